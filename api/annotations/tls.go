@@ -0,0 +1,45 @@
+package annotations
+
+// The following keys are set in the `tls.options` of Gateway listeners terminating TLS. Other option
+// keys are ignored and listed in the Accepted condition of the listener.
+const (
+	// ClientCertificateMode determines whether clients must present a certificate when the listener
+	// has `tls.frontendValidation` set. The value is either "Require" (the default) or "Optional".
+	// In Optional mode, connections without a client certificate are accepted, while the certificates
+	// that are presented are still validated.
+	ClientCertificateMode = "kgateway.dev/client-certificate-mode"
+
+	// VerifySubjectAltNames is a comma separated list of Subject Alternative Names, one of which the
+	// client certificate must have. Each name is prefixed with its type, e.g
+	// "dns:client.example.com,uri:spiffe://cluster.local/ns/default/sa/client". The supported types are
+	// dns, uri, email and ip.
+	VerifySubjectAltNames = "kgateway.dev/verify-subject-alt-names"
+
+	// VerifyCertificateHash is a comma separated list of hex-encoded SHA-256 hashes, one of which the
+	// client certificate must match.
+	VerifyCertificateHash = "kgateway.dev/verify-certificate-hash"
+
+	// MinTLSVersion is the minimum TLS version accepted by the listener: "1.0", "1.1", "1.2" or "1.3".
+	MinTLSVersion = "kgateway.dev/min-tls-version"
+
+	// MaxTLSVersion is the maximum TLS version accepted by the listener: "1.0", "1.1", "1.2" or "1.3".
+	MaxTLSVersion = "kgateway.dev/max-tls-version"
+
+	// CipherSuites is a comma separated list of the cipher suites accepted by the listener for TLS 1.2
+	// and below, e.g. "ECDHE-ECDSA-AES256-GCM-SHA384,ECDHE-RSA-AES256-GCM-SHA384". The TLS 1.3 cipher
+	// suites are not configurable.
+	CipherSuites = "kgateway.dev/cipher-suites"
+
+	// EcdhCurves is a comma separated list of the ECDH curves accepted by the listener, e.g. "P-256,P-384".
+	EcdhCurves = "kgateway.dev/ecdh-curves"
+
+	// AlpnProtocols is a comma separated list of the ALPN protocols offered by the listener, in order of
+	// preference, e.g. "h2,http/1.1".
+	AlpnProtocols = "kgateway.dev/alpn-protocols"
+)
+
+// Values of the ClientCertificateMode TLS option.
+const (
+	ClientCertificateModeRequire  = "Require"
+	ClientCertificateModeOptional = "Optional"
+)
@@ -12,6 +12,8 @@ type BackendConfigPolicySpecApplyConfiguration struct {
 	TargetRefs                    []LocalPolicyTargetReferenceApplyConfiguration `json:"targetRefs,omitempty"`
 	TargetSelectors               []LocalPolicyTargetSelectorApplyConfiguration  `json:"targetSelectors,omitempty"`
 	ConnectTimeout                *v1.Duration                                   `json:"connectTimeout,omitempty"`
+	IdleTimeout                   *v1.Duration                                   `json:"idleTimeout,omitempty"`
+	ProxyProtocol                 *UpstreamProxyProtocolApplyConfiguration       `json:"proxyProtocol,omitempty"`
 	PerConnectionBufferLimitBytes *int                                           `json:"perConnectionBufferLimitBytes,omitempty"`
 	TCPKeepalive                  *TCPKeepaliveApplyConfiguration                `json:"tcpKeepalive,omitempty"`
 	CommonHttpProtocolOptions     *CommonHttpProtocolOptionsApplyConfiguration   `json:"commonHttpProtocolOptions,omitempty"`
@@ -20,6 +22,8 @@ type BackendConfigPolicySpecApplyConfiguration struct {
 	TLS                           *TLSApplyConfiguration                         `json:"tls,omitempty"`
 	LoadBalancer                  *LoadBalancerApplyConfiguration                `json:"loadBalancer,omitempty"`
 	HealthCheck                   *HealthCheckApplyConfiguration                 `json:"healthCheck,omitempty"`
+	CircuitBreakers               *CircuitBreakersApplyConfiguration             `json:"circuitBreakers,omitempty"`
+	OutlierDetection              *OutlierDetectionApplyConfiguration            `json:"outlierDetection,omitempty"`
 }
 
 // BackendConfigPolicySpecApplyConfiguration constructs a declarative configuration of the BackendConfigPolicySpec type for use with
@@ -62,6 +66,22 @@ func (b *BackendConfigPolicySpecApplyConfiguration) WithConnectTimeout(value v1.
 	return b
 }
 
+// WithIdleTimeout sets the IdleTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IdleTimeout field is set to the value of the last call.
+func (b *BackendConfigPolicySpecApplyConfiguration) WithIdleTimeout(value v1.Duration) *BackendConfigPolicySpecApplyConfiguration {
+	b.IdleTimeout = &value
+	return b
+}
+
+// WithProxyProtocol sets the ProxyProtocol field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProxyProtocol field is set to the value of the last call.
+func (b *BackendConfigPolicySpecApplyConfiguration) WithProxyProtocol(value *UpstreamProxyProtocolApplyConfiguration) *BackendConfigPolicySpecApplyConfiguration {
+	b.ProxyProtocol = value
+	return b
+}
+
 // WithPerConnectionBufferLimitBytes sets the PerConnectionBufferLimitBytes field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the PerConnectionBufferLimitBytes field is set to the value of the last call.
@@ -125,3 +145,19 @@ func (b *BackendConfigPolicySpecApplyConfiguration) WithHealthCheck(value *Healt
 	b.HealthCheck = value
 	return b
 }
+
+// WithCircuitBreakers sets the CircuitBreakers field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CircuitBreakers field is set to the value of the last call.
+func (b *BackendConfigPolicySpecApplyConfiguration) WithCircuitBreakers(value *CircuitBreakersApplyConfiguration) *BackendConfigPolicySpecApplyConfiguration {
+	b.CircuitBreakers = value
+	return b
+}
+
+// WithOutlierDetection sets the OutlierDetection field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OutlierDetection field is set to the value of the last call.
+func (b *BackendConfigPolicySpecApplyConfiguration) WithOutlierDetection(value *OutlierDetectionApplyConfiguration) *BackendConfigPolicySpecApplyConfiguration {
+	b.OutlierDetection = value
+	return b
+}
@@ -2,11 +2,16 @@
 
 package v1alpha1
 
+import (
+	apiv1alpha1 "github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
 // EnvoyBootstrapApplyConfiguration represents a declarative configuration of the EnvoyBootstrap type for use
 // with apply.
 type EnvoyBootstrapApplyConfiguration struct {
-	LogLevel           *string           `json:"logLevel,omitempty"`
-	ComponentLogLevels map[string]string `json:"componentLogLevels,omitempty"`
+	LogLevel           *string              `json:"logLevel,omitempty"`
+	ComponentLogLevels map[string]string    `json:"componentLogLevels,omitempty"`
+	XdsMode            *apiv1alpha1.XdsMode `json:"xdsMode,omitempty"`
 }
 
 // EnvoyBootstrapApplyConfiguration constructs a declarative configuration of the EnvoyBootstrap type for use with
@@ -36,3 +41,11 @@ func (b *EnvoyBootstrapApplyConfiguration) WithComponentLogLevels(entries map[st
 	}
 	return b
 }
+
+// WithXdsMode sets the XdsMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the XdsMode field is set to the value of the last call.
+func (b *EnvoyBootstrapApplyConfiguration) WithXdsMode(value apiv1alpha1.XdsMode) *EnvoyBootstrapApplyConfiguration {
+	b.XdsMode = &value
+	return b
+}
@@ -2,10 +2,21 @@
 
 package v1alpha1
 
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // ExtAuthProviderApplyConfiguration represents a declarative configuration of the ExtAuthProvider type for use
 // with apply.
 type ExtAuthProviderApplyConfiguration struct {
-	GrpcService *ExtGrpcServiceApplyConfiguration `json:"grpcService,omitempty"`
+	GrpcService               *ExtGrpcServiceApplyConfiguration      `json:"grpcService,omitempty"`
+	HttpService               *ExtAuthHttpServiceApplyConfiguration  `json:"httpService,omitempty"`
+	FailOpen                  *bool                                  `json:"failOpen,omitempty"`
+	Timeout                   *v1.Duration                           `json:"timeout,omitempty"`
+	StatusOnError             *int32                                 `json:"statusOnError,omitempty"`
+	MetadataContextNamespaces []string                               `json:"metadataContextNamespaces,omitempty"`
+	AllowedRequestHeaders     []StringMatcherApplyConfiguration      `json:"allowedRequestHeaders,omitempty"`
+	HeaderMutationRules       *HeaderMutationRulesApplyConfiguration `json:"headerMutationRules,omitempty"`
 }
 
 // ExtAuthProviderApplyConfiguration constructs a declarative configuration of the ExtAuthProvider type for use with
@@ -21,3 +32,66 @@ func (b *ExtAuthProviderApplyConfiguration) WithGrpcService(value *ExtGrpcServic
 	b.GrpcService = value
 	return b
 }
+
+// WithHttpService sets the HttpService field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HttpService field is set to the value of the last call.
+func (b *ExtAuthProviderApplyConfiguration) WithHttpService(value *ExtAuthHttpServiceApplyConfiguration) *ExtAuthProviderApplyConfiguration {
+	b.HttpService = value
+	return b
+}
+
+// WithFailOpen sets the FailOpen field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailOpen field is set to the value of the last call.
+func (b *ExtAuthProviderApplyConfiguration) WithFailOpen(value bool) *ExtAuthProviderApplyConfiguration {
+	b.FailOpen = &value
+	return b
+}
+
+// WithTimeout sets the Timeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timeout field is set to the value of the last call.
+func (b *ExtAuthProviderApplyConfiguration) WithTimeout(value v1.Duration) *ExtAuthProviderApplyConfiguration {
+	b.Timeout = &value
+	return b
+}
+
+// WithStatusOnError sets the StatusOnError field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StatusOnError field is set to the value of the last call.
+func (b *ExtAuthProviderApplyConfiguration) WithStatusOnError(value int32) *ExtAuthProviderApplyConfiguration {
+	b.StatusOnError = &value
+	return b
+}
+
+// WithMetadataContextNamespaces adds the given value to the MetadataContextNamespaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the MetadataContextNamespaces field.
+func (b *ExtAuthProviderApplyConfiguration) WithMetadataContextNamespaces(values ...string) *ExtAuthProviderApplyConfiguration {
+	for i := range values {
+		b.MetadataContextNamespaces = append(b.MetadataContextNamespaces, values[i])
+	}
+	return b
+}
+
+// WithAllowedRequestHeaders adds the given value to the AllowedRequestHeaders field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AllowedRequestHeaders field.
+func (b *ExtAuthProviderApplyConfiguration) WithAllowedRequestHeaders(values ...*StringMatcherApplyConfiguration) *ExtAuthProviderApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAllowedRequestHeaders")
+		}
+		b.AllowedRequestHeaders = append(b.AllowedRequestHeaders, *values[i])
+	}
+	return b
+}
+
+// WithHeaderMutationRules sets the HeaderMutationRules field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HeaderMutationRules field is set to the value of the last call.
+func (b *ExtAuthProviderApplyConfiguration) WithHeaderMutationRules(value *HeaderMutationRulesApplyConfiguration) *ExtAuthProviderApplyConfiguration {
+	b.HeaderMutationRules = value
+	return b
+}
@@ -11,15 +11,31 @@ import (
 // HTTPListenerPolicySpecApplyConfiguration represents a declarative configuration of the HTTPListenerPolicySpec type for use
 // with apply.
 type HTTPListenerPolicySpecApplyConfiguration struct {
-	TargetRefs                 []LocalPolicyTargetReferenceApplyConfiguration `json:"targetRefs,omitempty"`
-	TargetSelectors            []LocalPolicyTargetSelectorApplyConfiguration  `json:"targetSelectors,omitempty"`
-	AccessLog                  []AccessLogApplyConfiguration                  `json:"accessLog,omitempty"`
-	Tracing                    *TracingApplyConfiguration                     `json:"tracing,omitempty"`
-	UpgradeConfig              *UpgradeConfigApplyConfiguration               `json:"upgradeConfig,omitempty"`
-	UseRemoteAddress           *bool                                          `json:"useRemoteAddress,omitempty"`
-	XffNumTrustedHops          *uint32                                        `json:"xffNumTrustedHops,omitempty"`
-	ServerHeaderTransformation *apiv1alpha1.ServerHeaderTransformation        `json:"serverHeaderTransformation,omitempty"`
-	StreamIdleTimeout          *v1.Duration                                   `json:"streamIdleTimeout,omitempty"`
+	TargetRefs                   []LocalPolicyTargetReferenceApplyConfiguration `json:"targetRefs,omitempty"`
+	TargetSelectors              []LocalPolicyTargetSelectorApplyConfiguration  `json:"targetSelectors,omitempty"`
+	AccessLog                    []AccessLogApplyConfiguration                  `json:"accessLog,omitempty"`
+	Tracing                      *TracingApplyConfiguration                     `json:"tracing,omitempty"`
+	UpgradeConfig                *UpgradeConfigApplyConfiguration               `json:"upgradeConfig,omitempty"`
+	UseRemoteAddress             *bool                                          `json:"useRemoteAddress,omitempty"`
+	XffNumTrustedHops            *uint32                                        `json:"xffNumTrustedHops,omitempty"`
+	ServerHeaderTransformation   *apiv1alpha1.ServerHeaderTransformation        `json:"serverHeaderTransformation,omitempty"`
+	StreamIdleTimeout            *v1.Duration                                   `json:"streamIdleTimeout,omitempty"`
+	IdleTimeout                  *v1.Duration                                   `json:"idleTimeout,omitempty"`
+	MaxConnectionDuration        *v1.Duration                                   `json:"maxConnectionDuration,omitempty"`
+	RequestTimeout               *v1.Duration                                   `json:"requestTimeout,omitempty"`
+	RequestHeadersTimeout        *v1.Duration                                   `json:"requestHeadersTimeout,omitempty"`
+	MaxRequestHeadersKb          *uint32                                        `json:"maxRequestHeadersKb,omitempty"`
+	MaxHeadersCount              *uint32                                        `json:"maxHeadersCount,omitempty"`
+	PreserveExternalRequestId    *bool                                          `json:"preserveExternalRequestId,omitempty"`
+	GenerateRequestId            *bool                                          `json:"generateRequestId,omitempty"`
+	NormalizePath                *bool                                          `json:"normalizePath,omitempty"`
+	MergeSlashes                 *bool                                          `json:"mergeSlashes,omitempty"`
+	PathWithEscapedSlashesAction *apiv1alpha1.PathWithEscapedSlashesAction      `json:"pathWithEscapedSlashesAction,omitempty"`
+	ForwardClientCertDetails     *apiv1alpha1.ForwardClientCertDetails          `json:"forwardClientCertDetails,omitempty"`
+	SetCurrentClientCertDetails  *ClientCertDetailsApplyConfiguration           `json:"setCurrentClientCertDetails,omitempty"`
+	Http1ProtocolOptions         *Http1ProtocolOptionsApplyConfiguration        `json:"http1ProtocolOptions,omitempty"`
+	Http2ProtocolOptions         *Http2ProtocolOptionsApplyConfiguration        `json:"http2ProtocolOptions,omitempty"`
+	Compression                  *CompressionApplyConfiguration                 `json:"compression,omitempty"`
 }
 
 // HTTPListenerPolicySpecApplyConfiguration constructs a declarative configuration of the HTTPListenerPolicySpec type for use with
@@ -114,3 +130,131 @@ func (b *HTTPListenerPolicySpecApplyConfiguration) WithStreamIdleTimeout(value v
 	b.StreamIdleTimeout = &value
 	return b
 }
+
+// WithIdleTimeout sets the IdleTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IdleTimeout field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithIdleTimeout(value v1.Duration) *HTTPListenerPolicySpecApplyConfiguration {
+	b.IdleTimeout = &value
+	return b
+}
+
+// WithMaxConnectionDuration sets the MaxConnectionDuration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxConnectionDuration field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithMaxConnectionDuration(value v1.Duration) *HTTPListenerPolicySpecApplyConfiguration {
+	b.MaxConnectionDuration = &value
+	return b
+}
+
+// WithRequestTimeout sets the RequestTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestTimeout field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithRequestTimeout(value v1.Duration) *HTTPListenerPolicySpecApplyConfiguration {
+	b.RequestTimeout = &value
+	return b
+}
+
+// WithRequestHeadersTimeout sets the RequestHeadersTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestHeadersTimeout field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithRequestHeadersTimeout(value v1.Duration) *HTTPListenerPolicySpecApplyConfiguration {
+	b.RequestHeadersTimeout = &value
+	return b
+}
+
+// WithMaxRequestHeadersKb sets the MaxRequestHeadersKb field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRequestHeadersKb field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithMaxRequestHeadersKb(value uint32) *HTTPListenerPolicySpecApplyConfiguration {
+	b.MaxRequestHeadersKb = &value
+	return b
+}
+
+// WithMaxHeadersCount sets the MaxHeadersCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxHeadersCount field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithMaxHeadersCount(value uint32) *HTTPListenerPolicySpecApplyConfiguration {
+	b.MaxHeadersCount = &value
+	return b
+}
+
+// WithPreserveExternalRequestId sets the PreserveExternalRequestId field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PreserveExternalRequestId field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithPreserveExternalRequestId(value bool) *HTTPListenerPolicySpecApplyConfiguration {
+	b.PreserveExternalRequestId = &value
+	return b
+}
+
+// WithGenerateRequestId sets the GenerateRequestId field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GenerateRequestId field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithGenerateRequestId(value bool) *HTTPListenerPolicySpecApplyConfiguration {
+	b.GenerateRequestId = &value
+	return b
+}
+
+// WithNormalizePath sets the NormalizePath field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NormalizePath field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithNormalizePath(value bool) *HTTPListenerPolicySpecApplyConfiguration {
+	b.NormalizePath = &value
+	return b
+}
+
+// WithMergeSlashes sets the MergeSlashes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MergeSlashes field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithMergeSlashes(value bool) *HTTPListenerPolicySpecApplyConfiguration {
+	b.MergeSlashes = &value
+	return b
+}
+
+// WithPathWithEscapedSlashesAction sets the PathWithEscapedSlashesAction field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PathWithEscapedSlashesAction field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithPathWithEscapedSlashesAction(value apiv1alpha1.PathWithEscapedSlashesAction) *HTTPListenerPolicySpecApplyConfiguration {
+	b.PathWithEscapedSlashesAction = &value
+	return b
+}
+
+// WithForwardClientCertDetails sets the ForwardClientCertDetails field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ForwardClientCertDetails field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithForwardClientCertDetails(value apiv1alpha1.ForwardClientCertDetails) *HTTPListenerPolicySpecApplyConfiguration {
+	b.ForwardClientCertDetails = &value
+	return b
+}
+
+// WithSetCurrentClientCertDetails sets the SetCurrentClientCertDetails field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SetCurrentClientCertDetails field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithSetCurrentClientCertDetails(value *ClientCertDetailsApplyConfiguration) *HTTPListenerPolicySpecApplyConfiguration {
+	b.SetCurrentClientCertDetails = value
+	return b
+}
+
+// WithHttp1ProtocolOptions sets the Http1ProtocolOptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Http1ProtocolOptions field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithHttp1ProtocolOptions(value *Http1ProtocolOptionsApplyConfiguration) *HTTPListenerPolicySpecApplyConfiguration {
+	b.Http1ProtocolOptions = value
+	return b
+}
+
+// WithHttp2ProtocolOptions sets the Http2ProtocolOptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Http2ProtocolOptions field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithHttp2ProtocolOptions(value *Http2ProtocolOptionsApplyConfiguration) *HTTPListenerPolicySpecApplyConfiguration {
+	b.Http2ProtocolOptions = value
+	return b
+}
+
+// WithCompression sets the Compression field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Compression field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithCompression(value *CompressionApplyConfiguration) *HTTPListenerPolicySpecApplyConfiguration {
+	b.Compression = value
+	return b
+}
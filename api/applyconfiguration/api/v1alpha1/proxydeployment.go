@@ -5,7 +5,9 @@ package v1alpha1
 // ProxyDeploymentApplyConfiguration represents a declarative configuration of the ProxyDeployment type for use
 // with apply.
 type ProxyDeploymentApplyConfiguration struct {
-	Replicas *uint32 `json:"replicas,omitempty"`
+	Replicas            *uint32                                `json:"replicas,omitempty"`
+	Autoscaling         *AutoscalingApplyConfiguration         `json:"autoscaling,omitempty"`
+	PodDisruptionBudget *PodDisruptionBudgetApplyConfiguration `json:"podDisruptionBudget,omitempty"`
 }
 
 // ProxyDeploymentApplyConfiguration constructs a declarative configuration of the ProxyDeployment type for use with
@@ -21,3 +23,19 @@ func (b *ProxyDeploymentApplyConfiguration) WithReplicas(value uint32) *ProxyDep
 	b.Replicas = &value
 	return b
 }
+
+// WithAutoscaling sets the Autoscaling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Autoscaling field is set to the value of the last call.
+func (b *ProxyDeploymentApplyConfiguration) WithAutoscaling(value *AutoscalingApplyConfiguration) *ProxyDeploymentApplyConfiguration {
+	b.Autoscaling = value
+	return b
+}
+
+// WithPodDisruptionBudget sets the PodDisruptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodDisruptionBudget field is set to the value of the last call.
+func (b *ProxyDeploymentApplyConfiguration) WithPodDisruptionBudget(value *PodDisruptionBudgetApplyConfiguration) *ProxyDeploymentApplyConfiguration {
+	b.PodDisruptionBudget = value
+	return b
+}
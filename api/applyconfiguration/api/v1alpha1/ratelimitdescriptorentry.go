@@ -9,9 +9,13 @@ import (
 // RateLimitDescriptorEntryApplyConfiguration represents a declarative configuration of the RateLimitDescriptorEntry type for use
 // with apply.
 type RateLimitDescriptorEntryApplyConfiguration struct {
-	Type    *apiv1alpha1.RateLimitDescriptorEntryType          `json:"type,omitempty"`
-	Generic *RateLimitDescriptorEntryGenericApplyConfiguration `json:"generic,omitempty"`
-	Header  *string                                            `json:"header,omitempty"`
+	Type                *apiv1alpha1.RateLimitDescriptorEntryType                      `json:"type,omitempty"`
+	Generic             *RateLimitDescriptorEntryGenericApplyConfiguration             `json:"generic,omitempty"`
+	Header              *string                                                        `json:"header,omitempty"`
+	QueryParameter      *string                                                        `json:"queryParameter,omitempty"`
+	Metadata            *RateLimitDescriptorEntryMetadataApplyConfiguration            `json:"metadata,omitempty"`
+	MaskedRemoteAddress *RateLimitDescriptorEntryMaskedRemoteAddressApplyConfiguration `json:"maskedRemoteAddress,omitempty"`
+	HeaderValueMatch    *RateLimitDescriptorEntryHeaderValueMatchApplyConfiguration    `json:"headerValueMatch,omitempty"`
 }
 
 // RateLimitDescriptorEntryApplyConfiguration constructs a declarative configuration of the RateLimitDescriptorEntry type for use with
@@ -43,3 +47,35 @@ func (b *RateLimitDescriptorEntryApplyConfiguration) WithHeader(value string) *R
 	b.Header = &value
 	return b
 }
+
+// WithQueryParameter sets the QueryParameter field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the QueryParameter field is set to the value of the last call.
+func (b *RateLimitDescriptorEntryApplyConfiguration) WithQueryParameter(value string) *RateLimitDescriptorEntryApplyConfiguration {
+	b.QueryParameter = &value
+	return b
+}
+
+// WithMetadata sets the Metadata field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Metadata field is set to the value of the last call.
+func (b *RateLimitDescriptorEntryApplyConfiguration) WithMetadata(value *RateLimitDescriptorEntryMetadataApplyConfiguration) *RateLimitDescriptorEntryApplyConfiguration {
+	b.Metadata = value
+	return b
+}
+
+// WithMaskedRemoteAddress sets the MaskedRemoteAddress field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaskedRemoteAddress field is set to the value of the last call.
+func (b *RateLimitDescriptorEntryApplyConfiguration) WithMaskedRemoteAddress(value *RateLimitDescriptorEntryMaskedRemoteAddressApplyConfiguration) *RateLimitDescriptorEntryApplyConfiguration {
+	b.MaskedRemoteAddress = value
+	return b
+}
+
+// WithHeaderValueMatch sets the HeaderValueMatch field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HeaderValueMatch field is set to the value of the last call.
+func (b *RateLimitDescriptorEntryApplyConfiguration) WithHeaderValueMatch(value *RateLimitDescriptorEntryHeaderValueMatchApplyConfiguration) *RateLimitDescriptorEntryApplyConfiguration {
+	b.HeaderValueMatch = value
+	return b
+}
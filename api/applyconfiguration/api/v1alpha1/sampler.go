@@ -9,7 +9,9 @@ import (
 // SamplerApplyConfiguration represents a declarative configuration of the Sampler type for use
 // with apply.
 type SamplerApplyConfiguration struct {
-	AlwaysOn *apiv1alpha1.AlwaysOnConfig `json:"alwaysOnConfig,omitempty"`
+	AlwaysOn     *apiv1alpha1.AlwaysOnConfig                  `json:"alwaysOnConfig,omitempty"`
+	TraceIdRatio *TraceIdRatioSamplerConfigApplyConfiguration `json:"traceIdRatio,omitempty"`
+	ParentBased  *ParentBasedSamplerConfigApplyConfiguration  `json:"parentBased,omitempty"`
 }
 
 // SamplerApplyConfiguration constructs a declarative configuration of the Sampler type for use with
@@ -25,3 +27,19 @@ func (b *SamplerApplyConfiguration) WithAlwaysOn(value apiv1alpha1.AlwaysOnConfi
 	b.AlwaysOn = &value
 	return b
 }
+
+// WithTraceIdRatio sets the TraceIdRatio field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TraceIdRatio field is set to the value of the last call.
+func (b *SamplerApplyConfiguration) WithTraceIdRatio(value *TraceIdRatioSamplerConfigApplyConfiguration) *SamplerApplyConfiguration {
+	b.TraceIdRatio = value
+	return b
+}
+
+// WithParentBased sets the ParentBased field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ParentBased field is set to the value of the last call.
+func (b *SamplerApplyConfiguration) WithParentBased(value *ParentBasedSamplerConfigApplyConfiguration) *SamplerApplyConfiguration {
+	b.ParentBased = value
+	return b
+}
@@ -5,7 +5,8 @@ package v1alpha1
 // SdsBootstrapApplyConfiguration represents a declarative configuration of the SdsBootstrap type for use
 // with apply.
 type SdsBootstrapApplyConfiguration struct {
-	LogLevel *string `json:"logLevel,omitempty"`
+	LogLevel    *string  `json:"logLevel,omitempty"`
+	SecretNames []string `json:"secretNames,omitempty"`
 }
 
 // SdsBootstrapApplyConfiguration constructs a declarative configuration of the SdsBootstrap type for use with
@@ -21,3 +22,13 @@ func (b *SdsBootstrapApplyConfiguration) WithLogLevel(value string) *SdsBootstra
 	b.LogLevel = &value
 	return b
 }
+
+// WithSecretNames adds the given value to the SecretNames field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SecretNames field.
+func (b *SdsBootstrapApplyConfiguration) WithSecretNames(values ...string) *SdsBootstrapApplyConfiguration {
+	for i := range values {
+		b.SecretNames = append(b.SecretNames, values[i])
+	}
+	return b
+}
@@ -6,6 +6,8 @@ package v1alpha1
 // with apply.
 type TracingProviderApplyConfiguration struct {
 	OpenTelemetry *OpenTelemetryTracingConfigApplyConfiguration `json:"openTelemetry,omitempty"`
+	Zipkin        *ZipkinTracingConfigApplyConfiguration        `json:"zipkin,omitempty"`
+	Datadog       *DatadogTracingConfigApplyConfiguration       `json:"datadog,omitempty"`
 }
 
 // TracingProviderApplyConfiguration constructs a declarative configuration of the TracingProvider type for use with
@@ -21,3 +23,19 @@ func (b *TracingProviderApplyConfiguration) WithOpenTelemetry(value *OpenTelemet
 	b.OpenTelemetry = value
 	return b
 }
+
+// WithZipkin sets the Zipkin field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Zipkin field is set to the value of the last call.
+func (b *TracingProviderApplyConfiguration) WithZipkin(value *ZipkinTracingConfigApplyConfiguration) *TracingProviderApplyConfiguration {
+	b.Zipkin = value
+	return b
+}
+
+// WithDatadog sets the Datadog field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Datadog field is set to the value of the last call.
+func (b *TracingProviderApplyConfiguration) WithDatadog(value *DatadogTracingConfigApplyConfiguration) *TracingProviderApplyConfiguration {
+	b.Datadog = value
+	return b
+}
@@ -16,6 +16,12 @@ type TrafficPolicySpecApplyConfiguration struct {
 	Csrf            *CSRFPolicyApplyConfiguration                                 `json:"csrf,omitempty"`
 	AutoHostRewrite *bool                                                         `json:"autoHostRewrite,omitempty"`
 	Buffer          *BufferApplyConfiguration                                     `json:"buffer,omitempty"`
+	JWT             *JWTAuthenticationApplyConfiguration                          `json:"jwt,omitempty"`
+	Authorization   *AuthorizationApplyConfiguration                              `json:"authorization,omitempty"`
+	FaultInjection  *FaultInjectionApplyConfiguration                             `json:"faultInjection,omitempty"`
+	Timeouts        *TimeoutsApplyConfiguration                                   `json:"timeouts,omitempty"`
+	Retry           *RetryApplyConfiguration                                      `json:"retry,omitempty"`
+	Compression     *CompressionPolicyApplyConfiguration                          `json:"compression,omitempty"`
 }
 
 // TrafficPolicySpecApplyConfiguration constructs a declarative configuration of the TrafficPolicySpec type for use with
@@ -121,3 +127,51 @@ func (b *TrafficPolicySpecApplyConfiguration) WithBuffer(value *BufferApplyConfi
 	b.Buffer = value
 	return b
 }
+
+// WithJWT sets the JWT field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JWT field is set to the value of the last call.
+func (b *TrafficPolicySpecApplyConfiguration) WithJWT(value *JWTAuthenticationApplyConfiguration) *TrafficPolicySpecApplyConfiguration {
+	b.JWT = value
+	return b
+}
+
+// WithAuthorization sets the Authorization field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Authorization field is set to the value of the last call.
+func (b *TrafficPolicySpecApplyConfiguration) WithAuthorization(value *AuthorizationApplyConfiguration) *TrafficPolicySpecApplyConfiguration {
+	b.Authorization = value
+	return b
+}
+
+// WithFaultInjection sets the FaultInjection field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FaultInjection field is set to the value of the last call.
+func (b *TrafficPolicySpecApplyConfiguration) WithFaultInjection(value *FaultInjectionApplyConfiguration) *TrafficPolicySpecApplyConfiguration {
+	b.FaultInjection = value
+	return b
+}
+
+// WithTimeouts sets the Timeouts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timeouts field is set to the value of the last call.
+func (b *TrafficPolicySpecApplyConfiguration) WithTimeouts(value *TimeoutsApplyConfiguration) *TrafficPolicySpecApplyConfiguration {
+	b.Timeouts = value
+	return b
+}
+
+// WithRetry sets the Retry field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Retry field is set to the value of the last call.
+func (b *TrafficPolicySpecApplyConfiguration) WithRetry(value *RetryApplyConfiguration) *TrafficPolicySpecApplyConfiguration {
+	b.Retry = value
+	return b
+}
+
+// WithCompression sets the Compression field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Compression field is set to the value of the last call.
+func (b *TrafficPolicySpecApplyConfiguration) WithCompression(value *CompressionPolicyApplyConfiguration) *TrafficPolicySpecApplyConfiguration {
+	b.Compression = value
+	return b
+}
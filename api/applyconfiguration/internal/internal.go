@@ -311,6 +311,90 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: prefix
       type:
         scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Authorization
+  map:
+    fields:
+    - name: action
+      type:
+        scalar: string
+    - name: rules
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AuthorizationRule
+          elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AuthorizationPermission
+  map:
+    fields:
+    - name: methods
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: paths
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.gateway-api.apis.v1.HTTPPathMatch
+          elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AuthorizationPrincipal
+  map:
+    fields:
+    - name: headers
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.gateway-api.apis.v1.HTTPHeaderMatch
+          elementRelationship: atomic
+    - name: jwtClaims
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.JWTClaimMatch
+          elementRelationship: atomic
+    - name: sourceCIDRs
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AuthorizationRule
+  map:
+    fields:
+    - name: condition
+      type:
+        scalar: string
+    - name: permissions
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AuthorizationPermission
+          elementRelationship: atomic
+    - name: principals
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AuthorizationPrincipal
+          elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Autoscaling
+  map:
+    fields:
+    - name: behavior
+      type:
+        namedType: io.k8s.api.autoscaling.v2.HorizontalPodAutoscalerBehavior
+    - name: maxReplicas
+      type:
+        scalar: numeric
+    - name: minReplicas
+      type:
+        scalar: numeric
+    - name: targetCPUUtilizationPercentage
+      type:
+        scalar: numeric
+    - name: targetMemoryUtilizationPercentage
+      type:
+        scalar: numeric
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AwsAuth
   map:
     fields:
@@ -426,6 +510,9 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.BackendConfigPolicySpec
   map:
     fields:
+    - name: circuitBreakers
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CircuitBreakers
     - name: commonHttpProtocolOptions
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CommonHttpProtocolOptions
@@ -441,12 +528,21 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: http2ProtocolOptions
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Http2ProtocolOptions
+    - name: idleTimeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
     - name: loadBalancer
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LoadBalancer
+    - name: outlierDetection
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.OutlierDetection
     - name: perConnectionBufferLimitBytes
       type:
         scalar: numeric
+    - name: proxyProtocol
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.UpstreamProxyProtocol
     - name: targetRefs
       type:
         list:
@@ -566,6 +662,57 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: percentageShadowed
       type:
         scalar: numeric
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CircuitBreakerThresholds
+  map:
+    fields:
+    - name: maxConnections
+      type:
+        scalar: numeric
+    - name: maxPendingRequests
+      type:
+        scalar: numeric
+    - name: maxRequests
+      type:
+        scalar: numeric
+    - name: maxRetries
+      type:
+        scalar: numeric
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CircuitBreakers
+  map:
+    fields:
+    - name: highPriority
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CircuitBreakerThresholds
+    - name: maxConnections
+      type:
+        scalar: numeric
+    - name: maxPendingRequests
+      type:
+        scalar: numeric
+    - name: maxRequests
+      type:
+        scalar: numeric
+    - name: maxRetries
+      type:
+        scalar: numeric
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ClientCertDetails
+  map:
+    fields:
+    - name: cert
+      type:
+        scalar: boolean
+    - name: chain
+      type:
+        scalar: boolean
+    - name: dns
+      type:
+        scalar: boolean
+    - name: subject
+      type:
+        scalar: boolean
+    - name: uri
+      type:
+        scalar: boolean
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CommonAccessLogGrpcService
   map:
     fields:
@@ -639,6 +786,34 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: maxStreamDuration
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Compression
+  map:
+    fields:
+    - name: requestDecompression
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RequestDecompression
+    - name: responseCompression
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ResponseCompression
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CompressionPolicy
+  map:
+    fields:
+    - name: requestDecompression
+      type:
+        scalar: string
+    - name: responseCompression
+      type:
+        scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ConnectionLimit
+  map:
+    fields:
+    - name: delay
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: maxConnections
+      type:
+        scalar: numeric
+      default: 0
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CorsPolicy
   map:
     fields:
@@ -758,6 +933,20 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: statusCode
       type:
         scalar: numeric
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.DatadogTracingConfig
+  map:
+    fields:
+    - name: backendRef
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1.BackendRef
+      default: {}
+    - name: collectorHostname
+      type:
+        scalar: string
+    - name: serviceName
+      type:
+        scalar: string
+      default: ""
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.DirectResponse
   map:
     fields:
@@ -801,6 +990,18 @@ var schemaYAML = typed.YAMLObject(`types:
         elementType:
           namedType: __untyped_deduced_
         elementRelationship: separable
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.DownstreamProxyProtocol
+  map:
+    fields:
+    - name: allowRequestsWithoutProxyProtocol
+      type:
+        scalar: boolean
+    - name: versions
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.DurationFilter
   map:
     fields:
@@ -839,6 +1040,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: logLevel
       type:
         scalar: string
+    - name: xdsMode
+      type:
+        scalar: string
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.EnvoyContainer
   map:
     fields:
@@ -860,6 +1064,33 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: securityContext
       type:
         namedType: io.k8s.api.core.v1.SecurityContext
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ExtAuthHttpService
+  map:
+    fields:
+    - name: allowedClientHeaders
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.StringMatcher
+          elementRelationship: atomic
+    - name: allowedClientHeadersOnSuccess
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.StringMatcher
+          elementRelationship: atomic
+    - name: allowedUpstreamHeaders
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.StringMatcher
+          elementRelationship: atomic
+    - name: backendRef
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1.BackendRef
+    - name: pathPrefix
+      type:
+        scalar: string
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ExtAuthPolicy
   map:
     fields:
@@ -880,9 +1111,36 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ExtAuthProvider
   map:
     fields:
+    - name: allowedRequestHeaders
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.StringMatcher
+          elementRelationship: atomic
+    - name: failOpen
+      type:
+        scalar: boolean
     - name: grpcService
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ExtGrpcService
+    - name: headerMutationRules
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HeaderMutationRules
+    - name: httpService
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ExtAuthHttpService
+    - name: metadataContextNamespaces
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: statusOnError
+      type:
+        scalar: numeric
+    - name: timeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ExtGrpcService
   map:
     fields:
@@ -907,6 +1165,42 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: grpcService
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ExtGrpcService
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.FaultAbort
+  map:
+    fields:
+    - name: grpcStatus
+      type:
+        scalar: numeric
+    - name: httpStatus
+      type:
+        scalar: numeric
+    - name: percentage
+      type:
+        scalar: numeric
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.FaultDelay
+  map:
+    fields:
+    - name: fixedDelay
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: percentage
+      type:
+        scalar: numeric
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.FaultInjection
+  map:
+    fields:
+    - name: abort
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.FaultAbort
+    - name: delay
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.FaultDelay
+    - name: headers
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.gateway-api.apis.v1.HTTPHeaderMatch
+          elementRelationship: atomic
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.FieldDefault
   map:
     fields:
@@ -1117,9 +1411,57 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AccessLog
           elementRelationship: atomic
+    - name: compression
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Compression
+    - name: forwardClientCertDetails
+      type:
+        scalar: string
+    - name: generateRequestId
+      type:
+        scalar: boolean
+    - name: http1ProtocolOptions
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Http1ProtocolOptions
+    - name: http2ProtocolOptions
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Http2ProtocolOptions
+    - name: idleTimeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: maxConnectionDuration
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: maxHeadersCount
+      type:
+        scalar: numeric
+    - name: maxRequestHeadersKb
+      type:
+        scalar: numeric
+    - name: mergeSlashes
+      type:
+        scalar: boolean
+    - name: normalizePath
+      type:
+        scalar: boolean
+    - name: pathWithEscapedSlashesAction
+      type:
+        scalar: string
+    - name: preserveExternalRequestId
+      type:
+        scalar: boolean
+    - name: requestHeadersTimeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: requestTimeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
     - name: serverHeaderTransformation
       type:
         scalar: string
+    - name: setCurrentClientCertDetails
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ClientCertDetails
     - name: streamIdleTimeout
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
@@ -1154,6 +1496,30 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: io.k8s.sigs.gateway-api.apis.v1.HTTPHeaderMatch
       default: {}
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HeaderMutationRules
+  map:
+    fields:
+    - name: allowAllRouting
+      type:
+        scalar: boolean
+    - name: allowEnvoy
+      type:
+        scalar: boolean
+    - name: allowExpression
+      type:
+        scalar: string
+    - name: disallowAll
+      type:
+        scalar: boolean
+    - name: disallowExpression
+      type:
+        scalar: string
+    - name: disallowIsError
+      type:
+        scalar: boolean
+    - name: disallowSystem
+      type:
+        scalar: boolean
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HeaderTransformation
   map:
     fields:
@@ -1311,6 +1677,86 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: istioProxyContainer
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.IstioContainer
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.JWKS
+  map:
+    fields:
+    - name: local
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalJWKS
+    - name: remote
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RemoteJWKS
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.JWTAuthentication
+  map:
+    fields:
+    - name: mode
+      type:
+        scalar: string
+    - name: providers
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.JWTProvider
+          elementRelationship: associative
+          keys:
+          - name
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.JWTClaimMatch
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: provider
+      type:
+        scalar: string
+      default: ""
+    - name: values
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.JWTClaimToHeader
+  map:
+    fields:
+    - name: header
+      type:
+        scalar: string
+      default: ""
+    - name: name
+      type:
+        scalar: string
+      default: ""
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.JWTProvider
+  map:
+    fields:
+    - name: audiences
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: claimsToHeaders
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.JWTClaimToHeader
+          elementRelationship: atomic
+    - name: forward
+      type:
+        scalar: boolean
+    - name: issuer
+      type:
+        scalar: string
+    - name: jwks
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.JWKS
+      default: {}
+    - name: name
+      type:
+        scalar: string
+      default: ""
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.KeyAnyValue
   map:
     fields:
@@ -1383,6 +1829,51 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.SupportedLLMProvider
       default: {}
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ListenerPolicy
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ListenerPolicySpec
+      default: {}
+    - name: status
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1alpha2.PolicyStatus
+      default: {}
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ListenerPolicySpec
+  map:
+    fields:
+    - name: connectionLimit
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ConnectionLimit
+    - name: perConnectionBufferLimitBytes
+      type:
+        scalar: numeric
+    - name: proxyProtocol
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.DownstreamProxyProtocol
+    - name: targetRefs
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalPolicyTargetReferenceWithSectionName
+          elementRelationship: atomic
+    - name: targetSelectors
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalPolicyTargetSelector
+          elementRelationship: atomic
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LoadBalancer
   map:
     fields:
@@ -1466,6 +1957,15 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: slowStart
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.SlowStart
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalJWKS
+  map:
+    fields:
+    - name: inline
+      type:
+        scalar: string
+    - name: secretRef
+      type:
+        namedType: io.k8s.api.core.v1.LocalObjectReference
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalPolicyTargetReference
   map:
     fields:
@@ -1622,6 +2122,45 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.OutlierDetection
+  map:
+    fields:
+    - name: baseEjectionTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: consecutive5xx
+      type:
+        scalar: numeric
+    - name: consecutiveGatewayErrors
+      type:
+        scalar: numeric
+    - name: interval
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: maxEjectionPercent
+      type:
+        scalar: numeric
+    - name: maxEjectionTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: successRate
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.OutlierDetectionSuccessRate
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.OutlierDetectionSuccessRate
+  map:
+    fields:
+    - name: enforcingPercentage
+      type:
+        scalar: numeric
+    - name: minimumHosts
+      type:
+        scalar: numeric
+    - name: requestVolume
+      type:
+        scalar: numeric
+    - name: stdevFactor
+      type:
+        scalar: string
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Parameters
   map:
     fields:
@@ -1643,6 +2182,21 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: tlsMinVersion
       type:
         scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ParentBasedRootSampler
+  map:
+    fields:
+    - name: alwaysOnConfig
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AlwaysOnConfig
+    - name: traceIdRatio
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TraceIdRatioSamplerConfig
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ParentBasedSamplerConfig
+  map:
+    fields:
+    - name: root
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ParentBasedRootSampler
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PathOverride
   map:
     fields:
@@ -1697,6 +2251,15 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: io.k8s.api.core.v1.Toleration
           elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PodDisruptionBudget
+  map:
+    fields:
+    - name: maxUnavailable
+      type:
+        namedType: io.k8s.apimachinery.pkg.util.intstr.IntOrString
+    - name: minAvailable
+      type:
+        namedType: io.k8s.apimachinery.pkg.util.intstr.IntOrString
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Port
   map:
     fields:
@@ -1764,6 +2327,12 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ProxyDeployment
   map:
     fields:
+    - name: autoscaling
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Autoscaling
+    - name: podDisruptionBudget
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PodDisruptionBudget
     - name: replicas
       type:
         scalar: numeric
@@ -1794,6 +2363,18 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: header
       type:
         scalar: string
+    - name: headerValueMatch
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RateLimitDescriptorEntryHeaderValueMatch
+    - name: maskedRemoteAddress
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RateLimitDescriptorEntryMaskedRemoteAddress
+    - name: metadata
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RateLimitDescriptorEntryMetadata
+    - name: queryParameter
+      type:
+        scalar: string
     - name: type
       type:
         scalar: string
@@ -1809,6 +2390,54 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RateLimitDescriptorEntryHeaderValueMatch
+  map:
+    fields:
+    - name: expectMatch
+      type:
+        scalar: boolean
+    - name: headers
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.gateway-api.apis.v1.HTTPHeaderMatch
+          elementRelationship: atomic
+    - name: key
+      type:
+        scalar: string
+    - name: value
+      type:
+        scalar: string
+      default: ""
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RateLimitDescriptorEntryMaskedRemoteAddress
+  map:
+    fields:
+    - name: v4PrefixLen
+      type:
+        scalar: numeric
+    - name: v6PrefixLen
+      type:
+        scalar: numeric
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RateLimitDescriptorEntryMetadata
+  map:
+    fields:
+    - name: defaultValue
+      type:
+        scalar: string
+    - name: key
+      type:
+        scalar: string
+      default: ""
+    - name: namespace
+      type:
+        scalar: string
+      default: ""
+    - name: path
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RateLimitPolicy
   map:
     fields:
@@ -1837,6 +2466,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: timeout
       type:
         scalar: string
+    - name: xRateLimitHeaders
+      type:
+        scalar: string
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Regex
   map:
     fields:
@@ -1864,12 +2496,59 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: pattern
       type:
         scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RemoteJWKS
+  map:
+    fields:
+    - name: backendRef
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1.BackendRef
+      default: {}
+    - name: cacheDuration
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: timeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: url
+      type:
+        scalar: string
+      default: ""
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RequestDecompression
+  map:
+    fields:
+    - name: algorithms
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ResourceDetector
   map:
     fields:
     - name: environmentResourceDetector
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.EnvironmentResourceDetectorConfig
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ResponseCompression
+  map:
+    fields:
+    - name: algorithms
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: contentTypes
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: disableOnETag
+      type:
+        scalar: boolean
+    - name: minContentLength
+      type:
+        scalar: numeric
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ResponseFlagFilter
   map:
     fields:
@@ -1879,6 +2558,39 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             scalar: string
           elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Retry
+  map:
+    fields:
+    - name: attempts
+      type:
+        scalar: numeric
+    - name: backoff
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RetryBackoff
+    - name: perTryTimeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: retryOn
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: statusCodes
+      type:
+        list:
+          elementType:
+            scalar: numeric
+          elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RetryBackoff
+  map:
+    fields:
+    - name: baseInterval
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: maxInterval
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RetryPolicy
   map:
     fields:
@@ -1894,12 +2606,24 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: alwaysOnConfig
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AlwaysOnConfig
+    - name: parentBased
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ParentBasedSamplerConfig
+    - name: traceIdRatio
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TraceIdRatioSamplerConfig
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.SdsBootstrap
   map:
     fields:
     - name: logLevel
       type:
         scalar: string
+    - name: secretNames
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.SdsContainer
   map:
     fields:
@@ -2123,6 +2847,18 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: tlsKey
       type:
         scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Timeouts
+  map:
+    fields:
+    - name: maxStreamDuration
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: request
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: streamIdle
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TokenBucket
   map:
     fields:
@@ -2137,6 +2873,13 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: tokensPerFill
       type:
         scalar: numeric
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TraceIdRatioSamplerConfig
+  map:
+    fields:
+    - name: ratio
+      type:
+        scalar: string
+      default: ""
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Tracing
   map:
     fields:
@@ -2171,9 +2914,15 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TracingProvider
   map:
     fields:
+    - name: datadog
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.DatadogTracingConfig
     - name: openTelemetry
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.OpenTelemetryTracingConfig
+    - name: zipkin
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ZipkinTracingConfig
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TrafficPolicy
   map:
     fields:
@@ -2201,12 +2950,18 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: ai
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIPolicy
+    - name: authorization
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Authorization
     - name: autoHostRewrite
       type:
         scalar: boolean
     - name: buffer
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Buffer
+    - name: compression
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CompressionPolicy
     - name: cors
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CorsPolicy
@@ -2219,9 +2974,18 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: extProc
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ExtProcPolicy
+    - name: faultInjection
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.FaultInjection
+    - name: jwt
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.JWTAuthentication
     - name: rateLimit
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RateLimit
+    - name: retry
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Retry
     - name: targetRefs
       type:
         list:
@@ -2234,6 +2998,9 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalPolicyTargetSelector
           elementRelationship: atomic
+    - name: timeouts
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Timeouts
     - name: transformation
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TransformationPolicy
@@ -2283,6 +3050,13 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             scalar: string
           elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.UpstreamProxyProtocol
+  map:
+    fields:
+    - name: version
+      type:
+        scalar: string
+      default: ""
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.VertexAIConfig
   map:
     fields:
@@ -2326,6 +3100,70 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Host
       default: {}
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ZipkinTracingConfig
+  map:
+    fields:
+    - name: backendRef
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1.BackendRef
+      default: {}
+    - name: collectorEndpoint
+      type:
+        scalar: string
+    - name: collectorEndpointVersion
+      type:
+        scalar: string
+    - name: collectorHostname
+      type:
+        scalar: string
+    - name: sharedSpanContext
+      type:
+        scalar: boolean
+    - name: traceId128Bit
+      type:
+        scalar: boolean
+- name: io.k8s.api.autoscaling.v2.HPAScalingPolicy
+  map:
+    fields:
+    - name: periodSeconds
+      type:
+        scalar: numeric
+      default: 0
+    - name: type
+      type:
+        scalar: string
+      default: ""
+    - name: value
+      type:
+        scalar: numeric
+      default: 0
+- name: io.k8s.api.autoscaling.v2.HPAScalingRules
+  map:
+    fields:
+    - name: policies
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.autoscaling.v2.HPAScalingPolicy
+          elementRelationship: atomic
+    - name: selectPolicy
+      type:
+        scalar: string
+    - name: stabilizationWindowSeconds
+      type:
+        scalar: numeric
+    - name: tolerance
+      type:
+        namedType: io.k8s.apimachinery.pkg.api.resource.Quantity
+- name: io.k8s.api.autoscaling.v2.HorizontalPodAutoscalerBehavior
+  map:
+    fields:
+    - name: scaleDown
+      type:
+        namedType: io.k8s.api.autoscaling.v2.HPAScalingRules
+    - name: scaleUp
+      type:
+        namedType: io.k8s.api.autoscaling.v2.HPAScalingRules
 - name: io.k8s.api.core.v1.Affinity
   map:
     fields:
@@ -3365,6 +4203,15 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: io.k8s.sigs.gateway-api.apis.v1.HTTPPathMatch
+  map:
+    fields:
+    - name: type
+      type:
+        scalar: string
+    - name: value
+      type:
+        scalar: string
 - name: io.k8s.sigs.gateway-api.apis.v1.ParentReference
   map:
     fields:
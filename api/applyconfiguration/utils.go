@@ -45,6 +45,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.AnyValueApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AuthHeaderOverride"):
 		return &apiv1alpha1.AuthHeaderOverrideApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Authorization"):
+		return &apiv1alpha1.AuthorizationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AuthorizationPermission"):
+		return &apiv1alpha1.AuthorizationPermissionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AuthorizationPrincipal"):
+		return &apiv1alpha1.AuthorizationPrincipalApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AuthorizationRule"):
+		return &apiv1alpha1.AuthorizationRuleApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Autoscaling"):
+		return &apiv1alpha1.AutoscalingApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AwsAuth"):
 		return &apiv1alpha1.AwsAuthApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AwsBackend"):
@@ -73,12 +83,24 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.BufferSettingsApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CELFilter"):
 		return &apiv1alpha1.CELFilterApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CircuitBreakers"):
+		return &apiv1alpha1.CircuitBreakersApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CircuitBreakerThresholds"):
+		return &apiv1alpha1.CircuitBreakerThresholdsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ClientCertDetails"):
+		return &apiv1alpha1.ClientCertDetailsApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CommonAccessLogGrpcService"):
 		return &apiv1alpha1.CommonAccessLogGrpcServiceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CommonGrpcService"):
 		return &apiv1alpha1.CommonGrpcServiceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CommonHttpProtocolOptions"):
 		return &apiv1alpha1.CommonHttpProtocolOptionsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Compression"):
+		return &apiv1alpha1.CompressionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CompressionPolicy"):
+		return &apiv1alpha1.CompressionPolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ConnectionLimit"):
+		return &apiv1alpha1.ConnectionLimitApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CorsPolicy"):
 		return &apiv1alpha1.CorsPolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CSRFPolicy"):
@@ -97,10 +119,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.CustomLabelApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CustomResponse"):
 		return &apiv1alpha1.CustomResponseApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DatadogTracingConfig"):
+		return &apiv1alpha1.DatadogTracingConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DirectResponse"):
 		return &apiv1alpha1.DirectResponseApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DirectResponseSpec"):
 		return &apiv1alpha1.DirectResponseSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DownstreamProxyProtocol"):
+		return &apiv1alpha1.DownstreamProxyProtocolApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DurationFilter"):
 		return &apiv1alpha1.DurationFilterApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DynamicForwardProxyBackend"):
@@ -109,6 +135,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.EnvoyBootstrapApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("EnvoyContainer"):
 		return &apiv1alpha1.EnvoyContainerApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ExtAuthHttpService"):
+		return &apiv1alpha1.ExtAuthHttpServiceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ExtAuthPolicy"):
 		return &apiv1alpha1.ExtAuthPolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ExtAuthProvider"):
@@ -119,6 +147,12 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.ExtProcPolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ExtProcProvider"):
 		return &apiv1alpha1.ExtProcProviderApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("FaultAbort"):
+		return &apiv1alpha1.FaultAbortApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("FaultDelay"):
+		return &apiv1alpha1.FaultDelayApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("FaultInjection"):
+		return &apiv1alpha1.FaultInjectionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("FieldDefault"):
 		return &apiv1alpha1.FieldDefaultApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("FileSink"):
@@ -143,6 +177,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.GrpcStatusFilterApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("HeaderFilter"):
 		return &apiv1alpha1.HeaderFilterApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("HeaderMutationRules"):
+		return &apiv1alpha1.HeaderMutationRulesApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("HeaderTransformation"):
 		return &apiv1alpha1.HeaderTransformationApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("HeaderValue"):
@@ -169,12 +205,26 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.IstioContainerApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("IstioIntegration"):
 		return &apiv1alpha1.IstioIntegrationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("JWKS"):
+		return &apiv1alpha1.JWKSApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("JWTAuthentication"):
+		return &apiv1alpha1.JWTAuthenticationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("JWTClaimMatch"):
+		return &apiv1alpha1.JWTClaimMatchApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("JWTClaimToHeader"):
+		return &apiv1alpha1.JWTClaimToHeaderApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("JWTProvider"):
+		return &apiv1alpha1.JWTProviderApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("KeyAnyValue"):
 		return &apiv1alpha1.KeyAnyValueApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("KeyAnyValueList"):
 		return &apiv1alpha1.KeyAnyValueListApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("KubernetesProxyConfig"):
 		return &apiv1alpha1.KubernetesProxyConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ListenerPolicy"):
+		return &apiv1alpha1.ListenerPolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ListenerPolicySpec"):
+		return &apiv1alpha1.ListenerPolicySpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("LLMProvider"):
 		return &apiv1alpha1.LLMProviderApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("LoadBalancer"):
@@ -185,6 +235,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.LoadBalancerRingHashConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("LoadBalancerRoundRobinConfig"):
 		return &apiv1alpha1.LoadBalancerRoundRobinConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("LocalJWKS"):
+		return &apiv1alpha1.LocalJWKSApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("LocalPolicyTargetReference"):
 		return &apiv1alpha1.LocalPolicyTargetReferenceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("LocalPolicyTargetReferenceWithSectionName"):
@@ -211,12 +263,22 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.OpenTelemetryTracingConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("OTelTracesSampler"):
 		return &apiv1alpha1.OTelTracesSamplerApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OutlierDetection"):
+		return &apiv1alpha1.OutlierDetectionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OutlierDetectionSuccessRate"):
+		return &apiv1alpha1.OutlierDetectionSuccessRateApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Parameters"):
 		return &apiv1alpha1.ParametersApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ParentBasedRootSampler"):
+		return &apiv1alpha1.ParentBasedRootSamplerApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ParentBasedSamplerConfig"):
+		return &apiv1alpha1.ParentBasedSamplerConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PathOverride"):
 		return &apiv1alpha1.PathOverrideApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Pod"):
 		return &apiv1alpha1.PodApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PodDisruptionBudget"):
+		return &apiv1alpha1.PodDisruptionBudgetApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Port"):
 		return &apiv1alpha1.PortApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Priority"):
@@ -237,6 +299,12 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.RateLimitDescriptorEntryApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RateLimitDescriptorEntryGeneric"):
 		return &apiv1alpha1.RateLimitDescriptorEntryGenericApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RateLimitDescriptorEntryHeaderValueMatch"):
+		return &apiv1alpha1.RateLimitDescriptorEntryHeaderValueMatchApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RateLimitDescriptorEntryMaskedRemoteAddress"):
+		return &apiv1alpha1.RateLimitDescriptorEntryMaskedRemoteAddressApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RateLimitDescriptorEntryMetadata"):
+		return &apiv1alpha1.RateLimitDescriptorEntryMetadataApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RateLimitPolicy"):
 		return &apiv1alpha1.RateLimitPolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RateLimitProvider"):
@@ -245,10 +313,20 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.RegexApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RegexMatch"):
 		return &apiv1alpha1.RegexMatchApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RemoteJWKS"):
+		return &apiv1alpha1.RemoteJWKSApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RequestDecompression"):
+		return &apiv1alpha1.RequestDecompressionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ResourceDetector"):
 		return &apiv1alpha1.ResourceDetectorApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ResponseCompression"):
+		return &apiv1alpha1.ResponseCompressionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ResponseFlagFilter"):
 		return &apiv1alpha1.ResponseFlagFilterApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Retry"):
+		return &apiv1alpha1.RetryApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RetryBackoff"):
+		return &apiv1alpha1.RetryBackoffApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RetryPolicy"):
 		return &apiv1alpha1.RetryPolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Sampler"):
@@ -277,12 +355,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.SupportedLLMProviderApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TCPKeepalive"):
 		return &apiv1alpha1.TCPKeepaliveApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Timeouts"):
+		return &apiv1alpha1.TimeoutsApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TLS"):
 		return &apiv1alpha1.TLSApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TLSFiles"):
 		return &apiv1alpha1.TLSFilesApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TokenBucket"):
 		return &apiv1alpha1.TokenBucketApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("TraceIdRatioSamplerConfig"):
+		return &apiv1alpha1.TraceIdRatioSamplerConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Tracing"):
 		return &apiv1alpha1.TracingApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TracingProvider"):
@@ -297,10 +379,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.TransformationPolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("UpgradeConfig"):
 		return &apiv1alpha1.UpgradeConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("UpstreamProxyProtocol"):
+		return &apiv1alpha1.UpstreamProxyProtocolApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("VertexAIConfig"):
 		return &apiv1alpha1.VertexAIConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Webhook"):
 		return &apiv1alpha1.WebhookApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ZipkinTracingConfig"):
+		return &apiv1alpha1.ZipkinTracingConfigApplyConfiguration{}
 
 	}
 	return nil
@@ -46,10 +46,26 @@ type BackendConfigPolicySpec struct {
 	TargetSelectors []LocalPolicyTargetSelector `json:"targetSelectors,omitempty"`
 
 	// The timeout for new network connections to hosts in the cluster.
+	// If unset, defaults to 5s.
 	// +optional
 	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('0s')",message="connectTimeout must be a valid duration string"
 	ConnectTimeout *metav1.Duration `json:"connectTimeout,omitempty"`
 
+	// The idle timeout of the connections to hosts in the cluster, after which a connection
+	// without active traffic is closed. It applies to both TCP and HTTP connections. For HTTP
+	// connections, commonHttpProtocolOptions.idleTimeout takes precedence if set.
+	// If unset, TCP connections are closed after 10 minutes and HTTP connections after 1 hour.
+	// Set to 0 to disable the idle timeout.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('0s')",message="idleTimeout must be a valid duration string"
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
+
+	// ProxyProtocol configures the proxy to send a PROXY protocol header to the backend when it
+	// opens a connection, so that the backend learns the address of the downstream client.
+	// The header is sent before the TLS handshake if TLS is configured for the backend.
+	// +optional
+	ProxyProtocol *UpstreamProxyProtocol `json:"proxyProtocol,omitempty"`
+
 	// Soft limit on size of the cluster's connections read and write buffers.
 	// If unspecified, an implementation defined default is applied (1MiB).
 	// +optional
@@ -86,6 +102,16 @@ type BackendConfigPolicySpec struct {
 	// HealthCheck contains the options necessary to configure the health check.
 	// +optional
 	HealthCheck *HealthCheck `json:"healthCheck,omitempty"`
+
+	// CircuitBreakers contains the options necessary to configure the circuit breakers
+	// limiting the connections and requests to the backend.
+	// +optional
+	CircuitBreakers *CircuitBreakers `json:"circuitBreakers,omitempty"`
+
+	// OutlierDetection contains the options necessary to configure the passive health checking
+	// that ejects misbehaving hosts from the load balancing pool.
+	// +optional
+	OutlierDetection *OutlierDetection `json:"outlierDetection,omitempty"`
 }
 
 // See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/core/v3/protocol.proto#envoy-v3-api-msg-config-core-v3-http1protocoloptions) for more details.
@@ -480,3 +506,139 @@ type HealthCheckGrpc struct {
 	// +optional
 	Authority *string `json:"authority,omitempty"`
 }
+
+// UpstreamProxyProtocol configures the PROXY protocol header sent to a backend.
+// See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/transport_sockets/proxy_protocol/v3/upstream_proxy_protocol.proto) for more details.
+type UpstreamProxyProtocol struct {
+	// Version is the version of the PROXY protocol to send.
+	// +required
+	Version ProxyProtocolVersion `json:"version"`
+}
+
+// ProxyProtocolVersion is a version of the PROXY protocol.
+// +kubebuilder:validation:Enum=V1;V2
+type ProxyProtocolVersion string
+
+const (
+	// ProxyProtocolVersionV1 is the human-readable version of the PROXY protocol.
+	ProxyProtocolVersionV1 ProxyProtocolVersion = "V1"
+	// ProxyProtocolVersionV2 is the binary version of the PROXY protocol.
+	ProxyProtocolVersionV2 ProxyProtocolVersion = "V2"
+)
+
+// CircuitBreakers contains the options to configure the circuit breakers of a backend.
+// The thresholds set at the top level apply to requests routed with the default priority.
+// See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/cluster/v3/circuit_breaker.proto) for more details.
+type CircuitBreakers struct {
+	CircuitBreakerThresholds `json:",inline"`
+
+	// HighPriority contains the thresholds applied to requests routed with the high priority.
+	// If unset, the Envoy defaults apply.
+	// +optional
+	HighPriority *CircuitBreakerThresholds `json:"highPriority,omitempty"`
+}
+
+// CircuitBreakerThresholds contains the circuit breaker thresholds of a routing priority.
+type CircuitBreakerThresholds struct {
+	// MaxConnections is the maximum number of connections that Envoy will make to the backend.
+	// If unset, defaults to 1024.
+	// +optional
+	MaxConnections *uint32 `json:"maxConnections,omitempty"`
+
+	// MaxPendingRequests is the maximum number of requests that will be queued while waiting
+	// for a ready connection pool connection.
+	// If unset, defaults to 1024.
+	// +optional
+	MaxPendingRequests *uint32 `json:"maxPendingRequests,omitempty"`
+
+	// MaxRequests is the maximum number of parallel requests that Envoy will make to the backend.
+	// If unset, defaults to 1024.
+	// +optional
+	MaxRequests *uint32 `json:"maxRequests,omitempty"`
+
+	// MaxRetries is the maximum number of parallel retries that Envoy will allow to the backend.
+	// If unset, defaults to 3.
+	// +optional
+	MaxRetries *uint32 `json:"maxRetries,omitempty"`
+}
+
+// OutlierDetection contains the options to configure the outlier detection of a backend.
+// See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/cluster/v3/outlier_detection.proto) for more details.
+type OutlierDetection struct {
+	// Consecutive5xx is the number of consecutive 5xx responses, or local origin errors, after
+	// which a host is ejected. Set to 0 to disable ejection on consecutive 5xx responses.
+	// If unset, defaults to 5.
+	// +optional
+	Consecutive5xx *uint32 `json:"consecutive5xx,omitempty"`
+
+	// ConsecutiveGatewayErrors is the number of consecutive 502, 503 and 504 responses, or local
+	// origin errors, after which a host is ejected.
+	// If unset, hosts are not ejected on consecutive gateway errors.
+	// +optional
+	ConsecutiveGatewayErrors *uint32 `json:"consecutiveGatewayErrors,omitempty"`
+
+	// Interval is the time between ejection analysis sweeps.
+	// If unset, defaults to 10s.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="interval must be a positive duration"
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// BaseEjectionTime is the base time that a host is ejected for. The real time is equal to the
+	// base time multiplied by the number of times the host has been ejected, capped by
+	// MaxEjectionTime.
+	// If unset, defaults to 30s.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="baseEjectionTime must be a positive duration"
+	BaseEjectionTime *metav1.Duration `json:"baseEjectionTime,omitempty"`
+
+	// MaxEjectionTime is the maximum time that a host is ejected for. It must not be smaller
+	// than BaseEjectionTime.
+	// If unset, defaults to 300s or BaseEjectionTime, whichever is larger.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="maxEjectionTime must be a positive duration"
+	MaxEjectionTime *metav1.Duration `json:"maxEjectionTime,omitempty"`
+
+	// MaxEjectionPercent is the maximum percentage of hosts in the backend that can be ejected.
+	// At least one host is ejected regardless of the value.
+	// If unset, defaults to 10.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MaxEjectionPercent *uint32 `json:"maxEjectionPercent,omitempty"`
+
+	// SuccessRate configures the ejection of hosts whose success rate is significantly lower
+	// than the success rate of the other hosts in the backend.
+	// If unset, hosts are not ejected based on their success rate.
+	// +optional
+	SuccessRate *OutlierDetectionSuccessRate `json:"successRate,omitempty"`
+}
+
+// OutlierDetectionSuccessRate contains the options of the success rate based outlier detection.
+// A host is ejected when its success rate is below the mean success rate of all the hosts
+// minus StdevFactor times the standard deviation of the success rates.
+type OutlierDetectionSuccessRate struct {
+	// MinimumHosts is the number of hosts that must have enough request volume for the success
+	// rate analysis to run.
+	// If unset, defaults to 5.
+	// +optional
+	MinimumHosts *uint32 `json:"minimumHosts,omitempty"`
+
+	// RequestVolume is the minimum number of requests in an interval for the success rate of a
+	// host to be included in the analysis.
+	// If unset, defaults to 100.
+	// +optional
+	RequestVolume *uint32 `json:"requestVolume,omitempty"`
+
+	// StdevFactor is the factor applied to the standard deviation of the success rates to
+	// compute the ejection threshold, for example "1.9".
+	// If unset, defaults to 1.9.
+	// +optional
+	StdevFactor *string `json:"stdevFactor,omitempty"`
+
+	// EnforcingPercentage is the chance that a host detected as an outlier is actually ejected.
+	// If unset, defaults to 100.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	EnforcingPercentage *uint32 `json:"enforcingPercentage,omitempty"`
+}
@@ -37,10 +37,121 @@ const (
 )
 
 // ExtAuthProvider defines the configuration for an ExtAuth provider.
+// +kubebuilder:validation:ExactlyOneOf=grpcService;httpService
 type ExtAuthProvider struct {
 	// GrpcService is the GRPC service that will handle the authentication.
+	// +optional
+	GrpcService *ExtGrpcService `json:"grpcService,omitempty"`
+
+	// HttpService is the HTTP service that will handle the authentication.
+	// +optional
+	HttpService *ExtAuthHttpService `json:"httpService,omitempty"`
+
+	// FailOpen determines if requests are allowed when the authorization service is unavailable
+	// or responds with an error. When false, such requests are denied with StatusOnError.
+	// +optional
+	// +kubebuilder:default=false
+	FailOpen bool `json:"failOpen,omitempty"`
+
+	// Timeout for requests to the authorization service. Defaults to 200ms.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('0s')",message="timeout must be a valid duration string"
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// StatusOnError is the HTTP status code returned to the client when the authorization service
+	// is unavailable or responds with an error, and FailOpen is false. Defaults to 403.
+	// +optional
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	StatusOnError *int32 `json:"statusOnError,omitempty"`
+
+	// MetadataContextNamespaces are the namespaces of the dynamic metadata of the request that is
+	// sent to the authorization service. Only GRPC services receive the metadata.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	MetadataContextNamespaces []string `json:"metadataContextNamespaces,omitempty"`
+
+	// AllowedRequestHeaders matches the headers of the client request that are sent to the
+	// authorization service. When unset, all the headers are sent to a GRPC service, while only the
+	// Host, Method, Path, Content-Length and Authorization headers are sent to an HTTP service.
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	AllowedRequestHeaders []StringMatcher `json:"allowedRequestHeaders,omitempty"`
+
+	// HeaderMutationRules restricts the request headers that the authorization service may set or
+	// remove before the request is forwarded upstream. When unset, the mutations are not restricted.
+	// +optional
+	HeaderMutationRules *HeaderMutationRules `json:"headerMutationRules,omitempty"`
+}
+
+// ExtAuthHttpService defines the HTTP service that will handle the authentication. The
+// service is sent a request with the headers and the path of the client request, and
+// allows the client request with a 200 response.
+type ExtAuthHttpService struct {
+	// BackendRef references the backend HTTP service.
 	// +required
-	GrpcService *ExtGrpcService `json:"grpcService"`
+	BackendRef *gwv1.BackendRef `json:"backendRef"`
+
+	// PathPrefix is prepended to the path of the requests sent to the authorization service.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^/.*$`
+	PathPrefix string `json:"pathPrefix,omitempty"`
+
+	// AllowedUpstreamHeaders matches the headers of the authorization response that are added
+	// to the client request when it is allowed, overriding the headers of the same name.
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	AllowedUpstreamHeaders []StringMatcher `json:"allowedUpstreamHeaders,omitempty"`
+
+	// AllowedClientHeaders matches the headers of the authorization response that are sent to the
+	// client when the request is denied. When unset, all the headers are sent.
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	AllowedClientHeaders []StringMatcher `json:"allowedClientHeaders,omitempty"`
+
+	// AllowedClientHeadersOnSuccess matches the headers of the authorization response that are
+	// added to the response sent to the client when the request is allowed.
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	AllowedClientHeadersOnSuccess []StringMatcher `json:"allowedClientHeadersOnSuccess,omitempty"`
+}
+
+// HeaderMutationRules restricts the headers that an external service may mutate.
+// The rules are evaluated in order: DisallowAll, DisallowExpression, AllowExpression, then the
+// rules on specific headers.
+type HeaderMutationRules struct {
+	// AllowAllRouting allows the mutation of the headers used for routing: Host, :authority,
+	// :method and :path.
+	// +optional
+	AllowAllRouting bool `json:"allowAllRouting,omitempty"`
+
+	// AllowEnvoy allows the mutation of the x-envoy-* headers.
+	// +optional
+	AllowEnvoy bool `json:"allowEnvoy,omitempty"`
+
+	// DisallowSystem disallows the mutation of the system headers that aren't covered by
+	// AllowAllRouting, e.g. :scheme.
+	// +optional
+	DisallowSystem bool `json:"disallowSystem,omitempty"`
+
+	// DisallowAll disallows the mutation of all the headers.
+	// +optional
+	DisallowAll bool `json:"disallowAll,omitempty"`
+
+	// AllowExpression is a Google RE2 regular expression matching the names of headers that may
+	// be mutated.
+	// +optional
+	AllowExpression *string `json:"allowExpression,omitempty"`
+
+	// DisallowExpression is a Google RE2 regular expression matching the names of headers that
+	// may not be mutated.
+	// +optional
+	DisallowExpression *string `json:"disallowExpression,omitempty"`
+
+	// DisallowIsError rejects the request when the external service attempts a disallowed
+	// mutation. By default, disallowed mutations are ignored.
+	// +optional
+	DisallowIsError bool `json:"disallowIsError,omitempty"`
 }
 
 // ExtProcProvider defines the configuration for an ExtProc provider.
@@ -83,8 +194,27 @@ type RateLimitProvider struct {
 	// +optional
 	// +kubebuilder:default="20ms"
 	Timeout gwv1.Duration `json:"timeout,omitempty"`
+
+	// XRateLimitHeaders determines which version of the X-RateLimit-* response headers, e.g.
+	// X-RateLimit-Limit and X-RateLimit-Remaining, is added to the responses of limited routes.
+	// The headers are not added when set to Off.
+	// +optional
+	// +kubebuilder:default=DraftVersion03
+	XRateLimitHeaders XRateLimitHeadersVersion `json:"xRateLimitHeaders,omitempty"`
 }
 
+// XRateLimitHeadersVersion is a version of the draft RFC defining the X-RateLimit-* headers.
+// +kubebuilder:validation:Enum=Off;DraftVersion03
+type XRateLimitHeadersVersion string
+
+const (
+	// XRateLimitHeadersOff disables the X-RateLimit-* headers.
+	XRateLimitHeadersOff XRateLimitHeadersVersion = "Off"
+
+	// XRateLimitHeadersDraftVersion03 adds the headers of version 03 of the draft RFC.
+	XRateLimitHeadersDraftVersion03 XRateLimitHeadersVersion = "DraftVersion03"
+)
+
 // GatewayExtensionSpec defines the desired state of GatewayExtension.
 // +kubebuilder:validation:XValidation:message="ExtAuth must be set when type is ExtAuth",rule="self.type != 'ExtAuth' || has(self.extAuth)"
 // +kubebuilder:validation:XValidation:message="ExtProc must be set when type is ExtProc",rule="self.type != 'ExtProc' || has(self.extProc)"
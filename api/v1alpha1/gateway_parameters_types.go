@@ -1,8 +1,10 @@
 package v1alpha1
 
 import (
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
@@ -210,9 +212,21 @@ func (in *KubernetesProxyConfig) GetFloatingUserId() *bool {
 // ProxyDeployment configures the Proxy deployment in Kubernetes.
 type ProxyDeployment struct {
 	// The number of desired pods. Defaults to 1.
+	// Ignored when autoscaling is set, as the HorizontalPodAutoscaler
+	// then owns the number of replicas.
 	//
 	// +optional
 	Replicas *uint32 `json:"replicas,omitempty"`
+
+	// Autoscaling creates a HorizontalPodAutoscaler for the proxy deployment.
+	//
+	// +optional
+	Autoscaling *Autoscaling `json:"autoscaling,omitempty"`
+
+	// PodDisruptionBudget creates a PodDisruptionBudget for the proxy pods.
+	//
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudget `json:"podDisruptionBudget,omitempty"`
 }
 
 func (in *ProxyDeployment) GetReplicas() *uint32 {
@@ -222,6 +236,128 @@ func (in *ProxyDeployment) GetReplicas() *uint32 {
 	return in.Replicas
 }
 
+func (in *ProxyDeployment) GetAutoscaling() *Autoscaling {
+	if in == nil {
+		return nil
+	}
+	return in.Autoscaling
+}
+
+func (in *ProxyDeployment) GetPodDisruptionBudget() *PodDisruptionBudget {
+	if in == nil {
+		return nil
+	}
+	return in.PodDisruptionBudget
+}
+
+// Autoscaling configures the HorizontalPodAutoscaler of the proxy deployment.
+// See https://kubernetes.io/docs/tasks/run-application/horizontal-pod-autoscale/
+// for details.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.minReplicas) || self.minReplicas <= self.maxReplicas",message="minReplicas must be less than or equal to maxReplicas"
+type Autoscaling struct {
+	// The lower limit for the number of replicas. Defaults to 1.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas *uint32 `json:"minReplicas,omitempty"`
+
+	// The upper limit for the number of replicas.
+	//
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas *uint32 `json:"maxReplicas"`
+
+	// The target average CPU utilization of the pods, as a percentage of the
+	// requested CPU. Defaults to 80 when no target is set.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TargetCPUUtilizationPercentage *uint32 `json:"targetCPUUtilizationPercentage,omitempty"`
+
+	// The target average memory utilization of the pods, as a percentage of
+	// the requested memory.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TargetMemoryUtilizationPercentage *uint32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+
+	// The scaling behavior in the up and down directions. See
+	// https://kubernetes.io/docs/reference/kubernetes-api/workload-resources/horizontal-pod-autoscaler-v2/#HorizontalPodAutoscalerSpec
+	// for details.
+	//
+	// +optional
+	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+}
+
+func (in *Autoscaling) GetMinReplicas() *uint32 {
+	if in == nil {
+		return nil
+	}
+	return in.MinReplicas
+}
+
+func (in *Autoscaling) GetMaxReplicas() *uint32 {
+	if in == nil {
+		return nil
+	}
+	return in.MaxReplicas
+}
+
+func (in *Autoscaling) GetTargetCPUUtilizationPercentage() *uint32 {
+	if in == nil {
+		return nil
+	}
+	return in.TargetCPUUtilizationPercentage
+}
+
+func (in *Autoscaling) GetTargetMemoryUtilizationPercentage() *uint32 {
+	if in == nil {
+		return nil
+	}
+	return in.TargetMemoryUtilizationPercentage
+}
+
+func (in *Autoscaling) GetBehavior() *autoscalingv2.HorizontalPodAutoscalerBehavior {
+	if in == nil {
+		return nil
+	}
+	return in.Behavior
+}
+
+// PodDisruptionBudget configures the PodDisruptionBudget of the proxy pods.
+// See https://kubernetes.io/docs/tasks/run-application/configure-pdb/
+// for details.
+//
+// +kubebuilder:validation:ExactlyOneOf=minAvailable;maxUnavailable
+type PodDisruptionBudget struct {
+	// The number or percentage of pods that must remain available during
+	// a voluntary disruption.
+	//
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// The number or percentage of pods that may be unavailable during
+	// a voluntary disruption.
+	//
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+func (in *PodDisruptionBudget) GetMinAvailable() *intstr.IntOrString {
+	if in == nil {
+		return nil
+	}
+	return in.MinAvailable
+}
+
+func (in *PodDisruptionBudget) GetMaxUnavailable() *intstr.IntOrString {
+	if in == nil {
+		return nil
+	}
+	return in.MaxUnavailable
+}
+
 // EnvoyContainer configures the container running Envoy.
 type EnvoyContainer struct {
 	// Initial envoy configuration.
@@ -330,8 +466,26 @@ type EnvoyBootstrap struct {
 	//
 	// +optional
 	ComponentLogLevels map[string]string `json:"componentLogLevels,omitempty"`
+
+	// XdsMode is the xDS protocol variant the proxy uses to receive its configuration
+	// from the control plane. Defaults to "StateOfTheWorld". With "Delta", the proxy
+	// uses incremental xDS and only receives the resources that changed in an update.
+	//
+	// +optional
+	XdsMode *XdsMode `json:"xdsMode,omitempty"`
 }
 
+// XdsMode is the xDS protocol variant used by the proxy.
+// +kubebuilder:validation:Enum=StateOfTheWorld;Delta
+type XdsMode string
+
+const (
+	// XdsModeStateOfTheWorld sends all resources of a type on every update.
+	XdsModeStateOfTheWorld XdsMode = "StateOfTheWorld"
+	// XdsModeDelta only sends the resources that were added, changed or removed.
+	XdsModeDelta XdsMode = "Delta"
+)
+
 func (in *EnvoyBootstrap) GetLogLevel() *string {
 	if in == nil {
 		return nil
@@ -346,6 +500,13 @@ func (in *EnvoyBootstrap) GetComponentLogLevels() map[string]string {
 	return in.ComponentLogLevels
 }
 
+func (in *EnvoyBootstrap) GetXdsMode() *XdsMode {
+	if in == nil {
+		return nil
+	}
+	return in.XdsMode
+}
+
 // SdsContainer configures the container running SDS sidecar.
 type SdsContainer struct {
 	// The SDS container image. See
@@ -410,6 +571,18 @@ type SdsBootstrap struct {
 	//
 	// +optional
 	LogLevel *string `json:"logLevel,omitempty"`
+
+	// Names of Kubernetes TLS Secrets in the namespace of the Gateway that SDS
+	// watches and serves directly, so that rotated certificates are picked up
+	// without waiting for volume mounts to be updated. Each Secret is served
+	// under the `kube_secret~<namespace>~<name>` SDS resource name, and its
+	// `ca.crt` under `kube_secret~<namespace>~<name>~validation_context`.
+	// The proxy ServiceAccount is granted read access to these Secrets only.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	// +listType=set
+	SecretNames []string `json:"secretNames,omitempty"`
 }
 
 func (in *SdsBootstrap) GetLogLevel() *string {
@@ -419,6 +592,13 @@ func (in *SdsBootstrap) GetLogLevel() *string {
 	return in.LogLevel
 }
 
+func (in *SdsBootstrap) GetSecretNames() []string {
+	if in == nil {
+		return nil
+	}
+	return in.SecretNames
+}
+
 // IstioIntegration configures the Istio integration settings used by a kgateway's data plane (Envoy proxy instance)
 type IstioIntegration struct {
 	// Configuration for the container running istio-proxy.
@@ -35,6 +35,9 @@ type HTTPListenerPolicyList struct {
 }
 
 // HTTPListenerPolicySpec defines the desired state of a HTTP listener policy.
+// When several policies set the same field for a listener, policies attached to the listener take
+// precedence over policies attached to the Gateway, then newer policies over older ones. The policies
+// whose fields are overridden report it with the Conflicted condition.
 type HTTPListenerPolicySpec struct {
 	// TargetRefs specifies the target resources by reference to attach the policy to.
 	// +optional
@@ -88,6 +91,95 @@ type HTTPListenerPolicySpec struct {
 	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/network/http_connection_manager/v3/http_connection_manager.proto#envoy-v3-api-field-extensions-filters-network-http-connection-manager-v3-httpconnectionmanager-stream-idle-timeout
 	// +optional
 	StreamIdleTimeout *metav1.Duration `json:"streamIdleTimeout,omitempty"`
+
+	// IdleTimeout is the time after which a downstream connection with no active streams is closed.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/core/v3/protocol.proto#envoy-v3-api-field-config-core-v3-httpprotocoloptions-idle-timeout
+	// +optional
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
+
+	// MaxConnectionDuration is the maximum duration of a downstream connection, after which it is drained and closed.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/core/v3/protocol.proto#envoy-v3-api-field-config-core-v3-httpprotocoloptions-max-connection-duration
+	// +optional
+	MaxConnectionDuration *metav1.Duration `json:"maxConnectionDuration,omitempty"`
+
+	// RequestTimeout is the time allowed to receive the entire request from the client.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/network/http_connection_manager/v3/http_connection_manager.proto#envoy-v3-api-field-extensions-filters-network-http-connection-manager-v3-httpconnectionmanager-request-timeout
+	// +optional
+	RequestTimeout *metav1.Duration `json:"requestTimeout,omitempty"`
+
+	// RequestHeadersTimeout is the time allowed to receive the request headers from the client.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/network/http_connection_manager/v3/http_connection_manager.proto#envoy-v3-api-field-extensions-filters-network-http-connection-manager-v3-httpconnectionmanager-request-headers-timeout
+	// +optional
+	RequestHeadersTimeout *metav1.Duration `json:"requestHeadersTimeout,omitempty"`
+
+	// MaxRequestHeadersKb is the maximum size of the request headers, in KiB. Defaults to 60.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/network/http_connection_manager/v3/http_connection_manager.proto#envoy-v3-api-field-extensions-filters-network-http-connection-manager-v3-httpconnectionmanager-max-request-headers-kb
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=8192
+	// +optional
+	MaxRequestHeadersKb *uint32 `json:"maxRequestHeadersKb,omitempty"`
+
+	// MaxHeadersCount is the maximum number of request headers. Defaults to 100.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/core/v3/protocol.proto#envoy-v3-api-field-config-core-v3-httpprotocoloptions-max-headers-count
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxHeadersCount *uint32 `json:"maxHeadersCount,omitempty"`
+
+	// PreserveExternalRequestId determines whether the x-request-id header of requests from external clients is kept.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/network/http_connection_manager/v3/http_connection_manager.proto#envoy-v3-api-field-extensions-filters-network-http-connection-manager-v3-httpconnectionmanager-preserve-external-request-id
+	// +optional
+	PreserveExternalRequestId *bool `json:"preserveExternalRequestId,omitempty"`
+
+	// GenerateRequestId determines whether a x-request-id header is generated for requests that don't have one. Defaults to true.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/network/http_connection_manager/v3/http_connection_manager.proto#envoy-v3-api-field-extensions-filters-network-http-connection-manager-v3-httpconnectionmanager-generate-request-id
+	// +optional
+	GenerateRequestId *bool `json:"generateRequestId,omitempty"`
+
+	// NormalizePath determines whether the request path is normalized according to RFC 3986 before routing. Defaults to true.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/network/http_connection_manager/v3/http_connection_manager.proto#envoy-v3-api-field-extensions-filters-network-http-connection-manager-v3-httpconnectionmanager-normalize-path
+	// +optional
+	NormalizePath *bool `json:"normalizePath,omitempty"`
+
+	// MergeSlashes determines whether adjacent slashes in the request path are merged into one before routing.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/network/http_connection_manager/v3/http_connection_manager.proto#envoy-v3-api-field-extensions-filters-network-http-connection-manager-v3-httpconnectionmanager-merge-slashes
+	// +optional
+	MergeSlashes *bool `json:"mergeSlashes,omitempty"`
+
+	// PathWithEscapedSlashesAction determines what is done with requests whose path contains escaped slashes.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/network/http_connection_manager/v3/http_connection_manager.proto#envoy-v3-api-field-extensions-filters-network-http-connection-manager-v3-httpconnectionmanager-path-with-escaped-slashes-action
+	// +kubebuilder:validation:Enum=KeepUnchanged;RejectRequest;UnescapeAndRedirect;UnescapeAndForward
+	// +optional
+	PathWithEscapedSlashesAction *PathWithEscapedSlashesAction `json:"pathWithEscapedSlashesAction,omitempty"`
+
+	// ForwardClientCertDetails determines how the x-forwarded-client-cert (XFCC) header is handled.
+	// Defaults to SanitizeSet on listeners validating client certificates with `tls.frontendValidation`, and to Sanitize otherwise.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/network/http_connection_manager/v3/http_connection_manager.proto#envoy-v3-api-field-extensions-filters-network-http-connection-manager-v3-httpconnectionmanager-forward-client-cert-details
+	// +kubebuilder:validation:Enum=Sanitize;ForwardOnly;AppendForward;SanitizeSet;AlwaysForwardOnly
+	// +optional
+	ForwardClientCertDetails *ForwardClientCertDetails `json:"forwardClientCertDetails,omitempty"`
+
+	// SetCurrentClientCertDetails selects the fields of the client certificate added to the XFCC header
+	// when ForwardClientCertDetails is AppendForward or SanitizeSet.
+	// Defaults to the subject, URI and DNS SANs on listeners validating client certificates.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/network/http_connection_manager/v3/http_connection_manager.proto#envoy-v3-api-msg-extensions-filters-network-http-connection-manager-v3-httpconnectionmanager-setcurrentclientcertdetails
+	// +optional
+	SetCurrentClientCertDetails *ClientCertDetails `json:"setCurrentClientCertDetails,omitempty"`
+
+	// Http1ProtocolOptions configures the HTTP/1 connections of the clients.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/core/v3/protocol.proto#envoy-v3-api-msg-config-core-v3-http1protocoloptions
+	// +optional
+	Http1ProtocolOptions *Http1ProtocolOptions `json:"http1ProtocolOptions,omitempty"`
+
+	// Http2ProtocolOptions configures the HTTP/2 connections of the clients.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/core/v3/protocol.proto#envoy-v3-api-msg-config-core-v3-http2protocoloptions
+	// +optional
+	Http2ProtocolOptions *Http2ProtocolOptions `json:"http2ProtocolOptions,omitempty"`
+
+	// Compression configures response compression and request decompression for the listeners.
+	// Both can be enabled or disabled for individual routes with the compression settings of a TrafficPolicy.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/compressor_filter
+	// +optional
+	Compression *Compression `json:"compression,omitempty"`
 }
 
 // AccessLog represents the top-level access log configuration.
@@ -398,6 +490,8 @@ type Tracing struct {
 	ClientSampling *uint32 `json:"clientSampling,omitempty"`
 
 	// Target percentage of requests managed by this HTTP connection manager that will be randomly selected for trace generation, if not requested by the client or not forced. Defaults to 100%
+	// Requests that already carry a sampling decision, such as a sampled flag in the traceparent header, keep it,
+	// so this is the sampling percentage of the traces started by Envoy.
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:validation:Maximum=100
@@ -544,6 +638,71 @@ type MetadataPathSegment struct {
 type TracingProvider struct {
 	// Tracing contains various settings for Envoy's OTel tracer.
 	OpenTelemetry *OpenTelemetryTracingConfig `json:"openTelemetry,omitempty"`
+
+	// Zipkin sends traces to a Zipkin compatible collector.
+	Zipkin *ZipkinTracingConfig `json:"zipkin,omitempty"`
+
+	// Datadog sends traces to a Datadog agent.
+	Datadog *DatadogTracingConfig `json:"datadog,omitempty"`
+}
+
+// ZipkinTracingConfig represents the top-level Envoy's Zipkin tracer.
+// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/trace/v3/zipkin.proto.html
+type ZipkinTracingConfig struct {
+	// The Zipkin collector. Can be any type of supported backend (Kubernetes Service, kgateway Backend, etc..)
+	// +kubebuilder:validation:Required
+	BackendRef gwv1.BackendRef `json:"backendRef"`
+
+	// The API endpoint of the Zipkin collector where the spans will be sent. Defaults to `/api/v2/spans`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	CollectorEndpoint *string `json:"collectorEndpoint,omitempty"`
+
+	// The encoding of the spans sent to the collector. Defaults to `HTTP_JSON`
+	// +kubebuilder:validation:Optional
+	CollectorEndpointVersion *ZipkinCollectorEndpointVersion `json:"collectorEndpointVersion,omitempty"`
+
+	// The hostname of the collector, used as the Host header of the requests sent to it.
+	// Defaults to the name of the cluster of the collector.
+	// +kubebuilder:validation:Optional
+	CollectorHostname *string `json:"collectorHostname,omitempty"`
+
+	// Whether to generate 128-bit trace IDs instead of 64-bit ones. Defaults to false
+	// +kubebuilder:validation:Optional
+	TraceId128Bit *bool `json:"traceId128Bit,omitempty"`
+
+	// Whether client and server spans share the same span context. Defaults to true
+	// +kubebuilder:validation:Optional
+	SharedSpanContext *bool `json:"sharedSpanContext,omitempty"`
+}
+
+// ZipkinCollectorEndpointVersion is the encoding of the spans sent to a Zipkin collector.
+// +kubebuilder:validation:Enum=HTTP_JSON;HTTP_PROTO
+type ZipkinCollectorEndpointVersion string
+
+const (
+	// Zipkin API v2, JSON over HTTP.
+	ZipkinCollectorEndpointVersionHTTPJSON ZipkinCollectorEndpointVersion = "HTTP_JSON"
+	// Zipkin API v2, protobuf over HTTP.
+	ZipkinCollectorEndpointVersionHTTPProto ZipkinCollectorEndpointVersion = "HTTP_PROTO"
+)
+
+// DatadogTracingConfig represents the top-level Envoy's Datadog tracer.
+// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/trace/v3/datadog.proto.html
+type DatadogTracingConfig struct {
+	// The Datadog agent. Can be any type of supported backend (Kubernetes Service, kgateway Backend, etc..)
+	// +kubebuilder:validation:Required
+	BackendRef gwv1.BackendRef `json:"backendRef"`
+
+	// The name of the service reported to Datadog
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ServiceName string `json:"serviceName"`
+
+	// The hostname of the agent, used as the Host header of the requests sent to it.
+	// Defaults to the name of the cluster of the agent.
+	// +kubebuilder:validation:Optional
+	CollectorHostname *string `json:"collectorHostname,omitempty"`
 }
 
 // OpenTelemetryTracingConfig represents the top-level Envoy's OpenTelemetry tracer.
@@ -562,7 +721,7 @@ type OpenTelemetryTracingConfig struct {
 	ResourceDetectors []ResourceDetector `json:"resourceDetectors,omitempty"`
 
 	// Specifies the sampler to be used by the OpenTelemetry tracer. This field can be left empty. In this case, the default Envoy sampling decision is used.
-	// Currently supported values are `AlwaysOn`
+	// Currently supported values are `AlwaysOn`, `TraceIdRatio` and `ParentBased`
 	// +kubebuilder:validation:Optional
 	Sampler *Sampler `json:"sampler,omitempty"`
 }
@@ -582,11 +741,43 @@ type EnvironmentResourceDetectorConfig struct{}
 // +kubebuilder:validation:MinProperties=1
 type Sampler struct {
 	AlwaysOn *AlwaysOnConfig `json:"alwaysOnConfig,omitempty"`
+
+	// TraceIdRatio samples a ratio of the traces, based on their trace ID.
+	// Requires Envoy 1.35 or later.
+	TraceIdRatio *TraceIdRatioSamplerConfig `json:"traceIdRatio,omitempty"`
+
+	// ParentBased follows the sampling decision of the parent span, and uses the root sampler
+	// for the traces started by Envoy.
+	// Requires Envoy 1.35 or later.
+	ParentBased *ParentBasedSamplerConfig `json:"parentBased,omitempty"`
 }
 
 // AlwaysOnConfig specified the AlwaysOn samplerc
 type AlwaysOnConfig struct{}
 
+// TraceIdRatioSamplerConfig specifies the TraceIdRatio sampler.
+type TraceIdRatioSamplerConfig struct {
+	// The ratio of traces to sample, between 0 and 1, e.g. `0.01` to sample 1% of the traces.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]{1,6})?|1(\.0{1,6})?)$`
+	Ratio string `json:"ratio"`
+}
+
+// ParentBasedSamplerConfig specifies the ParentBased sampler.
+type ParentBasedSamplerConfig struct {
+	// The sampler used for the traces started by Envoy. Defaults to sampling all of them.
+	// +kubebuilder:validation:Optional
+	Root *ParentBasedRootSampler `json:"root,omitempty"`
+}
+
+// ParentBasedRootSampler defines the list of samplers supported as the root of the ParentBased sampler.
+// +kubebuilder:validation:MaxProperties=1
+// +kubebuilder:validation:MinProperties=1
+type ParentBasedRootSampler struct {
+	AlwaysOn     *AlwaysOnConfig            `json:"alwaysOnConfig,omitempty"`
+	TraceIdRatio *TraceIdRatioSamplerConfig `json:"traceIdRatio,omitempty"`
+}
+
 // GrpcStatus represents possible gRPC statuses.
 // +kubebuilder:validation:Enum=OK;CANCELED;UNKNOWN;INVALID_ARGUMENT;DEADLINE_EXCEEDED;NOT_FOUND;ALREADY_EXISTS;PERMISSION_DENIED;RESOURCE_EXHAUSTED;FAILED_PRECONDITION;ABORTED;OUT_OF_RANGE;UNIMPLEMENTED;INTERNAL;UNAVAILABLE;DATA_LOSS;UNAUTHENTICATED
 type GrpcStatus string
@@ -629,3 +820,116 @@ const (
 	// PassThroughServerHeaderTransformation passes through the server header unchanged.
 	PassThroughServerHeaderTransformation ServerHeaderTransformation = "PassThrough"
 )
+
+// PathWithEscapedSlashesAction determines the action for requests that contain %2F, %2f, %5C or %5c sequences in the URI path.
+type PathWithEscapedSlashesAction string
+
+const (
+	// KeepUnchangedPathWithEscapedSlashesAction keeps the escaped slashes as they are.
+	KeepUnchangedPathWithEscapedSlashesAction PathWithEscapedSlashesAction = "KeepUnchanged"
+	// RejectRequestPathWithEscapedSlashesAction rejects the request with a 400 response.
+	RejectRequestPathWithEscapedSlashesAction PathWithEscapedSlashesAction = "RejectRequest"
+	// UnescapeAndRedirectPathWithEscapedSlashesAction unescapes the slashes and redirects the client to the new path.
+	UnescapeAndRedirectPathWithEscapedSlashesAction PathWithEscapedSlashesAction = "UnescapeAndRedirect"
+	// UnescapeAndForwardPathWithEscapedSlashesAction unescapes the slashes and forwards the request with the new path.
+	UnescapeAndForwardPathWithEscapedSlashesAction PathWithEscapedSlashesAction = "UnescapeAndForward"
+)
+
+// ForwardClientCertDetails determines how the x-forwarded-client-cert (XFCC) header of requests is handled.
+type ForwardClientCertDetails string
+
+const (
+	// SanitizeForwardClientCertDetails removes the XFCC header from requests.
+	SanitizeForwardClientCertDetails ForwardClientCertDetails = "Sanitize"
+	// ForwardOnlyForwardClientCertDetails forwards the XFCC header of requests received over mTLS.
+	ForwardOnlyForwardClientCertDetails ForwardClientCertDetails = "ForwardOnly"
+	// AppendForwardForwardClientCertDetails appends the details of the client certificate to the XFCC header of requests received over mTLS.
+	AppendForwardForwardClientCertDetails ForwardClientCertDetails = "AppendForward"
+	// SanitizeSetForwardClientCertDetails replaces the XFCC header of requests received over mTLS with the details of the client certificate.
+	SanitizeSetForwardClientCertDetails ForwardClientCertDetails = "SanitizeSet"
+	// AlwaysForwardOnlyForwardClientCertDetails always forwards the XFCC header, whether the request was received over mTLS or not.
+	AlwaysForwardOnlyForwardClientCertDetails ForwardClientCertDetails = "AlwaysForwardOnly"
+)
+
+// ClientCertDetails selects the fields of the client certificate added to the XFCC header.
+type ClientCertDetails struct {
+	// Subject adds the subject of the client certificate.
+	// +optional
+	Subject *bool `json:"subject,omitempty"`
+
+	// Cert adds the URL-encoded PEM client certificate.
+	// +optional
+	Cert *bool `json:"cert,omitempty"`
+
+	// Chain adds the URL-encoded PEM client certificate chain, including the leaf certificate.
+	// +optional
+	Chain *bool `json:"chain,omitempty"`
+
+	// DNS adds the DNS SANs of the client certificate.
+	// +optional
+	DNS *bool `json:"dns,omitempty"`
+
+	// URI adds the URI SANs of the client certificate.
+	// +optional
+	URI *bool `json:"uri,omitempty"`
+}
+
+// Compression configures compression of HTTP message bodies.
+// +kubebuilder:validation:AtLeastOneOf=responseCompression;requestDecompression
+type Compression struct {
+	// ResponseCompression configures compression of response bodies based on the Accept-Encoding header of the request.
+	// +optional
+	ResponseCompression *ResponseCompression `json:"responseCompression,omitempty"`
+
+	// RequestDecompression configures decompression of request bodies based on the Content-Encoding header of the request.
+	// +optional
+	RequestDecompression *RequestDecompression `json:"requestDecompression,omitempty"`
+}
+
+// CompressionAlgorithm is a compression algorithm used to encode HTTP message bodies.
+// +kubebuilder:validation:Enum=Gzip;Brotli;Zstd
+type CompressionAlgorithm string
+
+const (
+	// CompressionAlgorithmGzip uses the gzip content encoding.
+	CompressionAlgorithmGzip CompressionAlgorithm = "Gzip"
+	// CompressionAlgorithmBrotli uses the br content encoding.
+	CompressionAlgorithmBrotli CompressionAlgorithm = "Brotli"
+	// CompressionAlgorithmZstd uses the zstd content encoding.
+	CompressionAlgorithmZstd CompressionAlgorithm = "Zstd"
+)
+
+// ResponseCompression configures compression of response bodies.
+type ResponseCompression struct {
+	// Algorithms are the compression algorithms offered to clients. When a client accepts several of them,
+	// the algorithm is chosen based on the quality values of the Accept-Encoding header.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=3
+	// +listType=set
+	Algorithms []CompressionAlgorithm `json:"algorithms"`
+
+	// MinContentLength is the minimum response size in bytes for a response to be compressed.
+	// Defaults to 30 bytes.
+	// +optional
+	MinContentLength *uint32 `json:"minContentLength,omitempty"`
+
+	// ContentTypes is the list of content types that are compressed. When unset, Envoy's default
+	// list of text, JSON, XML and JavaScript content types is used.
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MinLength=1
+	ContentTypes []string `json:"contentTypes,omitempty"`
+
+	// DisableOnETag disables compression of responses that contain an ETag header.
+	// +optional
+	DisableOnETag *bool `json:"disableOnETag,omitempty"`
+}
+
+// RequestDecompression configures decompression of request bodies.
+type RequestDecompression struct {
+	// Algorithms are the compression algorithms for which request bodies are decompressed.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=3
+	// +listType=set
+	Algorithms []CompressionAlgorithm `json:"algorithms"`
+}
@@ -0,0 +1,109 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +kubebuilder:rbac:groups=gateway.kgateway.dev,resources=listenerpolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.kgateway.dev,resources=listenerpolicies/status,verbs=get;update;patch
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:metadata:labels={app=kgateway,app.kubernetes.io/name=kgateway}
+// +kubebuilder:resource:categories=kgateway
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=Direct"
+type ListenerPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ListenerPolicySpec `json:"spec,omitempty"`
+
+	Status gwv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type ListenerPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ListenerPolicy `json:"items"`
+}
+
+// ListenerPolicySpec defines the desired state of a listener policy.
+//
+// Gateway listeners that share a port are served by the same Envoy listener, so the settings
+// that apply to the whole Envoy listener (proxyProtocol and perConnectionBufferLimitBytes)
+// are only applied when the policy targets all the Gateway listeners on the port. Otherwise
+// they are not applied and the policy is reported as Conflicted.
+// Policies that target a listener take precedence over policies that target the whole Gateway.
+type ListenerPolicySpec struct {
+	// TargetRefs specifies the target resources by reference to attach the policy to.
+	// Set the sectionName to the name of a listener to only target this listener.
+	// +optional
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:rule="self.all(r, r.kind == 'Gateway' && (!has(r.group) || r.group == 'gateway.networking.k8s.io'))",message="targetRefs may only reference Gateway resources"
+	TargetRefs []LocalPolicyTargetReferenceWithSectionName `json:"targetRefs,omitempty"`
+
+	// TargetSelectors specifies the target selectors to select resources to attach the policy to.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self.all(r, r.kind == 'Gateway' && (!has(r.group) || r.group == 'gateway.networking.k8s.io'))",message="targetSelectors may only reference Gateway resources"
+	TargetSelectors []LocalPolicyTargetSelector `json:"targetSelectors,omitempty"`
+
+	// ProxyProtocol configures the listener to expect a PROXY protocol header at the start of
+	// each connection, as sent by load balancers such as AWS NLB or HAProxy. The source and
+	// destination addresses of the connection are then restored from the header.
+	// See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/listener/proxy_protocol/v3/proxy_protocol.proto) for more details.
+	// +optional
+	ProxyProtocol *DownstreamProxyProtocol `json:"proxyProtocol,omitempty"`
+
+	// PerConnectionBufferLimitBytes is the soft limit on size of the read and write buffers of
+	// the downstream connections of the listener. It overrides the
+	// kgateway.dev/per-connection-buffer-limit annotation of the Gateway.
+	// If unspecified, an implementation defined default is applied (1MiB).
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	PerConnectionBufferLimitBytes *int `json:"perConnectionBufferLimitBytes,omitempty"`
+
+	// ConnectionLimit limits the number of concurrent downstream connections of the listener.
+	// See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/network/connection_limit/v3/connection_limit.proto) for more details.
+	// +optional
+	ConnectionLimit *ConnectionLimit `json:"connectionLimit,omitempty"`
+}
+
+// DownstreamProxyProtocol configures how the PROXY protocol header of downstream connections
+// is handled.
+type DownstreamProxyProtocol struct {
+	// AllowRequestsWithoutProxyProtocol allows connections that do not start with a PROXY
+	// protocol header. The addresses of these connections are left unchanged.
+	// Defaults to false, closing these connections.
+	// +optional
+	AllowRequestsWithoutProxyProtocol *bool `json:"allowRequestsWithoutProxyProtocol,omitempty"`
+
+	// Versions are the versions of the PROXY protocol that are accepted.
+	// Connections with a header of another version are closed.
+	// If unset, both versions are accepted.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=2
+	Versions []ProxyProtocolVersion `json:"versions,omitempty"`
+}
+
+// ConnectionLimit limits the number of concurrent connections of a listener.
+type ConnectionLimit struct {
+	// MaxConnections is the maximum number of concurrent connections of the listener.
+	// New connections above the limit are closed.
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	MaxConnections int64 `json:"maxConnections"`
+
+	// Delay is how long to wait before closing a connection that is above the limit,
+	// to slow down clients that reconnect immediately.
+	// If unset, the connection is closed immediately.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('0s')",message="delay must be a valid duration string"
+	Delay *metav1.Duration `json:"delay,omitempty"`
+}
@@ -93,6 +93,40 @@ type TrafficPolicySpec struct {
 	// Requests exceeding this size will return a 413 response.
 	// +optional
 	Buffer *Buffer `json:"buffer,omitempty"`
+
+	// JWT configures validation of JSON Web Tokens presented by clients.
+	// +optional
+	JWT *JWTAuthentication `json:"jwt,omitempty"`
+
+	// Authorization allows or denies requests based on their source and the resources they access.
+	// +optional
+	Authorization *Authorization `json:"authorization,omitempty"`
+
+	// FaultInjection injects delays and aborts into requests to test the resiliency of services.
+	// +optional
+	FaultInjection *FaultInjection `json:"faultInjection,omitempty"`
+
+	// Timeouts configures timeouts for requests.
+	//
+	// Each timeout is taken from the most specific source that sets it, in the following order of precedence:
+	// the `timeouts` of an HTTPRoute rule, then a TrafficPolicy targeting the HTTPRoute, then a TrafficPolicy
+	// targeting the listener, then a TrafficPolicy targeting the Gateway.
+	// +optional
+	Timeouts *Timeouts `json:"timeouts,omitempty"`
+
+	// Retry configures retries for requests.
+	//
+	// When an HTTPRoute rule also sets `retry`, the retry policy is merged with it: the attempts, codes and
+	// backoff of the rule, and its `backendRequest` timeout as the per-try timeout, take precedence over the
+	// values of the policy. A retry policy from a TrafficPolicy targeting a listener or Gateway does not apply
+	// to routes whose retry policy is set by a more specific TrafficPolicy.
+	// +optional
+	Retry *Retry `json:"retry,omitempty"`
+
+	// Compression enables or disables the response compression and request decompression
+	// configured by an HTTPListenerPolicy for the targeted routes.
+	// +optional
+	Compression *CompressionPolicy `json:"compression,omitempty"`
 }
 
 // TransformationPolicy config is used to modify envoy behavior at a route level.
@@ -217,7 +251,8 @@ type ExtAuthPolicy struct {
 	// +optional
 	WithRequestBody *BufferSettings `json:"withRequestBody,omitempty"`
 
-	// Additional context for the authorization service.
+	// Additional context for the authorization service. Only GRPC authorization services
+	// receive the context extensions.
 	// +optional
 	ContextExtensions map[string]string `json:"contextExtensions,omitempty"`
 }
@@ -290,6 +325,9 @@ type TokenBucket struct {
 }
 
 // RateLimitPolicy defines a global rate limiting policy using an external service.
+// When the policy targets a Gateway or a listener, its descriptors are sent for the requests of
+// every route of the matching virtual hosts, except for the routes that have their own global
+// rate limit policy for the same rate limit service.
 type RateLimitPolicy struct {
 	// Descriptors define the dimensions for rate limiting.
 	// These values are passed to the rate limit service which applies configured limits based on them.
@@ -314,7 +352,7 @@ type RateLimitDescriptor struct {
 }
 
 // RateLimitDescriptorEntryType defines the type of a rate limit descriptor entry.
-// +kubebuilder:validation:Enum=Generic;Header;RemoteAddress;Path
+// +kubebuilder:validation:Enum=Generic;Header;RemoteAddress;Path;QueryParameter;Metadata;MaskedRemoteAddress;Method;HeaderValueMatch
 type RateLimitDescriptorEntryType string
 
 const (
@@ -329,11 +367,34 @@ const (
 
 	// RateLimitDescriptorEntryTypePath represents a descriptor entry that uses the request path as its value.
 	RateLimitDescriptorEntryTypePath RateLimitDescriptorEntryType = "Path"
+
+	// RateLimitDescriptorEntryTypeQueryParameter represents a descriptor entry that extracts its value from a query parameter.
+	RateLimitDescriptorEntryTypeQueryParameter RateLimitDescriptorEntryType = "QueryParameter"
+
+	// RateLimitDescriptorEntryTypeMetadata represents a descriptor entry that extracts its value from the dynamic
+	// metadata of the request, e.g. a JWT claim written by the JWT filter.
+	RateLimitDescriptorEntryTypeMetadata RateLimitDescriptorEntryType = "Metadata"
+
+	// RateLimitDescriptorEntryTypeMaskedRemoteAddress represents a descriptor entry that uses the client's IP address,
+	// masked to a prefix length, as its value.
+	RateLimitDescriptorEntryTypeMaskedRemoteAddress RateLimitDescriptorEntryType = "MaskedRemoteAddress"
+
+	// RateLimitDescriptorEntryTypeMethod represents a descriptor entry that uses the request method as its value.
+	RateLimitDescriptorEntryTypeMethod RateLimitDescriptorEntryType = "Method"
+
+	// RateLimitDescriptorEntryTypeHeaderValueMatch represents a descriptor entry with a static value that is only
+	// added when the request headers match.
+	RateLimitDescriptorEntryTypeHeaderValueMatch RateLimitDescriptorEntryType = "HeaderValueMatch"
 )
 
 // RateLimitDescriptorEntry defines a single entry in a rate limit descriptor.
-// Only one entry type may be specified.
-// +kubebuilder:validation:XValidation:message="exactly one entry type must be specified",rule="(has(self.type) && (self.type == 'Generic' && has(self.generic) && !has(self.header)) || (self.type == 'Header' && has(self.header) && !has(self.generic)) || (self.type == 'RemoteAddress' && !has(self.generic) && !has(self.header)) || (self.type == 'Path' && !has(self.generic) && !has(self.header)))"
+// Only the field of the entry type may be specified.
+// +kubebuilder:validation:XValidation:message="generic must be specified if and only if type is Generic",rule="(self.type == 'Generic') == has(self.generic)"
+// +kubebuilder:validation:XValidation:message="header must be specified if and only if type is Header",rule="(self.type == 'Header') == has(self.header)"
+// +kubebuilder:validation:XValidation:message="queryParameter must be specified if and only if type is QueryParameter",rule="(self.type == 'QueryParameter') == has(self.queryParameter)"
+// +kubebuilder:validation:XValidation:message="metadata must be specified if and only if type is Metadata",rule="(self.type == 'Metadata') == has(self.metadata)"
+// +kubebuilder:validation:XValidation:message="maskedRemoteAddress may only be specified when type is MaskedRemoteAddress",rule="self.type == 'MaskedRemoteAddress' || !has(self.maskedRemoteAddress)"
+// +kubebuilder:validation:XValidation:message="headerValueMatch must be specified if and only if type is HeaderValueMatch",rule="(self.type == 'HeaderValueMatch') == has(self.headerValueMatch)"
 type RateLimitDescriptorEntry struct {
 	// Type specifies what kind of rate limit descriptor entry this is.
 	// +required
@@ -348,6 +409,28 @@ type RateLimitDescriptorEntry struct {
 	// This field must be specified when Type is Header.
 	// +optional
 	Header string `json:"header,omitempty"`
+
+	// QueryParameter specifies a query parameter to extract the descriptor value from.
+	// The name of the query parameter is used as the descriptor key.
+	// This field must be specified when Type is QueryParameter.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	QueryParameter string `json:"queryParameter,omitempty"`
+
+	// Metadata specifies the dynamic metadata to extract the descriptor value from.
+	// This field must be specified when Type is Metadata.
+	// +optional
+	Metadata *RateLimitDescriptorEntryMetadata `json:"metadata,omitempty"`
+
+	// MaskedRemoteAddress specifies the prefix lengths the client's IP address is masked to.
+	// It may only be specified when Type is MaskedRemoteAddress.
+	// +optional
+	MaskedRemoteAddress *RateLimitDescriptorEntryMaskedRemoteAddress `json:"maskedRemoteAddress,omitempty"`
+
+	// HeaderValueMatch contains the configuration for a descriptor entry added when the request headers match.
+	// This field must be specified when Type is HeaderValueMatch.
+	// +optional
+	HeaderValueMatch *RateLimitDescriptorEntryHeaderValueMatch `json:"headerValueMatch,omitempty"`
 }
 
 // RateLimitDescriptorEntryGeneric defines a generic key-value descriptor entry.
@@ -361,6 +444,76 @@ type RateLimitDescriptorEntryGeneric struct {
 	Value string `json:"value"`
 }
 
+// RateLimitDescriptorEntryMetadata defines a descriptor entry whose value is read from the dynamic metadata
+// of the request.
+type RateLimitDescriptorEntryMetadata struct {
+	// Key is the name of this descriptor entry.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+
+	// Namespace is the metadata namespace, usually the name of the filter writing the metadata,
+	// e.g. envoy.filters.http.jwt_authn.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+
+	// Path is the path of the string value in the metadata of the namespace.
+	// +required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Path []string `json:"path"`
+
+	// DefaultValue is the descriptor value used when the metadata is absent.
+	// When unset, the descriptor is not sent for requests without the metadata.
+	// +optional
+	DefaultValue *string `json:"defaultValue,omitempty"`
+}
+
+// RateLimitDescriptorEntryMaskedRemoteAddress defines the prefix lengths the client's IP address is masked to,
+// e.g. a V4PrefixLen of 24 limits the clients of a /24 subnet together.
+type RateLimitDescriptorEntryMaskedRemoteAddress struct {
+	// V4PrefixLen is the prefix length IPv4 addresses are masked to.
+	// When unset, the default behavior is 32, i.e. the full address.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=32
+	V4PrefixLen *int32 `json:"v4PrefixLen,omitempty"`
+
+	// V6PrefixLen is the prefix length IPv6 addresses are masked to.
+	// When unset, the default behavior is 128, i.e. the full address.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=128
+	V6PrefixLen *int32 `json:"v6PrefixLen,omitempty"`
+}
+
+// RateLimitDescriptorEntryHeaderValueMatch defines a descriptor entry with a static value that is added
+// depending on whether the request headers match.
+type RateLimitDescriptorEntryHeaderValueMatch struct {
+	// Key is the name of this descriptor entry.
+	// When unset, the default behavior is header_match.
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// Value is the static value for this descriptor entry.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Value string `json:"value"`
+
+	// ExpectMatch determines whether the entry is added when all the headers match, or when
+	// at least one of them does not match.
+	// When unset, the default behavior is true.
+	// +optional
+	ExpectMatch *bool `json:"expectMatch,omitempty"`
+
+	// Headers are the request headers to match.
+	// +required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Headers []gwv1.HTTPHeaderMatch `json:"headers"`
+}
+
 type CorsPolicy struct {
 	// +kubebuilder:pruning:PreserveUnknownFields
 	*gwv1.HTTPCORSFilter `json:",inline"`
@@ -398,3 +551,420 @@ type Buffer struct {
 	// +kubebuilder:validation:XValidation:message="maxRequestSize must be greater than 0 and less than 4Gi",rule="quantity(self).isGreaterThan(quantity('0')) && quantity(self).isLessThan(quantity('4Gi'))"
 	MaxRequestSize *resource.Quantity `json:"maxRequestSize"`
 }
+
+// JWTAuthentication configures validation of JSON Web Tokens (JWTs) on requests.
+// Requests are validated against the configured providers and rejected with a 401
+// response when the requirement set by Mode is not satisfied.
+type JWTAuthentication struct {
+	// Providers is the list of JWT providers that tokens are validated against.
+	// +required
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	Providers []JWTProvider `json:"providers"`
+
+	// Mode determines how the providers are combined into the requirement that
+	// requests must satisfy.
+	// +optional
+	// +kubebuilder:default=RequireAny
+	Mode JWTValidationMode `json:"mode,omitempty"`
+}
+
+// JWTValidationMode determines how the JWT providers of a policy are combined into
+// a requirement.
+// +kubebuilder:validation:Enum=RequireAny;RequireAll;AllowMissing;AllowMissingOrFailed
+type JWTValidationMode string
+
+const (
+	// JWTValidationModeRequireAny requires a valid token from at least one of the providers.
+	JWTValidationModeRequireAny JWTValidationMode = "RequireAny"
+
+	// JWTValidationModeRequireAll requires a valid token from every provider.
+	JWTValidationModeRequireAll JWTValidationMode = "RequireAll"
+
+	// JWTValidationModeAllowMissing allows requests without a token, but rejects
+	// requests that present a token that fails validation.
+	JWTValidationModeAllowMissing JWTValidationMode = "AllowMissing"
+
+	// JWTValidationModeAllowMissingOrFailed allows all requests. Tokens are still
+	// validated and their claims are extracted when validation succeeds.
+	JWTValidationModeAllowMissingOrFailed JWTValidationMode = "AllowMissingOrFailed"
+)
+
+// JWTProvider defines how to validate JWTs issued by a single issuer.
+type JWTProvider struct {
+	// Name is the unique name of the provider within the policy.
+	// The validated token payload is written to the dynamic metadata namespace
+	// `envoy.filters.http.jwt_authn` under this name.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// Issuer is the principal that issued the JWT, usually a URL or an email address.
+	// When set, it must match the `iss` claim of the token.
+	// +optional
+	Issuer string `json:"issuer,omitempty"`
+
+	// Audiences is the list of allowed audiences. When set, the `aud` claim of the
+	// token must contain at least one of them.
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	Audiences []string `json:"audiences,omitempty"`
+
+	// JWKS is the source of the JSON Web Key Set used to verify token signatures.
+	// +required
+	JWKS JWKS `json:"jwks"`
+
+	// ClaimsToHeaders copies claims from the validated token into request headers.
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	ClaimsToHeaders []JWTClaimToHeader `json:"claimsToHeaders,omitempty"`
+
+	// Forward keeps the token in the request forwarded to the backend.
+	// When unset, the token is removed after it has been validated.
+	// +optional
+	Forward *bool `json:"forward,omitempty"`
+}
+
+// JWKS defines the source of a JSON Web Key Set.
+// +kubebuilder:validation:ExactlyOneOf=remote;local
+type JWKS struct {
+	// Remote fetches the key set from a remote server.
+	// +optional
+	Remote *RemoteJWKS `json:"remote,omitempty"`
+
+	// Local provides the key set inline or from a Secret.
+	// +optional
+	Local *LocalJWKS `json:"local,omitempty"`
+}
+
+// RemoteJWKS fetches a JSON Web Key Set over HTTP.
+type RemoteJWKS struct {
+	// URL is the URL of the key set. Its host and path are used for the fetch request,
+	// which is sent to the backend referenced by BackendRef.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="isURL(self)",message="url must be a valid URL"
+	URL string `json:"url"`
+
+	// BackendRef references the backend that serves the key set.
+	// +required
+	BackendRef gwv1.BackendRef `json:"backendRef"`
+
+	// CacheDuration is the duration for which the fetched key set is cached.
+	// When unset, Envoy caches the key set for 10 minutes.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('0s')",message="cacheDuration must be a valid duration string"
+	CacheDuration *metav1.Duration `json:"cacheDuration,omitempty"`
+
+	// Timeout is the timeout of the fetch request. Defaults to 5s.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="timeout must be greater than 0s"
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// LocalJWKS provides a JSON Web Key Set without fetching it from a remote server.
+// +kubebuilder:validation:ExactlyOneOf=inline;secretRef
+type LocalJWKS struct {
+	// Inline is the key set in JSON format.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	Inline *string `json:"inline,omitempty"`
+
+	// SecretRef references a Secret in the same namespace as the policy that
+	// contains the key set in JSON format under the `jwks` key.
+	// +optional
+	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
+}
+
+// JWTClaimToHeader copies a claim of a validated token into a request header.
+type JWTClaimToHeader struct {
+	// Name is the name of the claim. Nested claims can be referenced using
+	// a period-separated path, e.g. `sub.name`.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Header is the name of the request header the claim value is copied to.
+	// +required
+	Header HeaderName `json:"header"`
+}
+
+// Authorization configures role-based access control for requests.
+// A request matches a rule when it matches at least one of the rule's principals
+// and at least one of its permissions. The Action determines what happens to
+// requests that match at least one rule.
+type Authorization struct {
+	// Action is the action to take for requests that match at least one rule.
+	// With Allow, requests that do not match any rule are denied.
+	// With Deny, requests that match a rule are denied and all others are allowed.
+	// +optional
+	// +kubebuilder:default=Allow
+	Action AuthorizationAction `json:"action,omitempty"`
+
+	// Rules is the list of rules to match requests against.
+	// +required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Rules []AuthorizationRule `json:"rules"`
+}
+
+// AuthorizationAction is the action applied to requests that match an authorization rule.
+// +kubebuilder:validation:Enum=Allow;Deny
+type AuthorizationAction string
+
+const (
+	// AuthorizationActionAllow allows requests that match a rule and denies all others.
+	AuthorizationActionAllow AuthorizationAction = "Allow"
+
+	// AuthorizationActionDeny denies requests that match a rule and allows all others.
+	AuthorizationActionDeny AuthorizationAction = "Deny"
+)
+
+// AuthorizationRule matches requests by who sent them and what they access.
+type AuthorizationRule struct {
+	// Principals define who the rule applies to. A request matches when it matches
+	// any of the principals. When empty, the rule applies to all requests.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Principals []AuthorizationPrincipal `json:"principals,omitempty"`
+
+	// Permissions define which requests the rule applies to. A request matches when
+	// it matches any of the permissions. When empty, the rule applies to all requests.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Permissions []AuthorizationPermission `json:"permissions,omitempty"`
+
+	// Condition is a CEL expression that must also evaluate to true for the rule to match.
+	// See https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/advanced/attributes
+	// for the attributes that are available to the expression.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	Condition *string `json:"condition,omitempty"`
+}
+
+// AuthorizationPrincipal identifies the sender of a request.
+// All of the specified fields must match for the principal to match.
+type AuthorizationPrincipal struct {
+	// SourceCIDRs is a list of CIDR ranges that the client address must be in.
+	// The client address honours the `X-Forwarded-For` header according to the
+	// listener's remote address settings.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	SourceCIDRs []string `json:"sourceCIDRs,omitempty"`
+
+	// Headers is a list of request headers that must all match.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Headers []gwv1.HTTPHeaderMatch `json:"headers,omitempty"`
+
+	// JWTClaims is a list of claims of a JWT validated by the `jwt` section
+	// of a TrafficPolicy that must all match.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	JWTClaims []JWTClaimMatch `json:"jwtClaims,omitempty"`
+}
+
+// JWTClaimMatch matches a claim of a validated JWT.
+type JWTClaimMatch struct {
+	// Provider is the name of the JWT provider that validated the token.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Provider string `json:"provider"`
+
+	// Name is the name of the claim. Nested claims can be referenced using
+	// a period-separated path, e.g. `sub.name`.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Values is the list of accepted claim values. The claim matches when it
+	// equals any of them, or when it is a list that contains any of them.
+	// +required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Values []string `json:"values"`
+}
+
+// AuthorizationPermission identifies what a request accesses.
+// All of the specified fields must match for the permission to match.
+type AuthorizationPermission struct {
+	// Paths is a list of path matches. The request path must match any of them.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Paths []gwv1.HTTPPathMatch `json:"paths,omitempty"`
+
+	// Methods is a list of HTTP methods. The request method must be any of them.
+	// +optional
+	// +kubebuilder:validation:MaxItems=9
+	Methods []gwv1.HTTPMethod `json:"methods,omitempty"`
+}
+
+// CompressionEnablement determines whether compression is enabled for a route.
+// +kubebuilder:validation:Enum=Enable;Disable
+type CompressionEnablement string
+
+const (
+	// CompressionEnable enables compression, overriding a policy with a lower precedence that disables it.
+	CompressionEnable CompressionEnablement = "Enable"
+	// CompressionDisable disables compression.
+	CompressionDisable CompressionEnablement = "Disable"
+)
+
+// CompressionPolicy enables or disables compression for routes.
+// +kubebuilder:validation:AtLeastOneOf=responseCompression;requestDecompression
+type CompressionPolicy struct {
+	// ResponseCompression enables or disables response compression.
+	// +optional
+	ResponseCompression *CompressionEnablement `json:"responseCompression,omitempty"`
+
+	// RequestDecompression enables or disables request decompression.
+	// +optional
+	RequestDecompression *CompressionEnablement `json:"requestDecompression,omitempty"`
+}
+
+// FaultInjection configures faults that are injected into requests.
+// +kubebuilder:validation:XValidation:rule="has(self.delay) || has(self.abort)",message="at least one of delay or abort must be specified"
+type FaultInjection struct {
+	// Delay injects a fixed delay before the request is forwarded upstream.
+	// +optional
+	Delay *FaultDelay `json:"delay,omitempty"`
+
+	// Abort aborts the request with the given status instead of forwarding it upstream.
+	// +optional
+	Abort *FaultAbort `json:"abort,omitempty"`
+
+	// Headers is a list of request headers that must all match for faults to be injected.
+	// When unset, faults are injected into all requests.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Headers []gwv1.HTTPHeaderMatch `json:"headers,omitempty"`
+}
+
+// FaultDelay injects latency into requests.
+type FaultDelay struct {
+	// FixedDelay is the duration requests are delayed by.
+	// +required
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="fixedDelay must be greater than 0s"
+	FixedDelay metav1.Duration `json:"fixedDelay"`
+
+	// Percentage is the percentage of requests that are delayed. Defaults to 100.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage *uint32 `json:"percentage,omitempty"`
+}
+
+// FaultAbort aborts requests with an HTTP or gRPC status.
+// +kubebuilder:validation:ExactlyOneOf=httpStatus;grpcStatus
+type FaultAbort struct {
+	// HTTPStatus is the HTTP status code returned for aborted requests.
+	// +optional
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	HTTPStatus *uint32 `json:"httpStatus,omitempty"`
+
+	// GRPCStatus is the gRPC status code returned for aborted requests.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=16
+	GRPCStatus *uint32 `json:"grpcStatus,omitempty"`
+
+	// Percentage is the percentage of requests that are aborted. Defaults to 100.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage *uint32 `json:"percentage,omitempty"`
+}
+
+// Timeouts configures timeouts for requests.
+type Timeouts struct {
+	// Request is the timeout for the entire request, from when the request is received until
+	// the response has been completely processed, including all retries. A value of 0s disables the timeout.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('0s')",message="request must be a valid duration string"
+	Request *metav1.Duration `json:"request,omitempty"`
+
+	// StreamIdle is the timeout for a request stream that sends or receives no data.
+	// It overrides the stream idle timeout of the listener. A value of 0s disables the timeout.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('0s')",message="streamIdle must be a valid duration string"
+	StreamIdle *metav1.Duration `json:"streamIdle,omitempty"`
+
+	// MaxStreamDuration is the maximum duration of a request stream, regardless of activity.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="maxStreamDuration must be greater than 0s"
+	MaxStreamDuration *metav1.Duration `json:"maxStreamDuration,omitempty"`
+}
+
+// RetryOnCondition is a condition under which a request is retried.
+// See https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/router_filter#x-envoy-retry-on
+// and https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/router_filter#x-envoy-retry-grpc-on
+// +kubebuilder:validation:Enum="5xx";gateway-error;reset;reset-before-request;connect-failure;envoy-ratelimited;retriable-4xx;refused-stream;retriable-status-codes;http3-post-connect-failure;cancelled;deadline-exceeded;internal;resource-exhausted;unavailable
+type RetryOnCondition string
+
+const (
+	RetryOnServerError             RetryOnCondition = "5xx"
+	RetryOnGatewayError            RetryOnCondition = "gateway-error"
+	RetryOnReset                   RetryOnCondition = "reset"
+	RetryOnResetBeforeRequest      RetryOnCondition = "reset-before-request"
+	RetryOnConnectFailure          RetryOnCondition = "connect-failure"
+	RetryOnEnvoyRateLimited        RetryOnCondition = "envoy-ratelimited"
+	RetryOnRetriable4xx            RetryOnCondition = "retriable-4xx"
+	RetryOnRefusedStream           RetryOnCondition = "refused-stream"
+	RetryOnRetriableStatusCodes    RetryOnCondition = "retriable-status-codes"
+	RetryOnHTTP3PostConnectFailure RetryOnCondition = "http3-post-connect-failure"
+	RetryOnCancelled               RetryOnCondition = "cancelled"
+	RetryOnDeadlineExceeded        RetryOnCondition = "deadline-exceeded"
+	RetryOnInternal                RetryOnCondition = "internal"
+	RetryOnResourceExhausted       RetryOnCondition = "resource-exhausted"
+	RetryOnUnavailable             RetryOnCondition = "unavailable"
+)
+
+// Retry configures retries for requests.
+type Retry struct {
+	// RetryOn is the list of conditions under which a request is retried.
+	// When unset, requests are retried on connection failures, refused streams,
+	// the gRPC statuses cancelled and unavailable, and the configured status codes.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=15
+	RetryOn []RetryOnCondition `json:"retryOn,omitempty"`
+
+	// Attempts is the maximum number of retries. Defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Attempts *int32 `json:"attempts,omitempty"`
+
+	// PerTryTimeout is the timeout for each attempt, including the first one.
+	// When unset, the request timeout applies to each attempt.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="perTryTimeout must be greater than 0s"
+	PerTryTimeout *metav1.Duration `json:"perTryTimeout,omitempty"`
+
+	// StatusCodes is the list of HTTP status codes that are retried.
+	// Setting status codes implies the `retriable-status-codes` retry condition.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	StatusCodes []gwv1.HTTPRouteRetryStatusCode `json:"statusCodes,omitempty"`
+
+	// Backoff configures the exponential backoff between retries.
+	// +optional
+	Backoff *RetryBackoff `json:"backoff,omitempty"`
+}
+
+// RetryBackoff configures an exponential backoff between retries.
+// +kubebuilder:validation:XValidation:rule="!has(self.maxInterval) || duration(self.maxInterval) >= duration(self.baseInterval)",message="maxInterval must be greater than or equal to baseInterval"
+type RetryBackoff struct {
+	// BaseInterval is the base interval between retries.
+	// +required
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="baseInterval must be greater than 0s"
+	BaseInterval metav1.Duration `json:"baseInterval"`
+
+	// MaxInterval is the maximum interval between retries. Defaults to 10 times the base interval.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="maxInterval must be greater than 0s"
+	MaxInterval *metav1.Duration `json:"maxInterval,omitempty"`
+}
@@ -5,9 +5,11 @@
 package v1alpha1
 
 import (
+	v2 "k8s.io/api/autoscaling/v2"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	apisv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
@@ -481,6 +483,163 @@ func (in *AuthHeaderOverride) DeepCopy() *AuthHeaderOverride {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Authorization) DeepCopyInto(out *Authorization) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AuthorizationRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Authorization.
+func (in *Authorization) DeepCopy() *Authorization {
+	if in == nil {
+		return nil
+	}
+	out := new(Authorization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationPermission) DeepCopyInto(out *AuthorizationPermission) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]apisv1.HTTPPathMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]apisv1.HTTPMethod, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationPermission.
+func (in *AuthorizationPermission) DeepCopy() *AuthorizationPermission {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationPermission)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationPrincipal) DeepCopyInto(out *AuthorizationPrincipal) {
+	*out = *in
+	if in.SourceCIDRs != nil {
+		in, out := &in.SourceCIDRs, &out.SourceCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]apisv1.HTTPHeaderMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.JWTClaims != nil {
+		in, out := &in.JWTClaims, &out.JWTClaims
+		*out = make([]JWTClaimMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationPrincipal.
+func (in *AuthorizationPrincipal) DeepCopy() *AuthorizationPrincipal {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationPrincipal)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationRule) DeepCopyInto(out *AuthorizationRule) {
+	*out = *in
+	if in.Principals != nil {
+		in, out := &in.Principals, &out.Principals
+		*out = make([]AuthorizationPrincipal, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]AuthorizationPermission, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Condition != nil {
+		in, out := &in.Condition, &out.Condition
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationRule.
+func (in *AuthorizationRule) DeepCopy() *AuthorizationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Autoscaling) DeepCopyInto(out *Autoscaling) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.TargetCPUUtilizationPercentage != nil {
+		in, out := &in.TargetCPUUtilizationPercentage, &out.TargetCPUUtilizationPercentage
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.TargetMemoryUtilizationPercentage != nil {
+		in, out := &in.TargetMemoryUtilizationPercentage, &out.TargetMemoryUtilizationPercentage
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Behavior != nil {
+		in, out := &in.Behavior, &out.Behavior
+		*out = new(v2.HorizontalPodAutoscalerBehavior)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Autoscaling.
+func (in *Autoscaling) DeepCopy() *Autoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(Autoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AwsAuth) DeepCopyInto(out *AwsAuth) {
 	*out = *in
@@ -664,6 +823,16 @@ func (in *BackendConfigPolicySpec) DeepCopyInto(out *BackendConfigPolicySpec) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ProxyProtocol != nil {
+		in, out := &in.ProxyProtocol, &out.ProxyProtocol
+		*out = new(UpstreamProxyProtocol)
+		**out = **in
+	}
 	if in.PerConnectionBufferLimitBytes != nil {
 		in, out := &in.PerConnectionBufferLimitBytes, &out.PerConnectionBufferLimitBytes
 		*out = new(int)
@@ -704,6 +873,16 @@ func (in *BackendConfigPolicySpec) DeepCopyInto(out *BackendConfigPolicySpec) {
 		*out = new(HealthCheck)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CircuitBreakers != nil {
+		in, out := &in.CircuitBreakers, &out.CircuitBreakers
+		*out = new(CircuitBreakers)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OutlierDetection != nil {
+		in, out := &in.OutlierDetection, &out.OutlierDetection
+		*out = new(OutlierDetection)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendConfigPolicySpec.
@@ -942,6 +1121,102 @@ func (in *CSRFPolicy) DeepCopy() *CSRFPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CircuitBreakerThresholds) DeepCopyInto(out *CircuitBreakerThresholds) {
+	*out = *in
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxPendingRequests != nil {
+		in, out := &in.MaxPendingRequests, &out.MaxPendingRequests
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxRequests != nil {
+		in, out := &in.MaxRequests, &out.MaxRequests
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitBreakerThresholds.
+func (in *CircuitBreakerThresholds) DeepCopy() *CircuitBreakerThresholds {
+	if in == nil {
+		return nil
+	}
+	out := new(CircuitBreakerThresholds)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CircuitBreakers) DeepCopyInto(out *CircuitBreakers) {
+	*out = *in
+	in.CircuitBreakerThresholds.DeepCopyInto(&out.CircuitBreakerThresholds)
+	if in.HighPriority != nil {
+		in, out := &in.HighPriority, &out.HighPriority
+		*out = new(CircuitBreakerThresholds)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitBreakers.
+func (in *CircuitBreakers) DeepCopy() *CircuitBreakers {
+	if in == nil {
+		return nil
+	}
+	out := new(CircuitBreakers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientCertDetails) DeepCopyInto(out *ClientCertDetails) {
+	*out = *in
+	if in.Subject != nil {
+		in, out := &in.Subject, &out.Subject
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Cert != nil {
+		in, out := &in.Cert, &out.Cert
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Chain != nil {
+		in, out := &in.Chain, &out.Chain
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.URI != nil {
+		in, out := &in.URI, &out.URI
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientCertDetails.
+func (in *ClientCertDetails) DeepCopy() *ClientCertDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientCertDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommonAccessLogGrpcService) DeepCopyInto(out *CommonAccessLogGrpcService) {
 	*out = *in
@@ -1058,6 +1333,76 @@ func (in *ComparisonFilter) DeepCopy() *ComparisonFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Compression) DeepCopyInto(out *Compression) {
+	*out = *in
+	if in.ResponseCompression != nil {
+		in, out := &in.ResponseCompression, &out.ResponseCompression
+		*out = new(ResponseCompression)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequestDecompression != nil {
+		in, out := &in.RequestDecompression, &out.RequestDecompression
+		*out = new(RequestDecompression)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Compression.
+func (in *Compression) DeepCopy() *Compression {
+	if in == nil {
+		return nil
+	}
+	out := new(Compression)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompressionPolicy) DeepCopyInto(out *CompressionPolicy) {
+	*out = *in
+	if in.ResponseCompression != nil {
+		in, out := &in.ResponseCompression, &out.ResponseCompression
+		*out = new(CompressionEnablement)
+		**out = **in
+	}
+	if in.RequestDecompression != nil {
+		in, out := &in.RequestDecompression, &out.RequestDecompression
+		*out = new(CompressionEnablement)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompressionPolicy.
+func (in *CompressionPolicy) DeepCopy() *CompressionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CompressionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionLimit) DeepCopyInto(out *ConnectionLimit) {
+	*out = *in
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionLimit.
+func (in *ConnectionLimit) DeepCopy() *ConnectionLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CorsPolicy) DeepCopyInto(out *CorsPolicy) {
 	*out = *in
@@ -1239,6 +1584,27 @@ func (in *CustomResponse) DeepCopy() *CustomResponse {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogTracingConfig) DeepCopyInto(out *DatadogTracingConfig) {
+	*out = *in
+	in.BackendRef.DeepCopyInto(&out.BackendRef)
+	if in.CollectorHostname != nil {
+		in, out := &in.CollectorHostname, &out.CollectorHostname
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogTracingConfig.
+func (in *DatadogTracingConfig) DeepCopy() *DatadogTracingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogTracingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DirectResponse) DeepCopyInto(out *DirectResponse) {
 	*out = *in
@@ -1328,6 +1694,31 @@ func (in *DirectResponseStatus) DeepCopy() *DirectResponseStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DownstreamProxyProtocol) DeepCopyInto(out *DownstreamProxyProtocol) {
+	*out = *in
+	if in.AllowRequestsWithoutProxyProtocol != nil {
+		in, out := &in.AllowRequestsWithoutProxyProtocol, &out.AllowRequestsWithoutProxyProtocol
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]ProxyProtocolVersion, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DownstreamProxyProtocol.
+func (in *DownstreamProxyProtocol) DeepCopy() *DownstreamProxyProtocol {
+	if in == nil {
+		return nil
+	}
+	out := new(DownstreamProxyProtocol)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DurationFilter) DeepCopyInto(out *DurationFilter) {
 	*out = *in
@@ -1388,6 +1779,11 @@ func (in *EnvoyBootstrap) DeepCopyInto(out *EnvoyBootstrap) {
 			(*out)[key] = val
 		}
 	}
+	if in.XdsMode != nil {
+		in, out := &in.XdsMode, &out.XdsMode
+		*out = new(XdsMode)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyBootstrap.
@@ -1443,12 +1839,53 @@ func (in *EnvoyContainer) DeepCopy() *EnvoyContainer {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExtAuthPolicy) DeepCopyInto(out *ExtAuthPolicy) {
+func (in *ExtAuthHttpService) DeepCopyInto(out *ExtAuthHttpService) {
 	*out = *in
-	if in.ExtensionRef != nil {
-		in, out := &in.ExtensionRef, &out.ExtensionRef
-		*out = new(v1.LocalObjectReference)
-		**out = **in
+	if in.BackendRef != nil {
+		in, out := &in.BackendRef, &out.BackendRef
+		*out = new(apisv1.BackendRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedUpstreamHeaders != nil {
+		in, out := &in.AllowedUpstreamHeaders, &out.AllowedUpstreamHeaders
+		*out = make([]StringMatcher, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowedClientHeaders != nil {
+		in, out := &in.AllowedClientHeaders, &out.AllowedClientHeaders
+		*out = make([]StringMatcher, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowedClientHeadersOnSuccess != nil {
+		in, out := &in.AllowedClientHeadersOnSuccess, &out.AllowedClientHeadersOnSuccess
+		*out = make([]StringMatcher, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtAuthHttpService.
+func (in *ExtAuthHttpService) DeepCopy() *ExtAuthHttpService {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtAuthHttpService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtAuthPolicy) DeepCopyInto(out *ExtAuthPolicy) {
+	*out = *in
+	if in.ExtensionRef != nil {
+		in, out := &in.ExtensionRef, &out.ExtensionRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
 	}
 	if in.WithRequestBody != nil {
 		in, out := &in.WithRequestBody, &out.WithRequestBody
@@ -1482,6 +1919,38 @@ func (in *ExtAuthProvider) DeepCopyInto(out *ExtAuthProvider) {
 		*out = new(ExtGrpcService)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HttpService != nil {
+		in, out := &in.HttpService, &out.HttpService
+		*out = new(ExtAuthHttpService)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.StatusOnError != nil {
+		in, out := &in.StatusOnError, &out.StatusOnError
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MetadataContextNamespaces != nil {
+		in, out := &in.MetadataContextNamespaces, &out.MetadataContextNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedRequestHeaders != nil {
+		in, out := &in.AllowedRequestHeaders, &out.AllowedRequestHeaders
+		*out = make([]StringMatcher, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HeaderMutationRules != nil {
+		in, out := &in.HeaderMutationRules, &out.HeaderMutationRules
+		*out = new(HeaderMutationRules)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtAuthProvider.
@@ -1564,6 +2033,89 @@ func (in *ExtProcProvider) DeepCopy() *ExtProcProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultAbort) DeepCopyInto(out *FaultAbort) {
+	*out = *in
+	if in.HTTPStatus != nil {
+		in, out := &in.HTTPStatus, &out.HTTPStatus
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.GRPCStatus != nil {
+		in, out := &in.GRPCStatus, &out.GRPCStatus
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultAbort.
+func (in *FaultAbort) DeepCopy() *FaultAbort {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultAbort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultDelay) DeepCopyInto(out *FaultDelay) {
+	*out = *in
+	out.FixedDelay = in.FixedDelay
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultDelay.
+func (in *FaultDelay) DeepCopy() *FaultDelay {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultDelay)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultInjection) DeepCopyInto(out *FaultInjection) {
+	*out = *in
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(FaultDelay)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Abort != nil {
+		in, out := &in.Abort, &out.Abort
+		*out = new(FaultAbort)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]apisv1.HTTPHeaderMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultInjection.
+func (in *FaultInjection) DeepCopy() *FaultInjection {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultInjection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FieldDefault) DeepCopyInto(out *FieldDefault) {
 	*out = *in
@@ -2031,6 +2583,86 @@ func (in *HTTPListenerPolicySpec) DeepCopyInto(out *HTTPListenerPolicySpec) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxConnectionDuration != nil {
+		in, out := &in.MaxConnectionDuration, &out.MaxConnectionDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RequestTimeout != nil {
+		in, out := &in.RequestTimeout, &out.RequestTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RequestHeadersTimeout != nil {
+		in, out := &in.RequestHeadersTimeout, &out.RequestHeadersTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxRequestHeadersKb != nil {
+		in, out := &in.MaxRequestHeadersKb, &out.MaxRequestHeadersKb
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxHeadersCount != nil {
+		in, out := &in.MaxHeadersCount, &out.MaxHeadersCount
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.PreserveExternalRequestId != nil {
+		in, out := &in.PreserveExternalRequestId, &out.PreserveExternalRequestId
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GenerateRequestId != nil {
+		in, out := &in.GenerateRequestId, &out.GenerateRequestId
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NormalizePath != nil {
+		in, out := &in.NormalizePath, &out.NormalizePath
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MergeSlashes != nil {
+		in, out := &in.MergeSlashes, &out.MergeSlashes
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PathWithEscapedSlashesAction != nil {
+		in, out := &in.PathWithEscapedSlashesAction, &out.PathWithEscapedSlashesAction
+		*out = new(PathWithEscapedSlashesAction)
+		**out = **in
+	}
+	if in.ForwardClientCertDetails != nil {
+		in, out := &in.ForwardClientCertDetails, &out.ForwardClientCertDetails
+		*out = new(ForwardClientCertDetails)
+		**out = **in
+	}
+	if in.SetCurrentClientCertDetails != nil {
+		in, out := &in.SetCurrentClientCertDetails, &out.SetCurrentClientCertDetails
+		*out = new(ClientCertDetails)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Http1ProtocolOptions != nil {
+		in, out := &in.Http1ProtocolOptions, &out.Http1ProtocolOptions
+		*out = new(Http1ProtocolOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Http2ProtocolOptions != nil {
+		in, out := &in.Http2ProtocolOptions, &out.Http2ProtocolOptions
+		*out = new(Http2ProtocolOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(Compression)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPListenerPolicySpec.
@@ -2059,6 +2691,31 @@ func (in *HeaderFilter) DeepCopy() *HeaderFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderMutationRules) DeepCopyInto(out *HeaderMutationRules) {
+	*out = *in
+	if in.AllowExpression != nil {
+		in, out := &in.AllowExpression, &out.AllowExpression
+		*out = new(string)
+		**out = **in
+	}
+	if in.DisallowExpression != nil {
+		in, out := &in.DisallowExpression, &out.DisallowExpression
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderMutationRules.
+func (in *HeaderMutationRules) DeepCopy() *HeaderMutationRules {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderMutationRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HeaderTransformation) DeepCopyInto(out *HeaderTransformation) {
 	*out = *in
@@ -2386,6 +3043,119 @@ func (in *IstioIntegration) DeepCopy() *IstioIntegration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWKS) DeepCopyInto(out *JWKS) {
+	*out = *in
+	if in.Remote != nil {
+		in, out := &in.Remote, &out.Remote
+		*out = new(RemoteJWKS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Local != nil {
+		in, out := &in.Local, &out.Local
+		*out = new(LocalJWKS)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWKS.
+func (in *JWKS) DeepCopy() *JWKS {
+	if in == nil {
+		return nil
+	}
+	out := new(JWKS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTAuthentication) DeepCopyInto(out *JWTAuthentication) {
+	*out = *in
+	if in.Providers != nil {
+		in, out := &in.Providers, &out.Providers
+		*out = make([]JWTProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTAuthentication.
+func (in *JWTAuthentication) DeepCopy() *JWTAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTClaimMatch) DeepCopyInto(out *JWTClaimMatch) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTClaimMatch.
+func (in *JWTClaimMatch) DeepCopy() *JWTClaimMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTClaimMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTClaimToHeader) DeepCopyInto(out *JWTClaimToHeader) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTClaimToHeader.
+func (in *JWTClaimToHeader) DeepCopy() *JWTClaimToHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTClaimToHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTProvider) DeepCopyInto(out *JWTProvider) {
+	*out = *in
+	if in.Audiences != nil {
+		in, out := &in.Audiences, &out.Audiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.JWKS.DeepCopyInto(&out.JWKS)
+	if in.ClaimsToHeaders != nil {
+		in, out := &in.ClaimsToHeaders, &out.ClaimsToHeaders
+		*out = make([]JWTClaimToHeader, len(*in))
+		copy(*out, *in)
+	}
+	if in.Forward != nil {
+		in, out := &in.Forward, &out.Forward
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTProvider.
+func (in *JWTProvider) DeepCopy() *JWTProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeyAnyValue) DeepCopyInto(out *KeyAnyValue) {
 	*out = *in
@@ -2525,6 +3295,109 @@ func (in *LLMProvider) DeepCopy() *LLMProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerPolicy) DeepCopyInto(out *ListenerPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerPolicy.
+func (in *ListenerPolicy) DeepCopy() *ListenerPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ListenerPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerPolicyList) DeepCopyInto(out *ListenerPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ListenerPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerPolicyList.
+func (in *ListenerPolicyList) DeepCopy() *ListenerPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ListenerPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerPolicySpec) DeepCopyInto(out *ListenerPolicySpec) {
+	*out = *in
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]LocalPolicyTargetReferenceWithSectionName, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TargetSelectors != nil {
+		in, out := &in.TargetSelectors, &out.TargetSelectors
+		*out = make([]LocalPolicyTargetSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ProxyProtocol != nil {
+		in, out := &in.ProxyProtocol, &out.ProxyProtocol
+		*out = new(DownstreamProxyProtocol)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PerConnectionBufferLimitBytes != nil {
+		in, out := &in.PerConnectionBufferLimitBytes, &out.PerConnectionBufferLimitBytes
+		*out = new(int)
+		**out = **in
+	}
+	if in.ConnectionLimit != nil {
+		in, out := &in.ConnectionLimit, &out.ConnectionLimit
+		*out = new(ConnectionLimit)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerPolicySpec.
+func (in *ListenerPolicySpec) DeepCopy() *ListenerPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoadBalancer) DeepCopyInto(out *LoadBalancer) {
 	*out = *in
@@ -2680,6 +3553,31 @@ func (in *LoadBalancerRoundRobinConfig) DeepCopy() *LoadBalancerRoundRobinConfig
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalJWKS) DeepCopyInto(out *LocalJWKS) {
+	*out = *in
+	if in.Inline != nil {
+		in, out := &in.Inline, &out.Inline
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalJWKS.
+func (in *LocalJWKS) DeepCopy() *LocalJWKS {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalJWKS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalPolicyTargetReference) DeepCopyInto(out *LocalPolicyTargetReference) {
 	*out = *in
@@ -2955,6 +3853,91 @@ func (in *OpenTelemetryTracingConfig) DeepCopy() *OpenTelemetryTracingConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutlierDetection) DeepCopyInto(out *OutlierDetection) {
+	*out = *in
+	if in.Consecutive5xx != nil {
+		in, out := &in.Consecutive5xx, &out.Consecutive5xx
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ConsecutiveGatewayErrors != nil {
+		in, out := &in.ConsecutiveGatewayErrors, &out.ConsecutiveGatewayErrors
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.BaseEjectionTime != nil {
+		in, out := &in.BaseEjectionTime, &out.BaseEjectionTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxEjectionTime != nil {
+		in, out := &in.MaxEjectionTime, &out.MaxEjectionTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxEjectionPercent != nil {
+		in, out := &in.MaxEjectionPercent, &out.MaxEjectionPercent
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.SuccessRate != nil {
+		in, out := &in.SuccessRate, &out.SuccessRate
+		*out = new(OutlierDetectionSuccessRate)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutlierDetection.
+func (in *OutlierDetection) DeepCopy() *OutlierDetection {
+	if in == nil {
+		return nil
+	}
+	out := new(OutlierDetection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutlierDetectionSuccessRate) DeepCopyInto(out *OutlierDetectionSuccessRate) {
+	*out = *in
+	if in.MinimumHosts != nil {
+		in, out := &in.MinimumHosts, &out.MinimumHosts
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.RequestVolume != nil {
+		in, out := &in.RequestVolume, &out.RequestVolume
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.StdevFactor != nil {
+		in, out := &in.StdevFactor, &out.StdevFactor
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnforcingPercentage != nil {
+		in, out := &in.EnforcingPercentage, &out.EnforcingPercentage
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutlierDetectionSuccessRate.
+func (in *OutlierDetectionSuccessRate) DeepCopy() *OutlierDetectionSuccessRate {
+	if in == nil {
+		return nil
+	}
+	out := new(OutlierDetectionSuccessRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Parameters) DeepCopyInto(out *Parameters) {
 	*out = *in
@@ -2990,6 +3973,51 @@ func (in *Parameters) DeepCopy() *Parameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParentBasedRootSampler) DeepCopyInto(out *ParentBasedRootSampler) {
+	*out = *in
+	if in.AlwaysOn != nil {
+		in, out := &in.AlwaysOn, &out.AlwaysOn
+		*out = new(AlwaysOnConfig)
+		**out = **in
+	}
+	if in.TraceIdRatio != nil {
+		in, out := &in.TraceIdRatio, &out.TraceIdRatio
+		*out = new(TraceIdRatioSamplerConfig)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParentBasedRootSampler.
+func (in *ParentBasedRootSampler) DeepCopy() *ParentBasedRootSampler {
+	if in == nil {
+		return nil
+	}
+	out := new(ParentBasedRootSampler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParentBasedSamplerConfig) DeepCopyInto(out *ParentBasedSamplerConfig) {
+	*out = *in
+	if in.Root != nil {
+		in, out := &in.Root, &out.Root
+		*out = new(ParentBasedRootSampler)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParentBasedSamplerConfig.
+func (in *ParentBasedSamplerConfig) DeepCopy() *ParentBasedSamplerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ParentBasedSamplerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PathOverride) DeepCopyInto(out *PathOverride) {
 	*out = *in
@@ -3083,7 +4111,32 @@ func (in *Pod) DeepCopy() *Pod {
 	if in == nil {
 		return nil
 	}
-	out := new(Pod)
+	out := new(Pod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodDisruptionBudget) DeepCopyInto(out *PodDisruptionBudget) {
+	*out = *in
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionBudget.
+func (in *PodDisruptionBudget) DeepCopy() *PodDisruptionBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(PodDisruptionBudget)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3295,6 +4348,16 @@ func (in *ProxyDeployment) DeepCopyInto(out *ProxyDeployment) {
 		*out = new(uint32)
 		**out = **in
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(Autoscaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudget)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyDeployment.
@@ -3362,6 +4425,21 @@ func (in *RateLimitDescriptorEntry) DeepCopyInto(out *RateLimitDescriptorEntry)
 		*out = new(RateLimitDescriptorEntryGeneric)
 		**out = **in
 	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(RateLimitDescriptorEntryMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaskedRemoteAddress != nil {
+		in, out := &in.MaskedRemoteAddress, &out.MaskedRemoteAddress
+		*out = new(RateLimitDescriptorEntryMaskedRemoteAddress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HeaderValueMatch != nil {
+		in, out := &in.HeaderValueMatch, &out.HeaderValueMatch
+		*out = new(RateLimitDescriptorEntryHeaderValueMatch)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitDescriptorEntry.
@@ -3389,6 +4467,83 @@ func (in *RateLimitDescriptorEntryGeneric) DeepCopy() *RateLimitDescriptorEntryG
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitDescriptorEntryHeaderValueMatch) DeepCopyInto(out *RateLimitDescriptorEntryHeaderValueMatch) {
+	*out = *in
+	if in.ExpectMatch != nil {
+		in, out := &in.ExpectMatch, &out.ExpectMatch
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]apisv1.HTTPHeaderMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitDescriptorEntryHeaderValueMatch.
+func (in *RateLimitDescriptorEntryHeaderValueMatch) DeepCopy() *RateLimitDescriptorEntryHeaderValueMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitDescriptorEntryHeaderValueMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitDescriptorEntryMaskedRemoteAddress) DeepCopyInto(out *RateLimitDescriptorEntryMaskedRemoteAddress) {
+	*out = *in
+	if in.V4PrefixLen != nil {
+		in, out := &in.V4PrefixLen, &out.V4PrefixLen
+		*out = new(int32)
+		**out = **in
+	}
+	if in.V6PrefixLen != nil {
+		in, out := &in.V6PrefixLen, &out.V6PrefixLen
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitDescriptorEntryMaskedRemoteAddress.
+func (in *RateLimitDescriptorEntryMaskedRemoteAddress) DeepCopy() *RateLimitDescriptorEntryMaskedRemoteAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitDescriptorEntryMaskedRemoteAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitDescriptorEntryMetadata) DeepCopyInto(out *RateLimitDescriptorEntryMetadata) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DefaultValue != nil {
+		in, out := &in.DefaultValue, &out.DefaultValue
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitDescriptorEntryMetadata.
+func (in *RateLimitDescriptorEntryMetadata) DeepCopy() *RateLimitDescriptorEntryMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitDescriptorEntryMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RateLimitPolicy) DeepCopyInto(out *RateLimitPolicy) {
 	*out = *in
@@ -3493,6 +4648,52 @@ func (in *RegexMatch) DeepCopy() *RegexMatch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteJWKS) DeepCopyInto(out *RemoteJWKS) {
+	*out = *in
+	in.BackendRef.DeepCopyInto(&out.BackendRef)
+	if in.CacheDuration != nil {
+		in, out := &in.CacheDuration, &out.CacheDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteJWKS.
+func (in *RemoteJWKS) DeepCopy() *RemoteJWKS {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteJWKS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestDecompression) DeepCopyInto(out *RequestDecompression) {
+	*out = *in
+	if in.Algorithms != nil {
+		in, out := &in.Algorithms, &out.Algorithms
+		*out = make([]CompressionAlgorithm, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestDecompression.
+func (in *RequestDecompression) DeepCopy() *RequestDecompression {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestDecompression)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceDetector) DeepCopyInto(out *ResourceDetector) {
 	*out = *in
@@ -3513,6 +4714,41 @@ func (in *ResourceDetector) DeepCopy() *ResourceDetector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponseCompression) DeepCopyInto(out *ResponseCompression) {
+	*out = *in
+	if in.Algorithms != nil {
+		in, out := &in.Algorithms, &out.Algorithms
+		*out = make([]CompressionAlgorithm, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinContentLength != nil {
+		in, out := &in.MinContentLength, &out.MinContentLength
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ContentTypes != nil {
+		in, out := &in.ContentTypes, &out.ContentTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DisableOnETag != nil {
+		in, out := &in.DisableOnETag, &out.DisableOnETag
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseCompression.
+func (in *ResponseCompression) DeepCopy() *ResponseCompression {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponseCompression)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResponseFlagFilter) DeepCopyInto(out *ResponseFlagFilter) {
 	*out = *in
@@ -3533,6 +4769,67 @@ func (in *ResponseFlagFilter) DeepCopy() *ResponseFlagFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Retry) DeepCopyInto(out *Retry) {
+	*out = *in
+	if in.RetryOn != nil {
+		in, out := &in.RetryOn, &out.RetryOn
+		*out = make([]RetryOnCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.Attempts != nil {
+		in, out := &in.Attempts, &out.Attempts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PerTryTimeout != nil {
+		in, out := &in.PerTryTimeout, &out.PerTryTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.StatusCodes != nil {
+		in, out := &in.StatusCodes, &out.StatusCodes
+		*out = make([]apisv1.HTTPRouteRetryStatusCode, len(*in))
+		copy(*out, *in)
+	}
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(RetryBackoff)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Retry.
+func (in *Retry) DeepCopy() *Retry {
+	if in == nil {
+		return nil
+	}
+	out := new(Retry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryBackoff) DeepCopyInto(out *RetryBackoff) {
+	*out = *in
+	out.BaseInterval = in.BaseInterval
+	if in.MaxInterval != nil {
+		in, out := &in.MaxInterval, &out.MaxInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryBackoff.
+func (in *RetryBackoff) DeepCopy() *RetryBackoff {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryBackoff)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
 	*out = *in
@@ -3566,6 +4863,16 @@ func (in *Sampler) DeepCopyInto(out *Sampler) {
 		*out = new(AlwaysOnConfig)
 		**out = **in
 	}
+	if in.TraceIdRatio != nil {
+		in, out := &in.TraceIdRatio, &out.TraceIdRatio
+		*out = new(TraceIdRatioSamplerConfig)
+		**out = **in
+	}
+	if in.ParentBased != nil {
+		in, out := &in.ParentBased, &out.ParentBased
+		*out = new(ParentBasedSamplerConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Sampler.
@@ -3586,6 +4893,11 @@ func (in *SdsBootstrap) DeepCopyInto(out *SdsBootstrap) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.SecretNames != nil {
+		in, out := &in.SecretNames, &out.SecretNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SdsBootstrap.
@@ -4029,6 +5341,36 @@ func (in *TLSFiles) DeepCopy() *TLSFiles {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Timeouts) DeepCopyInto(out *Timeouts) {
+	*out = *in
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.StreamIdle != nil {
+		in, out := &in.StreamIdle, &out.StreamIdle
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxStreamDuration != nil {
+		in, out := &in.MaxStreamDuration, &out.MaxStreamDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Timeouts.
+func (in *Timeouts) DeepCopy() *Timeouts {
+	if in == nil {
+		return nil
+	}
+	out := new(Timeouts)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TokenBucket) DeepCopyInto(out *TokenBucket) {
 	*out = *in
@@ -4049,6 +5391,21 @@ func (in *TokenBucket) DeepCopy() *TokenBucket {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TraceIdRatioSamplerConfig) DeepCopyInto(out *TraceIdRatioSamplerConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraceIdRatioSamplerConfig.
+func (in *TraceIdRatioSamplerConfig) DeepCopy() *TraceIdRatioSamplerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TraceIdRatioSamplerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tracing) DeepCopyInto(out *Tracing) {
 	*out = *in
@@ -4110,6 +5467,16 @@ func (in *TracingProvider) DeepCopyInto(out *TracingProvider) {
 		*out = new(OpenTelemetryTracingConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Zipkin != nil {
+		in, out := &in.Zipkin, &out.Zipkin
+		*out = new(ZipkinTracingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Datadog != nil {
+		in, out := &in.Datadog, &out.Datadog
+		*out = new(DatadogTracingConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingProvider.
@@ -4243,6 +5610,36 @@ func (in *TrafficPolicySpec) DeepCopyInto(out *TrafficPolicySpec) {
 		*out = new(Buffer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.JWT != nil {
+		in, out := &in.JWT, &out.JWT
+		*out = new(JWTAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(Authorization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FaultInjection != nil {
+		in, out := &in.FaultInjection, &out.FaultInjection
+		*out = new(FaultInjection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeouts != nil {
+		in, out := &in.Timeouts, &out.Timeouts
+		*out = new(Timeouts)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(Retry)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(CompressionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficPolicySpec.
@@ -4335,6 +5732,21 @@ func (in *UpgradeConfig) DeepCopy() *UpgradeConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpstreamProxyProtocol) DeepCopyInto(out *UpstreamProxyProtocol) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamProxyProtocol.
+func (in *UpstreamProxyProtocol) DeepCopy() *UpstreamProxyProtocol {
+	if in == nil {
+		return nil
+	}
+	out := new(UpstreamProxyProtocol)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VertexAIConfig) DeepCopyInto(out *VertexAIConfig) {
 	*out = *in
@@ -4378,3 +5790,44 @@ func (in *Webhook) DeepCopy() *Webhook {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZipkinTracingConfig) DeepCopyInto(out *ZipkinTracingConfig) {
+	*out = *in
+	in.BackendRef.DeepCopyInto(&out.BackendRef)
+	if in.CollectorEndpoint != nil {
+		in, out := &in.CollectorEndpoint, &out.CollectorEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.CollectorEndpointVersion != nil {
+		in, out := &in.CollectorEndpointVersion, &out.CollectorEndpointVersion
+		*out = new(ZipkinCollectorEndpointVersion)
+		**out = **in
+	}
+	if in.CollectorHostname != nil {
+		in, out := &in.CollectorHostname, &out.CollectorHostname
+		*out = new(string)
+		**out = **in
+	}
+	if in.TraceId128Bit != nil {
+		in, out := &in.TraceId128Bit, &out.TraceId128Bit
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SharedSpanContext != nil {
+		in, out := &in.SharedSpanContext, &out.SharedSpanContext
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZipkinTracingConfig.
+func (in *ZipkinTracingConfig) DeepCopy() *ZipkinTracingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ZipkinTracingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
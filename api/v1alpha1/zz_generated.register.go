@@ -57,6 +57,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&GatewayParametersList{},
 		&HTTPListenerPolicy{},
 		&HTTPListenerPolicyList{},
+		&ListenerPolicy{},
+		&ListenerPolicyList{},
 		&TrafficPolicy{},
 		&TrafficPolicyList{},
 	)
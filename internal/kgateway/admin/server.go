@@ -13,12 +13,13 @@ import (
 	"istio.io/istio/pkg/kube/krt"
 
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/controller"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 )
 
 func RunAdminServer(ctx context.Context, setupOpts *controller.SetupOpts) error {
 	// serverHandlers defines the custom handlers that the Admin Server will support
-	serverHandlers := getServerHandlers(ctx, setupOpts.KrtDebugger, setupOpts.Cache)
+	serverHandlers := getServerHandlers(ctx, setupOpts.KrtDebugger, setupOpts.Cache, setupOpts.XdsNacks)
 
 	startHandlers(ctx, serverHandlers)
 
@@ -31,10 +32,17 @@ type dynamicProfileDescription func() string
 
 // getServerHandlers returns the custom handlers for the Admin Server, which will be bound to the http.ServeMux
 // These endpoints serve as the basis for an Admin Interface for the Control Plane (https://github.com/kgateway-dev/kgateway/issues/6494)
-func getServerHandlers(_ context.Context, dbg *krt.DebugHandler, cache envoycache.SnapshotCache) func(mux *http.ServeMux, profiles map[string]dynamicProfileDescription) {
+func getServerHandlers(
+	_ context.Context,
+	dbg *krt.DebugHandler,
+	cache envoycache.SnapshotCache,
+	nacks *krtcollections.XdsNacks,
+) func(mux *http.ServeMux, profiles map[string]dynamicProfileDescription) {
 	return func(m *http.ServeMux, profiles map[string]dynamicProfileDescription) {
 		addXdsSnapshotHandler("/snapshots/xds", m, profiles, cache)
 
+		addXdsNacksHandler("/xds/nacks", m, profiles, nacks)
+
 		addKrtSnapshotHandler("/snapshots/krt", m, profiles, dbg)
 
 		addLoggingHandler("/logging", m, profiles)
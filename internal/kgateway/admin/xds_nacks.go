@@ -0,0 +1,20 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+)
+
+// The xDS NACKs handler returns the last xDS response rejected by each connected proxy, keyed by the
+// node id of the proxy.
+func addXdsNacksHandler(path string, mux *http.ServeMux, profiles map[string]dynamicProfileDescription, nacks *krtcollections.XdsNacks) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		response := map[string]krtcollections.XdsNack{}
+		if nacks != nil {
+			response = nacks.LastNacks()
+		}
+		writeJSON(w, response, r)
+	})
+	profiles[path] = func() string { return "Last xDS response rejected by each proxy" }
+}
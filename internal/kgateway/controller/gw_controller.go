@@ -7,12 +7,14 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	api "sigs.k8s.io/gateway-api/apis/v1"
 
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/pkg/deployer"
 )
 
@@ -110,6 +112,15 @@ func (r *gatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (re
 		return result, err
 	}
 
+	// autoscaling and the PodDisruptionBudget may have been disabled since the last deployment
+	err = r.deployer.PruneObjs(ctx, &gw, objs, []schema.GroupVersionKind{
+		wellknown.HorizontalPodAutoscalerGVK,
+		wellknown.PodDisruptionBudgetGVK,
+	})
+	if err != nil {
+		return result, err
+	}
+
 	return result, nil
 }
 
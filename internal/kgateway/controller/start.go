@@ -30,6 +30,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/proxy_syncer"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils/krtutil"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/xds"
 	"github.com/kgateway-dev/kgateway/v2/pkg/client/clientset/versioned"
 	"github.com/kgateway-dev/kgateway/v2/pkg/deployer"
 	"github.com/kgateway-dev/kgateway/v2/pkg/logging"
@@ -50,6 +51,9 @@ const (
 type SetupOpts struct {
 	Cache envoycache.SnapshotCache
 
+	// XdsNacks records the xDS responses rejected by the proxies. It may be nil.
+	XdsNacks *krtcollections.XdsNacks
+
 	KrtDebugger *krt.DebugHandler
 
 	// static set of global Settings
@@ -205,6 +209,7 @@ func NewControllerBuilder(ctx context.Context, cfg StartConfig) (*ControllerBuil
 		mergedPlugins,
 		commoncol,
 		cfg.SetupOpts.Cache,
+		cfg.SetupOpts.XdsNacks,
 		cfg.AgentGatewayClassName,
 	)
 	proxySyncer.Init(ctx, cfg.KrtOptions)
@@ -281,16 +286,29 @@ func (c *ControllerBuilder) Start(ctx context.Context) error {
 	xdsPort := globalSettings.XdsServicePort
 	slog.Info("got xds address for deployer", "xds_host", xdsHost, "xds_port", xdsPort)
 
+	controlPlane := deployer.ControlPlaneInfo{
+		XdsHost: xdsHost,
+		XdsPort: xdsPort,
+	}
+	if globalSettings.XdsTlsCertDir != "" {
+		caCert, err := xds.ReadTLSCA(globalSettings.XdsTlsCertDir)
+		if err != nil {
+			return err
+		}
+		controlPlane.XdsTlsCaCert = caCert
+		controlPlane.XdsTlsClientCertSecretName = globalSettings.XdsTlsClientCertSecretName
+	}
+	if globalSettings.XdsAuth {
+		controlPlane.XdsTokenAudience = xds.TokenAudience
+	}
+
 	istioAutoMtlsEnabled := globalSettings.EnableIstioAutoMtls
 
 	gwCfg := GatewayConfig{
-		Mgr:            c.mgr,
-		ControllerName: c.cfg.ControllerName,
-		AutoProvision:  AutoProvision,
-		ControlPlane: deployer.ControlPlaneInfo{
-			XdsHost: xdsHost,
-			XdsPort: xdsPort,
-		},
+		Mgr:                  c.mgr,
+		ControllerName:       c.cfg.ControllerName,
+		AutoProvision:        AutoProvision,
+		ControlPlane:         controlPlane,
 		IstioAutoMtlsEnabled: istioAutoMtlsEnabled,
 		ImageInfo: &deployer.ImageInfo{
 			Registry:   globalSettings.DefaultImageRegistry,
@@ -55,9 +55,23 @@ func GatewayGVKsToWatch(ctx context.Context, d *deployer.Deployer) ([]schema.Gro
 	return d.GetGvksToWatch(ctx, map[string]any{
 		"gateway": map[string]any{
 			"istio": map[string]any{
-				"enabled": false,
+				"enabled": true,
+			},
+			"sdsContainer": map[string]any{
+				"image": map[string]any{
+					"repository": "sds",
+				},
+				"sdsBootstrap": map[string]any{
+					"secretNames": []any{"default"},
+				},
 			},
 			"image": map[string]any{},
+			"autoscaling": map[string]any{
+				"enabled": true,
+			},
+			"podDisruptionBudget": map[string]any{
+				"enabled": true,
+			},
 		},
 	})
 }
@@ -263,9 +277,13 @@ func (k *kGatewayParameters) getValues(gw *api.Gateway, gwParam *v1alpha1.Gatewa
 				// This is the socket address that the Proxy will connect to on startup, to receive xds updates
 				Host: &k.inputs.ControlPlane.XdsHost,
 				Port: &k.inputs.ControlPlane.XdsPort,
+				Tls:  deployer.GetXdsTlsValues(k.inputs.ControlPlane),
 			},
 		},
 	}
+	if audience := k.inputs.ControlPlane.XdsTokenAudience; audience != "" {
+		vals.Gateway.Xds.TokenAudience = &audience
+	}
 
 	// if there is no GatewayParameters, return the values as is
 	if gwParam == nil {
@@ -299,6 +317,8 @@ func (k *kGatewayParameters) getValues(gw *api.Gateway, gwParam *v1alpha1.Gatewa
 	gateway := vals.Gateway
 	// deployment values
 	gateway.ReplicaCount = deployConfig.GetReplicas()
+	gateway.Autoscaling = deployer.GetAutoscalingValues(deployConfig.GetAutoscaling())
+	gateway.PodDisruptionBudget = deployer.GetPodDisruptionBudgetValues(deployConfig.GetPodDisruptionBudget())
 
 	// service values
 	gateway.Service = deployer.GetServiceValues(svcConfig)
@@ -326,6 +346,7 @@ func (k *kGatewayParameters) getValues(gw *api.Gateway, gwParam *v1alpha1.Gatewa
 		return nil, err
 	}
 	gateway.ComponentLogLevel = &compLogLevelStr
+	gateway.Xds.ApiType = deployer.GetXdsApiType(envoyContainerConfig.GetBootstrap().GetXdsMode())
 
 	agentgatewayEnabled := agentGatewayConfig.GetEnabled()
 	if agentgatewayEnabled != nil && *agentgatewayEnabled {
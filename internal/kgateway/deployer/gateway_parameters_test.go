@@ -117,12 +117,16 @@ func TestGatewayGVKsToWatch(t *testing.T) {
 
 	gvks, err := GatewayGVKsToWatch(context.TODO(), d)
 	assert.NoError(t, err)
-	assert.Len(t, gvks, 4)
+	assert.Len(t, gvks, 8)
 	assert.ElementsMatch(t, gvks, []schema.GroupVersionKind{
 		wellknown.DeploymentGVK,
 		wellknown.ServiceGVK,
 		wellknown.ServiceAccountGVK,
 		wellknown.ConfigMapGVK,
+		wellknown.HorizontalPodAutoscalerGVK,
+		wellknown.PodDisruptionBudgetGVK,
+		wellknown.RoleGVK,
+		wellknown.RoleBindingGVK,
 	})
 }
 
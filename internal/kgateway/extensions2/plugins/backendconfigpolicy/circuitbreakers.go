@@ -0,0 +1,45 @@
+package backendconfigpolicy
+
+import (
+	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+func translateCircuitBreakers(cb *v1alpha1.CircuitBreakers) *clusterv3.CircuitBreakers {
+	if cb == nil {
+		return nil
+	}
+
+	circuitBreakers := &clusterv3.CircuitBreakers{
+		Thresholds: []*clusterv3.CircuitBreakers_Thresholds{
+			translateCircuitBreakerThresholds(corev3.RoutingPriority_DEFAULT, &cb.CircuitBreakerThresholds),
+		},
+	}
+	if cb.HighPriority != nil {
+		circuitBreakers.Thresholds = append(circuitBreakers.Thresholds,
+			translateCircuitBreakerThresholds(corev3.RoutingPriority_HIGH, cb.HighPriority))
+	}
+	return circuitBreakers
+}
+
+func translateCircuitBreakerThresholds(priority corev3.RoutingPriority, t *v1alpha1.CircuitBreakerThresholds) *clusterv3.CircuitBreakers_Thresholds {
+	thresholds := &clusterv3.CircuitBreakers_Thresholds{
+		Priority: priority,
+	}
+	if t.MaxConnections != nil {
+		thresholds.MaxConnections = &wrapperspb.UInt32Value{Value: *t.MaxConnections}
+	}
+	if t.MaxPendingRequests != nil {
+		thresholds.MaxPendingRequests = &wrapperspb.UInt32Value{Value: *t.MaxPendingRequests}
+	}
+	if t.MaxRequests != nil {
+		thresholds.MaxRequests = &wrapperspb.UInt32Value{Value: *t.MaxRequests}
+	}
+	if t.MaxRetries != nil {
+		thresholds.MaxRetries = &wrapperspb.UInt32Value{Value: *t.MaxRetries}
+	}
+	return thresholds
+}
@@ -0,0 +1,76 @@
+package backendconfigpolicy
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+func translateOutlierDetection(od *v1alpha1.OutlierDetection) (*clusterv3.OutlierDetection, error) {
+	if od == nil {
+		return nil, nil
+	}
+
+	if od.BaseEjectionTime != nil && od.MaxEjectionTime != nil && od.MaxEjectionTime.Duration < od.BaseEjectionTime.Duration {
+		return nil, fmt.Errorf("outlierDetection maxEjectionTime %s must not be smaller than baseEjectionTime %s",
+			od.MaxEjectionTime.Duration, od.BaseEjectionTime.Duration)
+	}
+
+	outlierDetection := &clusterv3.OutlierDetection{
+		// success rate based ejection is opt-in, unlike in Envoy
+		EnforcingSuccessRate: &wrapperspb.UInt32Value{Value: 0},
+	}
+	if od.Consecutive5xx != nil {
+		if *od.Consecutive5xx == 0 {
+			outlierDetection.EnforcingConsecutive_5Xx = &wrapperspb.UInt32Value{Value: 0}
+		} else {
+			outlierDetection.Consecutive_5Xx = &wrapperspb.UInt32Value{Value: *od.Consecutive5xx}
+		}
+	}
+	if od.ConsecutiveGatewayErrors != nil {
+		outlierDetection.ConsecutiveGatewayFailure = &wrapperspb.UInt32Value{Value: *od.ConsecutiveGatewayErrors}
+		// Envoy doesn't enforce ejections on consecutive gateway failures by default
+		outlierDetection.EnforcingConsecutiveGatewayFailure = &wrapperspb.UInt32Value{Value: 100}
+	}
+	if od.Interval != nil {
+		outlierDetection.Interval = durationpb.New(od.Interval.Duration)
+	}
+	if od.BaseEjectionTime != nil {
+		outlierDetection.BaseEjectionTime = durationpb.New(od.BaseEjectionTime.Duration)
+	}
+	if od.MaxEjectionTime != nil {
+		outlierDetection.MaxEjectionTime = durationpb.New(od.MaxEjectionTime.Duration)
+	}
+	if od.MaxEjectionPercent != nil {
+		outlierDetection.MaxEjectionPercent = &wrapperspb.UInt32Value{Value: *od.MaxEjectionPercent}
+	}
+
+	if sr := od.SuccessRate; sr != nil {
+		outlierDetection.EnforcingSuccessRate = &wrapperspb.UInt32Value{Value: 100}
+		if sr.EnforcingPercentage != nil {
+			outlierDetection.EnforcingSuccessRate = &wrapperspb.UInt32Value{Value: *sr.EnforcingPercentage}
+		}
+		if sr.MinimumHosts != nil {
+			outlierDetection.SuccessRateMinimumHosts = &wrapperspb.UInt32Value{Value: *sr.MinimumHosts}
+		}
+		if sr.RequestVolume != nil {
+			outlierDetection.SuccessRateRequestVolume = &wrapperspb.UInt32Value{Value: *sr.RequestVolume}
+		}
+		if sr.StdevFactor != nil {
+			factor, err := strconv.ParseFloat(*sr.StdevFactor, 64)
+			if err != nil || factor <= 0 || factor > math.MaxUint32/1000 {
+				return nil, fmt.Errorf("outlierDetection successRate stdevFactor %q must be a positive number", *sr.StdevFactor)
+			}
+			// Envoy expects the factor multiplied by 1000
+			outlierDetection.SuccessRateStdevFactor = &wrapperspb.UInt32Value{Value: uint32(math.Round(factor * 1000))}
+		}
+	}
+
+	return outlierDetection, nil
+}
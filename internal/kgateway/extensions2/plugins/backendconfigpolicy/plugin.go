@@ -24,6 +24,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/common"
 	extensionsplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	translatorutils "github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/utils"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/pkg/client/clientset/versioned"
@@ -31,11 +32,13 @@ import (
 	pluginsdkutils "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/utils"
 )
 
-const PreserveCasePlugin = "envoy.http.stateful_header_formatters.preserve_case"
+const PreserveCasePlugin = translatorutils.PreserveCasePlugin
 
 type BackendConfigPolicyIR struct {
 	ct                            time.Time
 	connectTimeout                *durationpb.Duration
+	idleTimeout                   *durationpb.Duration
+	proxyProtocol                 *corev3.ProxyProtocolConfig
 	perConnectionBufferLimitBytes *int
 	tcpKeepalive                  *corev3.TcpKeepalive
 	commonHttpProtocolOptions     *corev3.HttpProtocolOptions
@@ -44,6 +47,8 @@ type BackendConfigPolicyIR struct {
 	tlsConfig                     *envoyauth.UpstreamTlsContext
 	loadBalancerConfig            *LoadBalancerConfigIR
 	healthCheck                   *corev3.HealthCheck
+	circuitBreakers               *clusterv3.CircuitBreakers
+	outlierDetection              *clusterv3.OutlierDetection
 }
 
 var logger = logging.New("backendconfigpolicy")
@@ -73,6 +78,14 @@ func (d *BackendConfigPolicyIR) Equals(other any) bool {
 		}
 	}
 
+	if !proto.Equal(d.idleTimeout, d2.idleTimeout) {
+		return false
+	}
+
+	if !proto.Equal(d.proxyProtocol, d2.proxyProtocol) {
+		return false
+	}
+
 	if (d.perConnectionBufferLimitBytes == nil) != (d2.perConnectionBufferLimitBytes == nil) {
 		return false
 	}
@@ -138,6 +151,14 @@ func (d *BackendConfigPolicyIR) Equals(other any) bool {
 		return false
 	}
 
+	if !proto.Equal(d.circuitBreakers, d2.circuitBreakers) {
+		return false
+	}
+
+	if !proto.Equal(d.outlierDetection, d2.outlierDetection) {
+		return false
+	}
+
 	return true
 }
 
@@ -213,6 +234,7 @@ func processBackend(_ context.Context, polir ir.PolicyIR, backend ir.BackendObje
 	applyCommonHttpProtocolOptions(pol.commonHttpProtocolOptions, backend, out)
 	applyHttp1ProtocolOptions(pol.http1ProtocolOptions, backend, out)
 	applyHttp2ProtocolOptions(pol.http2ProtocolOptions, backend, out)
+	applyIdleTimeout(pol.idleTimeout, backend, out)
 
 	if pol.tlsConfig != nil {
 		typedConfig, err := utils.MessageToAny(pol.tlsConfig)
@@ -220,11 +242,22 @@ func processBackend(_ context.Context, polir ir.PolicyIR, backend ir.BackendObje
 			logger.Error("failed to convert tls config to any", "error", err)
 			return
 		}
-		out.TransportSocket = &corev3.TransportSocket{
+		err = translatorutils.SetTransportSocket(out, &corev3.TransportSocket{
 			Name: envoywellknown.TransportSocketTls,
 			ConfigType: &corev3.TransportSocket_TypedConfig{
 				TypedConfig: typedConfig,
 			},
+		})
+		if err != nil {
+			logger.Error("failed to set tls transport socket", "backend", backend.GetName(), "error", err)
+			return
+		}
+	}
+
+	if pol.proxyProtocol != nil {
+		if err := translatorutils.WrapTransportSocketWithProxyProtocol(out, pol.proxyProtocol); err != nil {
+			logger.Error("failed to apply proxy protocol", "backend", backend.GetName(), "error", err)
+			return
 		}
 	}
 
@@ -233,6 +266,13 @@ func processBackend(_ context.Context, polir ir.PolicyIR, backend ir.BackendObje
 	if pol.healthCheck != nil {
 		out.HealthChecks = []*corev3.HealthCheck{pol.healthCheck}
 	}
+
+	// the thresholds not set by the policy may be set by a DestinationRule
+	translatorutils.MergeCircuitBreakers(out, pol.circuitBreakers, true)
+
+	if pol.outlierDetection != nil {
+		out.OutlierDetection = pol.outlierDetection
+	}
 }
 
 func translate(commoncol *common.CommonCollections, krtctx krt.HandlerContext, pol *v1alpha1.BackendConfigPolicy) (*BackendConfigPolicyIR, error) {
@@ -242,6 +282,12 @@ func translate(commoncol *common.CommonCollections, krtctx krt.HandlerContext, p
 	if pol.Spec.ConnectTimeout != nil {
 		ir.connectTimeout = durationpb.New(pol.Spec.ConnectTimeout.Duration)
 	}
+	if pol.Spec.IdleTimeout != nil {
+		ir.idleTimeout = durationpb.New(pol.Spec.IdleTimeout.Duration)
+	}
+	if pol.Spec.ProxyProtocol != nil {
+		ir.proxyProtocol = translateProxyProtocol(pol.Spec.ProxyProtocol)
+	}
 	if pol.Spec.PerConnectionBufferLimitBytes != nil {
 		ir.perConnectionBufferLimitBytes = pol.Spec.PerConnectionBufferLimitBytes
 	}
@@ -255,7 +301,7 @@ func translate(commoncol *common.CommonCollections, krtctx krt.HandlerContext, p
 	}
 
 	if pol.Spec.Http1ProtocolOptions != nil {
-		http1ProtocolOptions, err := translateHttp1ProtocolOptions(pol.Spec.Http1ProtocolOptions)
+		http1ProtocolOptions, err := translatorutils.ToEnvoyHttp1ProtocolOptions(pol.Spec.Http1ProtocolOptions)
 		if err != nil {
 			return &ir, err
 		}
@@ -263,7 +309,7 @@ func translate(commoncol *common.CommonCollections, krtctx krt.HandlerContext, p
 	}
 
 	if pol.Spec.Http2ProtocolOptions != nil {
-		ir.http2ProtocolOptions = translateHttp2ProtocolOptions(pol.Spec.Http2ProtocolOptions)
+		ir.http2ProtocolOptions = translatorutils.ToEnvoyHttp2ProtocolOptions(pol.Spec.Http2ProtocolOptions)
 	}
 
 	if pol.Spec.TLS != nil {
@@ -282,6 +328,18 @@ func translate(commoncol *common.CommonCollections, krtctx krt.HandlerContext, p
 		ir.healthCheck = translateHealthCheck(pol.Spec.HealthCheck)
 	}
 
+	if pol.Spec.CircuitBreakers != nil {
+		ir.circuitBreakers = translateCircuitBreakers(pol.Spec.CircuitBreakers)
+	}
+
+	if pol.Spec.OutlierDetection != nil {
+		outlierDetection, err := translateOutlierDetection(pol.Spec.OutlierDetection)
+		if err != nil {
+			return &ir, err
+		}
+		ir.outlierDetection = outlierDetection
+	}
+
 	return &ir, nil
 }
 
@@ -298,3 +356,13 @@ func translateTCPKeepalive(tcpKeepalive *v1alpha1.TCPKeepalive) *corev3.TcpKeepa
 	}
 	return out
 }
+
+func translateProxyProtocol(proxyProtocol *v1alpha1.UpstreamProxyProtocol) *corev3.ProxyProtocolConfig {
+	out := &corev3.ProxyProtocolConfig{
+		Version: corev3.ProxyProtocolConfig_V1,
+	}
+	if proxyProtocol.Version == v1alpha1.ProxyProtocolVersionV2 {
+		out.Version = corev3.ProxyProtocolConfig_V2
+	}
+	return out
+}
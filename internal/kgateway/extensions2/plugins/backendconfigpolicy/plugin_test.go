@@ -8,7 +8,10 @@ import (
 	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	preserve_case_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/header_formatters/preserve_case/v3"
+	envoy_proxy_protocol_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/proxy_protocol/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoy_upstreams_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	envoy_upstreams_tcp_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/tcp/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
@@ -236,6 +239,288 @@ func TestBackendConfigPolicyFlow(t *testing.T) {
 			want:    &clusterv3.Cluster{},
 			wantErr: false,
 		},
+		{
+			name: "idle timeout",
+			policy: &v1alpha1.BackendConfigPolicy{
+				Spec: v1alpha1.BackendConfigPolicySpec{
+					IdleTimeout: ptr.To(metav1.Duration{Duration: 30 * time.Second}),
+				},
+			},
+			want: &clusterv3.Cluster{
+				TypedExtensionProtocolOptions: map[string]*anypb.Any{
+					"envoy.extensions.upstreams.tcp.v3.TcpProtocolOptions": mustMessageToAny(t, &envoy_upstreams_tcp_v3.TcpProtocolOptions{
+						IdleTimeout: durationpb.New(30 * time.Second),
+					}),
+					"envoy.extensions.upstreams.http.v3.HttpProtocolOptions": mustMessageToAny(t, &envoy_upstreams_http_v3.HttpProtocolOptions{
+						CommonHttpProtocolOptions: &corev3.HttpProtocolOptions{
+							IdleTimeout: durationpb.New(30 * time.Second),
+						},
+						UpstreamProtocolOptions: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+							ExplicitHttpConfig: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig{
+								ProtocolConfig: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_HttpProtocolOptions{},
+							},
+						},
+					}),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "idle timeout uses http2 for an http2 backend",
+			policy: &v1alpha1.BackendConfigPolicy{
+				Spec: v1alpha1.BackendConfigPolicySpec{
+					IdleTimeout: ptr.To(metav1.Duration{Duration: 30 * time.Second}),
+				},
+			},
+			backend: &ir.BackendObjectIR{AppProtocol: ir.HTTP2AppProtocol},
+			want: &clusterv3.Cluster{
+				TypedExtensionProtocolOptions: map[string]*anypb.Any{
+					"envoy.extensions.upstreams.tcp.v3.TcpProtocolOptions": mustMessageToAny(t, &envoy_upstreams_tcp_v3.TcpProtocolOptions{
+						IdleTimeout: durationpb.New(30 * time.Second),
+					}),
+					"envoy.extensions.upstreams.http.v3.HttpProtocolOptions": mustMessageToAny(t, &envoy_upstreams_http_v3.HttpProtocolOptions{
+						CommonHttpProtocolOptions: &corev3.HttpProtocolOptions{
+							IdleTimeout: durationpb.New(30 * time.Second),
+						},
+						UpstreamProtocolOptions: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+							ExplicitHttpConfig: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig{
+								ProtocolConfig: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_Http2ProtocolOptions{},
+							},
+						},
+					}),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "idle timeout keeps the http2 protocol of the backend",
+			policy: &v1alpha1.BackendConfigPolicy{
+				Spec: v1alpha1.BackendConfigPolicySpec{
+					IdleTimeout: ptr.To(metav1.Duration{Duration: 30 * time.Second}),
+				},
+			},
+			backend: &ir.BackendObjectIR{AppProtocol: ir.HTTP2AppProtocol},
+			cluster: &clusterv3.Cluster{
+				TypedExtensionProtocolOptions: map[string]*anypb.Any{
+					"envoy.extensions.upstreams.http.v3.HttpProtocolOptions": mustMessageToAny(t, &envoy_upstreams_http_v3.HttpProtocolOptions{
+						UpstreamProtocolOptions: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+							ExplicitHttpConfig: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig{
+								ProtocolConfig: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_Http2ProtocolOptions{
+									Http2ProtocolOptions: &corev3.Http2ProtocolOptions{},
+								},
+							},
+						},
+					}),
+				},
+			},
+			want: &clusterv3.Cluster{
+				TypedExtensionProtocolOptions: map[string]*anypb.Any{
+					"envoy.extensions.upstreams.tcp.v3.TcpProtocolOptions": mustMessageToAny(t, &envoy_upstreams_tcp_v3.TcpProtocolOptions{
+						IdleTimeout: durationpb.New(30 * time.Second),
+					}),
+					"envoy.extensions.upstreams.http.v3.HttpProtocolOptions": mustMessageToAny(t, &envoy_upstreams_http_v3.HttpProtocolOptions{
+						CommonHttpProtocolOptions: &corev3.HttpProtocolOptions{
+							IdleTimeout: durationpb.New(30 * time.Second),
+						},
+						UpstreamProtocolOptions: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+							ExplicitHttpConfig: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig{
+								ProtocolConfig: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_Http2ProtocolOptions{
+									Http2ProtocolOptions: &corev3.Http2ProtocolOptions{},
+								},
+							},
+						},
+					}),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "http idle timeout takes precedence over idle timeout",
+			policy: &v1alpha1.BackendConfigPolicy{
+				Spec: v1alpha1.BackendConfigPolicySpec{
+					IdleTimeout: ptr.To(metav1.Duration{Duration: 30 * time.Second}),
+					CommonHttpProtocolOptions: &v1alpha1.CommonHttpProtocolOptions{
+						IdleTimeout: ptr.To(metav1.Duration{Duration: 10 * time.Second}),
+					},
+				},
+			},
+			want: &clusterv3.Cluster{
+				TypedExtensionProtocolOptions: map[string]*anypb.Any{
+					"envoy.extensions.upstreams.tcp.v3.TcpProtocolOptions": mustMessageToAny(t, &envoy_upstreams_tcp_v3.TcpProtocolOptions{
+						IdleTimeout: durationpb.New(30 * time.Second),
+					}),
+					"envoy.extensions.upstreams.http.v3.HttpProtocolOptions": mustMessageToAny(t, &envoy_upstreams_http_v3.HttpProtocolOptions{
+						CommonHttpProtocolOptions: &corev3.HttpProtocolOptions{
+							IdleTimeout: durationpb.New(10 * time.Second),
+						},
+						UpstreamProtocolOptions: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+							ExplicitHttpConfig: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig{
+								ProtocolConfig: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_HttpProtocolOptions{},
+							},
+						},
+					}),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "proxy protocol",
+			policy: &v1alpha1.BackendConfigPolicy{
+				Spec: v1alpha1.BackendConfigPolicySpec{
+					ProxyProtocol: &v1alpha1.UpstreamProxyProtocol{
+						Version: v1alpha1.ProxyProtocolVersionV2,
+					},
+				},
+			},
+			want: &clusterv3.Cluster{
+				TransportSocket: &corev3.TransportSocket{
+					Name: "envoy.transport_sockets.upstream_proxy_protocol",
+					ConfigType: &corev3.TransportSocket_TypedConfig{
+						TypedConfig: mustMessageToAny(t, &envoy_proxy_protocol_v3.ProxyProtocolUpstreamTransport{
+							Config: &corev3.ProxyProtocolConfig{Version: corev3.ProxyProtocolConfig_V2},
+						}),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "proxy protocol wraps the existing transport socket",
+			policy: &v1alpha1.BackendConfigPolicy{
+				Spec: v1alpha1.BackendConfigPolicySpec{
+					ProxyProtocol: &v1alpha1.UpstreamProxyProtocol{
+						Version: v1alpha1.ProxyProtocolVersionV1,
+					},
+				},
+			},
+			cluster: &clusterv3.Cluster{
+				TransportSocket: &corev3.TransportSocket{
+					Name: "envoy.transport_sockets.tls",
+					ConfigType: &corev3.TransportSocket_TypedConfig{
+						TypedConfig: mustMessageToAny(t, &envoyauth.UpstreamTlsContext{Sni: "example.com"}),
+					},
+				},
+			},
+			want: &clusterv3.Cluster{
+				TransportSocket: &corev3.TransportSocket{
+					Name: "envoy.transport_sockets.upstream_proxy_protocol",
+					ConfigType: &corev3.TransportSocket_TypedConfig{
+						TypedConfig: mustMessageToAny(t, &envoy_proxy_protocol_v3.ProxyProtocolUpstreamTransport{
+							Config: &corev3.ProxyProtocolConfig{Version: corev3.ProxyProtocolConfig_V1},
+							TransportSocket: &corev3.TransportSocket{
+								Name: "envoy.transport_sockets.tls",
+								ConfigType: &corev3.TransportSocket_TypedConfig{
+									TypedConfig: mustMessageToAny(t, &envoyauth.UpstreamTlsContext{Sni: "example.com"}),
+								},
+							},
+						}),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "circuit breakers and outlier detection",
+			policy: &v1alpha1.BackendConfigPolicy{
+				Spec: v1alpha1.BackendConfigPolicySpec{
+					CircuitBreakers: &v1alpha1.CircuitBreakers{
+						CircuitBreakerThresholds: v1alpha1.CircuitBreakerThresholds{
+							MaxConnections:     ptr.To(uint32(100)),
+							MaxPendingRequests: ptr.To(uint32(10)),
+						},
+						HighPriority: &v1alpha1.CircuitBreakerThresholds{
+							MaxRequests: ptr.To(uint32(200)),
+							MaxRetries:  ptr.To(uint32(5)),
+						},
+					},
+					OutlierDetection: &v1alpha1.OutlierDetection{
+						Consecutive5xx:           ptr.To(uint32(0)),
+						ConsecutiveGatewayErrors: ptr.To(uint32(3)),
+						Interval:                 ptr.To(metav1.Duration{Duration: 5 * time.Second}),
+						BaseEjectionTime:         ptr.To(metav1.Duration{Duration: 30 * time.Second}),
+						MaxEjectionTime:          ptr.To(metav1.Duration{Duration: 2 * time.Minute}),
+						MaxEjectionPercent:       ptr.To(uint32(50)),
+						SuccessRate: &v1alpha1.OutlierDetectionSuccessRate{
+							MinimumHosts:  ptr.To(uint32(3)),
+							RequestVolume: ptr.To(uint32(20)),
+							StdevFactor:   ptr.To("1.5"),
+						},
+					},
+				},
+			},
+			want: &clusterv3.Cluster{
+				CircuitBreakers: &clusterv3.CircuitBreakers{
+					Thresholds: []*clusterv3.CircuitBreakers_Thresholds{
+						{
+							Priority:           corev3.RoutingPriority_DEFAULT,
+							MaxConnections:     &wrapperspb.UInt32Value{Value: 100},
+							MaxPendingRequests: &wrapperspb.UInt32Value{Value: 10},
+						},
+						{
+							Priority:    corev3.RoutingPriority_HIGH,
+							MaxRequests: &wrapperspb.UInt32Value{Value: 200},
+							MaxRetries:  &wrapperspb.UInt32Value{Value: 5},
+						},
+					},
+				},
+				OutlierDetection: &clusterv3.OutlierDetection{
+					EnforcingConsecutive_5Xx:           &wrapperspb.UInt32Value{Value: 0},
+					ConsecutiveGatewayFailure:          &wrapperspb.UInt32Value{Value: 3},
+					EnforcingConsecutiveGatewayFailure: &wrapperspb.UInt32Value{Value: 100},
+					Interval:                           durationpb.New(5 * time.Second),
+					BaseEjectionTime:                   durationpb.New(30 * time.Second),
+					MaxEjectionTime:                    durationpb.New(2 * time.Minute),
+					MaxEjectionPercent:                 &wrapperspb.UInt32Value{Value: 50},
+					EnforcingSuccessRate:               &wrapperspb.UInt32Value{Value: 100},
+					SuccessRateMinimumHosts:            &wrapperspb.UInt32Value{Value: 3},
+					SuccessRateRequestVolume:           &wrapperspb.UInt32Value{Value: 20},
+					SuccessRateStdevFactor:             &wrapperspb.UInt32Value{Value: 1500},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "outlier detection without success rate",
+			policy: &v1alpha1.BackendConfigPolicy{
+				Spec: v1alpha1.BackendConfigPolicySpec{
+					OutlierDetection: &v1alpha1.OutlierDetection{
+						Consecutive5xx: ptr.To(uint32(7)),
+					},
+				},
+			},
+			want: &clusterv3.Cluster{
+				OutlierDetection: &clusterv3.OutlierDetection{
+					Consecutive_5Xx:      &wrapperspb.UInt32Value{Value: 7},
+					EnforcingSuccessRate: &wrapperspb.UInt32Value{Value: 0},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "outlier detection with max ejection time smaller than base ejection time",
+			policy: &v1alpha1.BackendConfigPolicy{
+				Spec: v1alpha1.BackendConfigPolicySpec{
+					OutlierDetection: &v1alpha1.OutlierDetection{
+						BaseEjectionTime: ptr.To(metav1.Duration{Duration: time.Minute}),
+						MaxEjectionTime:  ptr.To(metav1.Duration{Duration: 30 * time.Second}),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "outlier detection with invalid stdev factor",
+			policy: &v1alpha1.BackendConfigPolicy{
+				Spec: v1alpha1.BackendConfigPolicySpec{
+					OutlierDetection: &v1alpha1.OutlierDetection{
+						SuccessRate: &v1alpha1.OutlierDetectionSuccessRate{
+							StdevFactor: ptr.To("high"),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
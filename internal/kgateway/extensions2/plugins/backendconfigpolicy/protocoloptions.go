@@ -3,8 +3,9 @@ package backendconfigpolicy
 import (
 	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
-	preserve_case_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/header_formatters/preserve_case/v3"
 	envoy_upstreams_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	envoy_upstreams_tcp_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/tcp/v3"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
@@ -14,6 +15,8 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
 )
 
+const tcpProtocolOptionsKey = "envoy.extensions.upstreams.tcp.v3.TcpProtocolOptions"
+
 func translateCommonHttpProtocolOptions(commonHttpProtocolOptions *v1alpha1.CommonHttpProtocolOptions) *corev3.HttpProtocolOptions {
 	out := &corev3.HttpProtocolOptions{}
 	if commonHttpProtocolOptions.MaxRequestsPerConnection != nil {
@@ -34,59 +37,6 @@ func translateCommonHttpProtocolOptions(commonHttpProtocolOptions *v1alpha1.Comm
 	return out
 }
 
-func translateHttp1ProtocolOptions(http1ProtocolOptions *v1alpha1.Http1ProtocolOptions) (*corev3.Http1ProtocolOptions, error) {
-	out := &corev3.Http1ProtocolOptions{}
-	if http1ProtocolOptions.EnableTrailers != nil {
-		out.EnableTrailers = *http1ProtocolOptions.EnableTrailers
-	}
-
-	if http1ProtocolOptions.OverrideStreamErrorOnInvalidHttpMessage != nil {
-		out.OverrideStreamErrorOnInvalidHttpMessage = &wrapperspb.BoolValue{Value: *http1ProtocolOptions.OverrideStreamErrorOnInvalidHttpMessage}
-	}
-
-	if http1ProtocolOptions.HeaderFormat != nil {
-		switch *http1ProtocolOptions.HeaderFormat {
-		case v1alpha1.ProperCaseHeaderKeyFormat:
-			out.HeaderKeyFormat = &corev3.Http1ProtocolOptions_HeaderKeyFormat{
-				HeaderFormat: &corev3.Http1ProtocolOptions_HeaderKeyFormat_ProperCaseWords_{
-					ProperCaseWords: &corev3.Http1ProtocolOptions_HeaderKeyFormat_ProperCaseWords{},
-				},
-			}
-		case v1alpha1.PreserveCaseHeaderKeyFormat:
-			typedConfig, err := utils.MessageToAny(&preserve_case_v3.PreserveCaseFormatterConfig{})
-			if err != nil {
-				return nil, err
-			}
-			out.HeaderKeyFormat = &corev3.Http1ProtocolOptions_HeaderKeyFormat{
-				HeaderFormat: &corev3.Http1ProtocolOptions_HeaderKeyFormat_StatefulFormatter{
-					StatefulFormatter: &corev3.TypedExtensionConfig{
-						Name:        PreserveCasePlugin,
-						TypedConfig: typedConfig,
-					},
-				},
-			}
-		}
-	}
-	return out, nil
-}
-
-func translateHttp2ProtocolOptions(http2ProtocolOptions *v1alpha1.Http2ProtocolOptions) *corev3.Http2ProtocolOptions {
-	out := &corev3.Http2ProtocolOptions{}
-	if http2ProtocolOptions.MaxConcurrentStreams != nil {
-		out.MaxConcurrentStreams = &wrapperspb.UInt32Value{Value: uint32(*http2ProtocolOptions.MaxConcurrentStreams)}
-	}
-	if http2ProtocolOptions.InitialStreamWindowSize != nil {
-		out.InitialStreamWindowSize = &wrapperspb.UInt32Value{Value: uint32(http2ProtocolOptions.InitialStreamWindowSize.Value())}
-	}
-	if http2ProtocolOptions.InitialConnectionWindowSize != nil {
-		out.InitialConnectionWindowSize = &wrapperspb.UInt32Value{Value: uint32(http2ProtocolOptions.InitialConnectionWindowSize.Value())}
-	}
-	if http2ProtocolOptions.OverrideStreamErrorOnInvalidHttpMessage != nil {
-		out.OverrideStreamErrorOnInvalidHttpMessage = &wrapperspb.BoolValue{Value: *http2ProtocolOptions.OverrideStreamErrorOnInvalidHttpMessage}
-	}
-	return out
-}
-
 func applyCommonHttpProtocolOptions(commonHttpProtocolOptions *corev3.HttpProtocolOptions, backend ir.BackendObjectIR, out *clusterv3.Cluster) {
 	if commonHttpProtocolOptions == nil {
 		return
@@ -152,3 +102,48 @@ func applyHttp2ProtocolOptions(http2ProtocolOptions *corev3.Http2ProtocolOptions
 		logger.Error("failed to apply http2 protocol options", "backend", backend.GetName(), "error", err)
 	}
 }
+
+// applyIdleTimeout sets the idle timeout of both the TCP and the HTTP connection pools of the
+// cluster, without overriding the idle timeout set by the common HTTP protocol options.
+func applyIdleTimeout(idleTimeout *durationpb.Duration, backend ir.BackendObjectIR, out *clusterv3.Cluster) {
+	if idleTimeout == nil {
+		return
+	}
+
+	tcpProtocolOptions, err := utils.MessageToAny(&envoy_upstreams_tcp_v3.TcpProtocolOptions{
+		IdleTimeout: idleTimeout,
+	})
+	if err != nil {
+		logger.Error("failed to apply tcp idle timeout", "backend", backend.GetName(), "error", err)
+		return
+	}
+	if out.GetTypedExtensionProtocolOptions() == nil {
+		out.TypedExtensionProtocolOptions = map[string]*anypb.Any{}
+	}
+	out.GetTypedExtensionProtocolOptions()[tcpProtocolOptionsKey] = tcpProtocolOptions
+
+	if err := translatorutils.MutateHttpOptions(out, func(opts *envoy_upstreams_v3.HttpProtocolOptions) {
+		if opts.GetCommonHttpProtocolOptions() == nil {
+			opts.CommonHttpProtocolOptions = &corev3.HttpProtocolOptions{}
+		}
+		if opts.GetCommonHttpProtocolOptions().GetIdleTimeout() == nil {
+			opts.GetCommonHttpProtocolOptions().IdleTimeout = idleTimeout
+		}
+		// Envoy requires UpstreamProtocolOptions if CommonHttpProtocolOptions is set. The protocol
+		// already selected for the backend, e.g. HTTP/2 for gRPC, is kept, otherwise the protocol
+		// of the backend's app protocol is used.
+		if opts.GetUpstreamProtocolOptions() == nil {
+			explicitHttpConfig := &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig{
+				ProtocolConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_HttpProtocolOptions{},
+			}
+			if backend.AppProtocol == ir.HTTP2AppProtocol {
+				explicitHttpConfig.ProtocolConfig = &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_Http2ProtocolOptions{}
+			}
+			opts.UpstreamProtocolOptions = &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+				ExplicitHttpConfig: explicitHttpConfig,
+			}
+		}
+	}); err != nil {
+		logger.Error("failed to apply http idle timeout", "backend", backend.GetName(), "error", err)
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/common"
 	extensionsplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	translatorutils "github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/utils"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
 	kgwellknown "github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 	pluginutils "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/utils"
@@ -134,7 +135,9 @@ func processBackend(ctx context.Context, polir ir.PolicyIR, in ir.BackendObjectI
 	if tlsPol.transportSocket == nil {
 		return
 	}
-	out.TransportSocket = tlsPol.transportSocket
+	if err := translatorutils.SetTransportSocket(out, tlsPol.transportSocket); err != nil {
+		slog.Error("error setting TLS transport socket", "error", err, "backend", in.GetName())
+	}
 }
 
 func buildTranslateFunc(
@@ -3,6 +3,7 @@ package destrule
 import (
 	"fmt"
 	"slices"
+	"strings"
 
 	"google.golang.org/protobuf/proto"
 	"istio.io/api/networking/v1alpha3"
@@ -12,6 +13,8 @@ import (
 	"istio.io/istio/pkg/kube/kclient"
 	"istio.io/istio/pkg/kube/krt"
 	"istio.io/istio/pkg/kube/kubetypes"
+	"istio.io/istio/pkg/util/sets"
+	"knative.dev/pkg/network"
 
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils/krtutil"
 )
@@ -31,6 +34,8 @@ func (n NsWithHostname) String() string {
 type DestinationRuleIndex struct {
 	Destrules  krt.Collection[DestinationRuleWrapper]
 	ByHostname krt.Index[NsWithHostname, DestinationRuleWrapper]
+	// ByHost indexes the destination rules by host only, regardless of where they are exported to.
+	ByHost krt.Index[string, DestinationRuleWrapper]
 }
 type DestinationRuleWrapper struct {
 	*networkingclient.DestinationRule
@@ -49,6 +54,12 @@ func (c DestinationRuleWrapper) String() string {
 	return c.ResourceName()
 }
 
+// Host returns the host of the destination rule, with short names resolved
+// relative to the namespace of the destination rule like Istio does.
+func (c DestinationRuleWrapper) Host() string {
+	return resolveHost(c.Spec.GetHost(), c.Namespace)
+}
+
 var _ krt.Equaler[DestinationRuleWrapper] = new(DestinationRuleWrapper)
 
 func (c DestinationRuleWrapper) Equals(k DestinationRuleWrapper) bool {
@@ -65,17 +76,20 @@ func NewDestRuleIndex(istioClient kube.Client, krtopts *krtutil.KrtOptions) Dest
 	destrules := krt.NewCollection(rawDestrules, func(kctx krt.HandlerContext, dr *networkingclient.DestinationRule) *DestinationRuleWrapper {
 		return &DestinationRuleWrapper{dr}
 	})
-	return DestinationRuleIndex{
-		Destrules:  destrules,
-		ByHostname: newDestruleIndex(destrules),
-	}
+	return newDestRuleIndexFromCollection(destrules)
 }
 
 func NewEmptyDestRuleIndex() DestinationRuleIndex {
-	destrules := krt.NewStaticCollection[DestinationRuleWrapper](nil)
+	return newDestRuleIndexFromCollection(krt.NewStaticCollection[DestinationRuleWrapper](nil))
+}
+
+func newDestRuleIndexFromCollection(destrules krt.Collection[DestinationRuleWrapper]) DestinationRuleIndex {
 	return DestinationRuleIndex{
 		Destrules:  destrules,
 		ByHostname: newDestruleIndex(destrules),
+		ByHost: krt.NewIndex(destrules, func(d DestinationRuleWrapper) []string {
+			return []string{d.Host()}
+		}),
 	}
 }
 
@@ -87,7 +101,7 @@ func newDestruleIndex(destRuleCollection krt.Collection[DestinationRuleWrapper])
 		if len(exportTo) == 0 {
 			return []NsWithHostname{{
 				Ns:       exportAllNs,
-				Hostname: d.Spec.GetHost(),
+				Hostname: d.Host(),
 			}}
 		}
 		var keys []NsWithHostname
@@ -97,7 +111,7 @@ func newDestruleIndex(destRuleCollection krt.Collection[DestinationRuleWrapper])
 			}
 			keys = append(keys, NsWithHostname{
 				Ns:       ns,
-				Hostname: d.Spec.GetHost(),
+				Hostname: d.Host(),
 			})
 		}
 
@@ -106,31 +120,97 @@ func newDestruleIndex(destRuleCollection krt.Collection[DestinationRuleWrapper])
 	return idx
 }
 
-func (d *DestinationRuleIndex) FetchDestRulesFor(kctx krt.HandlerContext, proxyNs string, hostname string, podLabels map[string]string) *DestinationRuleWrapper {
+func resolveHost(host, ns string) string {
+	// only short names are resolved, anything containing a dot (including wildcards) is treated as fully qualified
+	if host == "" || strings.Contains(host, ".") || host == "*" {
+		return host
+	}
+	// TODO: reevaluate knative dep, dedupe with pkg/utils/kubeutils/dns.go
+	return fmt.Sprintf("%s.%s.svc.%s", host, ns, network.GetClusterDomainName())
+}
+
+// MergedDestinationRule is the result of merging all the destination rules applying to a host,
+// as Istio does: the oldest destination rule with a traffic policy provides the traffic policy,
+// and subsets are combined, the oldest destination rule winning when subset names collide.
+type MergedDestinationRule struct {
+	Spec *v1alpha3.DestinationRule
+	// Sources are the destination rules that were merged, oldest first.
+	Sources []DestinationRuleWrapper
+}
+
+// FetchDestRulesFor returns the merged destination rules for the hostname that are visible
+// from the proxy namespace and select the proxy pod, or nil if there are none.
+func (d *DestinationRuleIndex) FetchDestRulesFor(kctx krt.HandlerContext, proxyNs string, hostname string, podLabels map[string]string) *MergedDestinationRule {
 	if hostname == "" {
 		return nil
 	}
 
-	key := NsWithHostname{
+	destrules := krt.Fetch(kctx, d.Destrules, krt.FilterIndex(d.ByHostname, NsWithHostname{
 		Ns:       exportAllNs,
 		Hostname: hostname,
+	}), krt.FilterSelects(podLabels))
+	destrules = append(destrules, krt.Fetch(kctx, d.Destrules, krt.FilterIndex(d.ByHostname, NsWithHostname{
+		Ns:       proxyNs,
+		Hostname: hostname,
+	}), krt.FilterSelects(podLabels))...)
+
+	// destination rules with a workload selector take precedence over the ones without
+	if slices.ContainsFunc(destrules, hasWorkloadSelector) {
+		destrules = slices.DeleteFunc(destrules, func(d DestinationRuleWrapper) bool {
+			return !hasWorkloadSelector(d)
+		})
 	}
-	destrules := krt.Fetch(kctx, d.Destrules, krt.FilterIndex(d.ByHostname, key), krt.FilterSelects(podLabels))
-	if len(destrules) == 0 {
-		key := NsWithHostname{
-			Ns:       proxyNs,
-			Hostname: hostname,
-		}
-		destrules = krt.Fetch(kctx, d.Destrules, krt.FilterIndex(d.ByHostname, key), krt.FilterSelects(podLabels))
-	}
+	return mergeDestRules(destrules)
+}
+
+func hasWorkloadSelector(d DestinationRuleWrapper) bool {
+	return len(d.GetLabelSelector()) > 0
+}
+
+func mergeDestRules(destrules []DestinationRuleWrapper) *MergedDestinationRule {
 	if len(destrules) == 0 {
 		return nil
 	}
-	// use oldest. TODO -  we need to merge them.
-	oldestDestRule := slices.MinFunc(destrules, func(i DestinationRuleWrapper, j DestinationRuleWrapper) int {
-		return i.CreationTimestamp.Time.Compare(j.CreationTimestamp.Time)
+	slices.SortFunc(destrules, func(i, j DestinationRuleWrapper) int {
+		if c := i.CreationTimestamp.Time.Compare(j.CreationTimestamp.Time); c != 0 {
+			return c
+		}
+		return strings.Compare(i.ResourceName(), j.ResourceName())
+	})
+	// the same destination rule may be exported both to all namespaces and to the proxy namespace
+	destrules = slices.CompactFunc(destrules, func(i, j DestinationRuleWrapper) bool {
+		return i.ResourceName() == j.ResourceName()
 	})
-	return &oldestDestRule
+
+	merged := &MergedDestinationRule{
+		Spec:    proto.Clone(&destrules[0].Spec).(*v1alpha3.DestinationRule),
+		Sources: destrules,
+	}
+	subsets := sets.New[string]()
+	for _, subset := range merged.Spec.GetSubsets() {
+		subsets.Insert(subset.GetName())
+	}
+	for _, d := range destrules[1:] {
+		if merged.Spec.GetTrafficPolicy() == nil {
+			merged.Spec.TrafficPolicy = d.Spec.GetTrafficPolicy()
+		}
+		for _, subset := range d.Spec.GetSubsets() {
+			if subsets.InsertContains(subset.GetName()) {
+				continue
+			}
+			merged.Spec.Subsets = append(merged.Spec.Subsets, subset)
+		}
+	}
+	return merged
+}
+
+func (m *MergedDestinationRule) getSubset(name string) *v1alpha3.Subset {
+	for _, subset := range m.Spec.GetSubsets() {
+		if subset.GetName() == name {
+			return subset
+		}
+	}
+	return nil
 }
 
 func getLocalityLbSetting(trafficPolicy *v1alpha3.TrafficPolicy) *v1alpha3.LocalityLoadBalancerSetting {
@@ -146,27 +226,61 @@ func getLocalityLbSetting(trafficPolicy *v1alpha3.TrafficPolicy) *v1alpha3.Local
 	return localityLb
 }
 
-func getTrafficPolicy(destrule *DestinationRuleWrapper, port uint32) *v1alpha3.TrafficPolicy {
-	trafficPolicy := destrule.Spec.GetTrafficPolicy()
-	if trafficPolicy == nil {
-		return nil
+// getTrafficPolicy returns the traffic policy of the destination rule for the port, with the
+// settings of the subset (if any) overriding the top level ones.
+func getTrafficPolicy(destrule *MergedDestinationRule, subset string, port uint32) *v1alpha3.TrafficPolicy {
+	trafficPolicy := mergeTrafficPolicy(nil, destrule.Spec.GetTrafficPolicy(), port)
+	if subset == "" {
+		return trafficPolicy
 	}
+	return mergeTrafficPolicy(trafficPolicy, destrule.getSubset(subset).GetTrafficPolicy(), port)
+}
 
-	for _, portlevel := range trafficPolicy.GetPortLevelSettings() {
-		if portlevel.GetPort() != nil {
-			if portlevel.GetPort().GetNumber() == port {
-				return convertPortLevel(portlevel)
-			}
-		}
+// mergeTrafficPolicy mirrors the similarly named function in Istio: each field set by the override
+// (or by its settings for the port) replaces the field of the original policy.
+func mergeTrafficPolicy(original, override *v1alpha3.TrafficPolicy, port uint32) *v1alpha3.TrafficPolicy {
+	if override == nil {
+		return original
+	}
+
+	merged := &v1alpha3.TrafficPolicy{}
+	if original != nil {
+		merged.ConnectionPool = original.GetConnectionPool()
+		merged.LoadBalancer = original.GetLoadBalancer()
+		merged.OutlierDetection = original.GetOutlierDetection()
+		merged.Tls = original.GetTls()
 	}
-	return trafficPolicy
-}
 
-func convertPortLevel(portlevel *v1alpha3.TrafficPolicy_PortTrafficPolicy) *v1alpha3.TrafficPolicy {
-	return &v1alpha3.TrafficPolicy{
-		ConnectionPool:   portlevel.GetConnectionPool(),
-		LoadBalancer:     portlevel.GetLoadBalancer(),
-		OutlierDetection: portlevel.GetOutlierDetection(),
-		Tls:              portlevel.GetTls(),
+	if override.GetConnectionPool() != nil {
+		merged.ConnectionPool = override.GetConnectionPool()
+	}
+	if override.GetLoadBalancer() != nil {
+		merged.LoadBalancer = override.GetLoadBalancer()
+	}
+	if override.GetOutlierDetection() != nil {
+		merged.OutlierDetection = override.GetOutlierDetection()
+	}
+	if override.GetTls() != nil {
+		merged.Tls = override.GetTls()
+	}
+
+	for _, portlevel := range override.GetPortLevelSettings() {
+		if portlevel.GetPort().GetNumber() != port {
+			continue
+		}
+		if portlevel.GetConnectionPool() != nil {
+			merged.ConnectionPool = portlevel.GetConnectionPool()
+		}
+		if portlevel.GetLoadBalancer() != nil {
+			merged.LoadBalancer = portlevel.GetLoadBalancer()
+		}
+		if portlevel.GetOutlierDetection() != nil {
+			merged.OutlierDetection = portlevel.GetOutlierDetection()
+		}
+		if portlevel.GetTls() != nil {
+			merged.Tls = portlevel.GetTls()
+		}
+		break
 	}
+	return merged
 }
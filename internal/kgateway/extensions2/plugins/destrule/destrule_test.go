@@ -0,0 +1,162 @@
+package destrule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"istio.io/api/networking/v1alpha3"
+	networkingclient "istio.io/client-go/pkg/apis/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func destRule(name string, created time.Time, spec *v1alpha3.DestinationRule) DestinationRuleWrapper {
+	dr := &networkingclient.DestinationRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(created),
+		},
+	}
+	proto.Merge(&dr.Spec, spec)
+	return DestinationRuleWrapper{dr}
+}
+
+func TestResolveHost(t *testing.T) {
+	assert.Equal(t, "reviews.default.svc.cluster.local", resolveHost("reviews", "default"))
+	assert.Equal(t, "reviews.other", resolveHost("reviews.other", "default"))
+	assert.Equal(t, "*.example.com", resolveHost("*.example.com", "default"))
+}
+
+func TestMergeDestRules(t *testing.T) {
+	now := time.Now()
+	lb := &v1alpha3.TrafficPolicy{
+		LoadBalancer: &v1alpha3.LoadBalancerSettings{
+			LbPolicy: &v1alpha3.LoadBalancerSettings_Simple{Simple: v1alpha3.LoadBalancerSettings_RANDOM},
+		},
+	}
+	tls := &v1alpha3.TrafficPolicy{
+		Tls: &v1alpha3.ClientTLSSettings{Mode: v1alpha3.ClientTLSSettings_ISTIO_MUTUAL},
+	}
+
+	newest := destRule("newest", now, &v1alpha3.DestinationRule{
+		TrafficPolicy: tls,
+		Subsets: []*v1alpha3.Subset{
+			{Name: "v1", Labels: map[string]string{"version": "newest"}},
+			{Name: "v3", Labels: map[string]string{"version": "v3"}},
+		},
+	})
+	oldest := destRule("oldest", now.Add(-time.Hour), &v1alpha3.DestinationRule{
+		Subsets: []*v1alpha3.Subset{
+			{Name: "v1", Labels: map[string]string{"version": "v1"}},
+		},
+	})
+	middle := destRule("middle", now.Add(-time.Minute), &v1alpha3.DestinationRule{
+		TrafficPolicy: lb,
+		Subsets: []*v1alpha3.Subset{
+			{Name: "v2", Labels: map[string]string{"version": "v2"}},
+		},
+	})
+
+	merged := mergeDestRules([]DestinationRuleWrapper{newest, oldest, middle, oldest})
+
+	a := assert.New(t)
+	a.Equal([]string{"oldest", "middle", "newest"}, []string{merged.Sources[0].Name, merged.Sources[1].Name, merged.Sources[2].Name})
+	a.True(proto.Equal(lb, merged.Spec.GetTrafficPolicy()), "the oldest traffic policy should be used")
+	a.Len(merged.Spec.GetSubsets(), 3)
+	a.Equal(map[string]string{"version": "v1"}, merged.getSubset("v1").GetLabels(), "the oldest subset should win")
+	a.NotNil(merged.getSubset("v2"))
+	a.NotNil(merged.getSubset("v3"))
+	a.Len(oldest.Spec.GetSubsets(), 1, "merging must not modify the destination rules")
+
+	a.Nil(mergeDestRules(nil))
+}
+
+func TestGetTrafficPolicy(t *testing.T) {
+	connectionPool := &v1alpha3.ConnectionPoolSettings{
+		Tcp: &v1alpha3.ConnectionPoolSettings_TCPSettings{MaxConnections: 10},
+	}
+	portConnectionPool := &v1alpha3.ConnectionPoolSettings{
+		Tcp: &v1alpha3.ConnectionPoolSettings_TCPSettings{MaxConnections: 20},
+	}
+	subsetConnectionPool := &v1alpha3.ConnectionPoolSettings{
+		Tcp: &v1alpha3.ConnectionPoolSettings_TCPSettings{MaxConnections: 30},
+	}
+	lb := &v1alpha3.LoadBalancerSettings{
+		LbPolicy: &v1alpha3.LoadBalancerSettings_Simple{Simple: v1alpha3.LoadBalancerSettings_RANDOM},
+	}
+	subsetTLS := &v1alpha3.ClientTLSSettings{Mode: v1alpha3.ClientTLSSettings_SIMPLE}
+
+	merged := mergeDestRules([]DestinationRuleWrapper{destRule("dr", time.Now(), &v1alpha3.DestinationRule{
+		TrafficPolicy: &v1alpha3.TrafficPolicy{
+			ConnectionPool: connectionPool,
+			LoadBalancer:   lb,
+			PortLevelSettings: []*v1alpha3.TrafficPolicy_PortTrafficPolicy{{
+				Port:           &v1alpha3.PortSelector{Number: 8080},
+				ConnectionPool: portConnectionPool,
+			}},
+		},
+		Subsets: []*v1alpha3.Subset{
+			{
+				Name: "v1",
+				TrafficPolicy: &v1alpha3.TrafficPolicy{
+					Tls: subsetTLS,
+					PortLevelSettings: []*v1alpha3.TrafficPolicy_PortTrafficPolicy{{
+						Port:           &v1alpha3.PortSelector{Number: 9090},
+						ConnectionPool: subsetConnectionPool,
+					}},
+				},
+			},
+			{Name: "v2"},
+		},
+	})})
+
+	tests := []struct {
+		name           string
+		subset         string
+		port           uint32
+		connectionPool *v1alpha3.ConnectionPoolSettings
+		tls            *v1alpha3.ClientTLSSettings
+	}{
+		{
+			name:           "top level",
+			port:           80,
+			connectionPool: connectionPool,
+		},
+		{
+			name:           "port level settings override the top level ones",
+			port:           8080,
+			connectionPool: portConnectionPool,
+		},
+		{
+			name:           "subset settings override the top level ones",
+			subset:         "v1",
+			port:           8080,
+			connectionPool: portConnectionPool,
+			tls:            subsetTLS,
+		},
+		{
+			name:           "subset port level settings override the subset ones",
+			subset:         "v1",
+			port:           9090,
+			connectionPool: subsetConnectionPool,
+			tls:            subsetTLS,
+		},
+		{
+			name:           "subset without traffic policy",
+			subset:         "v2",
+			port:           80,
+			connectionPool: connectionPool,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+			trafficPolicy := getTrafficPolicy(merged, tt.subset, tt.port)
+			a.True(proto.Equal(tt.connectionPool, trafficPolicy.GetConnectionPool()), "unexpected connection pool %v", trafficPolicy.GetConnectionPool())
+			a.True(proto.Equal(tt.tls, trafficPolicy.GetTls()), "unexpected tls %v", trafficPolicy.GetTls())
+			a.True(proto.Equal(lb, trafficPolicy.GetLoadBalancer()), "unexpected load balancer %v", trafficPolicy.GetLoadBalancer())
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package destrule
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/avast/retry-go"
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	istiometav1alpha1 "istio.io/api/meta/v1alpha1"
+	"istio.io/api/networking/v1alpha3"
+	networkingclient "istio.io/client-go/pkg/apis/networking/v1"
+	"istio.io/istio/pkg/kube/controllers"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/common"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils/krtutil"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+// destRuleStatus holds the errors found in the settings of a destination rule, to report them
+// in its status, as the settings are applied per proxy where errors can only be logged.
+type destRuleStatus struct {
+	krt.Named
+	Generation int64
+	Errors     []error
+}
+
+func (s destRuleStatus) Equals(in destRuleStatus) bool {
+	return s.Named == in.Named && s.Generation == in.Generation &&
+		slices.EqualFunc(s.Errors, in.Errors, func(a, b error) bool {
+			return a.Error() == b.Error()
+		})
+}
+
+// trafficPolicySettings are the settings of one of the traffic policies of a destination rule.
+type trafficPolicySettings struct {
+	path         string
+	loadBalancer *v1alpha3.LoadBalancerSettings
+	tls          *v1alpha3.ClientTLSSettings
+}
+
+func getTrafficPolicySettings(spec *v1alpha3.DestinationRule) []trafficPolicySettings {
+	var out []trafficPolicySettings
+	add := func(path string, trafficPolicy *v1alpha3.TrafficPolicy) {
+		if trafficPolicy == nil {
+			return
+		}
+		out = append(out, trafficPolicySettings{
+			path:         path,
+			loadBalancer: trafficPolicy.GetLoadBalancer(),
+			tls:          trafficPolicy.GetTls(),
+		})
+		for i, portlevel := range trafficPolicy.GetPortLevelSettings() {
+			out = append(out, trafficPolicySettings{
+				path:         fmt.Sprintf("%s.portLevelSettings[%d]", path, i),
+				loadBalancer: portlevel.GetLoadBalancer(),
+				tls:          portlevel.GetTls(),
+			})
+		}
+	}
+	add("trafficPolicy", spec.GetTrafficPolicy())
+	for i, subset := range spec.GetSubsets() {
+		add(fmt.Sprintf("subsets[%d].trafficPolicy", i), subset.GetTrafficPolicy())
+	}
+	return out
+}
+
+// newDestRuleStatuses validates the destination rules. The TLS credentials are read from the
+// namespace of the proxy, so they are validated in the namespaces of the Gateways the
+// destination rule is exported to.
+func newDestRuleStatuses(
+	destrules krt.Collection[DestinationRuleWrapper],
+	gateways krt.Collection[ir.Gateway],
+	secrets *krtcollections.SecretIndex,
+	krtopts krtutil.KrtOptions,
+) krt.Collection[destRuleStatus] {
+	return krt.NewCollection(destrules, func(kctx krt.HandlerContext, dr DestinationRuleWrapper) *destRuleStatus {
+		var gatewayNamespaces []string
+		for _, settings := range getTrafficPolicySettings(&dr.Spec) {
+			if settings.tls.GetCredentialName() != "" {
+				gatewayNamespaces = exportedGatewayNamespaces(krt.Fetch(kctx, gateways), dr)
+				break
+			}
+		}
+
+		var errs []error
+		for _, settings := range getTrafficPolicySettings(&dr.Spec) {
+			if err := applyLoadBalancer(settings.loadBalancer, &envoy_config_cluster_v3.Cluster{}); err != nil {
+				errs = append(errs, fmt.Errorf("%s.loadBalancer: %w", settings.path, err))
+			}
+			if settings.tls.GetCredentialName() == "" {
+				if err := applyTLS(kctx, secrets, "", settings.tls, ir.BackendObjectIR{}, &envoy_config_cluster_v3.Cluster{}); err != nil {
+					errs = append(errs, fmt.Errorf("%s.tls: %w", settings.path, err))
+				}
+				continue
+			}
+			for _, ns := range gatewayNamespaces {
+				if err := applyTLS(kctx, secrets, ns, settings.tls, ir.BackendObjectIR{}, &envoy_config_cluster_v3.Cluster{}); err != nil {
+					errs = append(errs, fmt.Errorf("%s.tls for the Gateways in namespace %s: %w", settings.path, ns, err))
+				}
+			}
+		}
+		return &destRuleStatus{
+			Named:      krt.Named{Namespace: dr.Namespace, Name: dr.Name},
+			Generation: dr.Generation,
+			Errors:     errs,
+		}
+	}, krtopts.ToOptions("DestinationRuleStatuses")...)
+}
+
+func exportedGatewayNamespaces(gateways []ir.Gateway, dr DestinationRuleWrapper) []string {
+	exportTo := sets.New[string]()
+	for _, ns := range dr.Spec.GetExportTo() {
+		if ns == "." {
+			ns = dr.Namespace
+		}
+		exportTo.Insert(ns)
+	}
+	namespaces := sets.New[string]()
+	for _, gw := range gateways {
+		if exportTo.Len() == 0 || exportTo.Contains(exportAllNs) || exportTo.Contains(gw.Namespace) {
+			namespaces.Insert(gw.Namespace)
+		}
+	}
+	return sets.SortedList(namespaces)
+}
+
+func buildRegisterCallback(
+	ctx context.Context,
+	commoncol *common.CommonCollections,
+	destrules krt.Collection[DestinationRuleWrapper],
+) func() {
+	return func() {
+		// the gateways are only available once the plugins are initialized
+		statuses := newDestRuleStatuses(destrules, commoncol.GatewayIndex.Gateways, commoncol.Secrets, commoncol.KrtOpts)
+		statuses.Register(func(o krt.Event[destRuleStatus]) {
+			if o.Event == controllers.EventDelete {
+				return
+			}
+			status := o.Latest()
+			if err := retry.Do(
+				func() error {
+					return updateDestRuleStatus(ctx, commoncol.CrudClient, status)
+				},
+				retry.Attempts(5),
+				retry.Delay(100*time.Millisecond),
+				retry.DelayType(retry.BackOffDelay),
+			); err != nil {
+				logger.Error("all attempts failed updating destination rule status", "destination_rule", status.ResourceName(), "error", err)
+			}
+		})
+	}
+}
+
+// updateDestRuleStatus sets the Accepted condition of the destination rule, keeping the conditions
+// of the other controllers. The condition is only added to valid destination rules to clear
+// a previous error.
+func updateDestRuleStatus(ctx context.Context, cl client.Client, status destRuleStatus) error {
+	res := networkingclient.DestinationRule{}
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: status.Namespace, Name: status.Name}, &res); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil
+		}
+		return err
+	}
+	if res.Generation != status.Generation {
+		// a newer generation will be validated
+		return nil
+	}
+
+	newCondition := pluginutils.BuildCondition("DestinationRule", status.Errors)
+	conditions := res.Status.GetConditions()
+	idx := slices.IndexFunc(conditions, func(c *istiometav1alpha1.IstioCondition) bool {
+		return c.GetType() == newCondition.Type
+	})
+	if idx < 0 && len(status.Errors) == 0 {
+		return nil
+	}
+	condition := &istiometav1alpha1.IstioCondition{
+		Type:               newCondition.Type,
+		Status:             string(newCondition.Status),
+		Reason:             newCondition.Reason,
+		Message:            newCondition.Message,
+		ObservedGeneration: res.Generation,
+		LastTransitionTime: timestamppb.Now(),
+	}
+	if idx >= 0 {
+		found := conditions[idx]
+		if found.GetStatus() == condition.GetStatus() {
+			if found.GetReason() == condition.GetReason() && found.GetMessage() == condition.GetMessage() &&
+				found.GetObservedGeneration() == condition.GetObservedGeneration() {
+				// condition is already up-to-date, nothing to do
+				return nil
+			}
+			condition.LastTransitionTime = found.GetLastTransitionTime()
+		}
+		conditions[idx] = condition
+	} else {
+		conditions = append(conditions, condition)
+	}
+	res.Status.Conditions = conditions
+	return cl.Status().Update(ctx, &res)
+}
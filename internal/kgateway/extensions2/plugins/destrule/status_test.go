@@ -0,0 +1,133 @@
+package destrule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	istiometav1alpha1 "istio.io/api/meta/v1alpha1"
+	"istio.io/api/networking/v1alpha3"
+	networkingclient "istio.io/client-go/pkg/apis/networking/v1"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/slices"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils/krtutil"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/schemes"
+)
+
+func TestDestRuleStatuses(t *testing.T) {
+	dr := destRule("reviews", time.Now(), &v1alpha3.DestinationRule{
+		Host: "reviews",
+		TrafficPolicy: &v1alpha3.TrafficPolicy{
+			Tls: &v1alpha3.ClientTLSSettings{
+				Mode:           v1alpha3.ClientTLSSettings_SIMPLE,
+				CredentialName: "reviews-ca",
+			},
+			PortLevelSettings: []*v1alpha3.TrafficPolicy_PortTrafficPolicy{{
+				Port: &v1alpha3.PortSelector{Number: 8080},
+				Tls:  &v1alpha3.ClientTLSSettings{Mode: v1alpha3.ClientTLSSettings_MUTUAL},
+			}},
+		},
+		Subsets: []*v1alpha3.Subset{{
+			Name: "v1",
+			TrafficPolicy: &v1alpha3.TrafficPolicy{
+				LoadBalancer: &v1alpha3.LoadBalancerSettings{
+					LbPolicy: &v1alpha3.LoadBalancerSettings_ConsistentHash{ConsistentHash: &v1alpha3.LoadBalancerSettings_ConsistentHashLB{
+						HashKey: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_HttpCookie{
+							HttpCookie: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_HTTPCookie{Name: "user"},
+						},
+					}},
+				},
+			},
+		}},
+	})
+	dr.Generation = 2
+
+	gateways := krt.NewStaticCollection([]ir.Gateway{
+		{ObjectSource: ir.ObjectSource{Namespace: "with-secret", Name: "gw"}},
+		{ObjectSource: ir.ObjectSource{Namespace: "without-secret", Name: "gw"}},
+	})
+	secrets := krtcollections.NewSecretIndex(map[schema.GroupKind]krt.Collection[ir.Secret]{
+		{Group: "", Kind: "Secret"}: krt.NewStaticCollection([]ir.Secret{{
+			ObjectSource: ir.ObjectSource{Kind: "Secret", Namespace: "with-secret", Name: "reviews-ca"},
+			Data:         map[string][]byte{"ca.crt": []byte("ca")},
+		}}),
+	}, krtcollections.NewRefGrantIndex(krt.NewStaticCollection[*gwv1beta1.ReferenceGrant](nil)))
+
+	statuses := newDestRuleStatuses(krt.NewStaticCollection([]DestinationRuleWrapper{dr}), gateways, secrets, krtutil.KrtOptions{})
+	statuses.WaitUntilSynced(nil)
+	status := statuses.GetKey(dr.ResourceName())
+	require.NotNil(t, status)
+	assert.Equal(t, int64(2), status.Generation)
+	errs := slices.Map(status.Errors, func(err error) string { return err.Error() })
+	// consistent hashing with a hash key is not applied, but does not invalidate the destination rule
+	require.Len(t, errs, 2)
+	assert.Contains(t, errs[0], "trafficPolicy.tls for the Gateways in namespace without-secret: failed to find secret reviews-ca")
+	assert.Contains(t, errs[1], "trafficPolicy.portLevelSettings[0].tls: MUTUAL tls mode requires a client certificate")
+}
+
+func TestUpdateDestRuleStatus(t *testing.T) {
+	ctx := context.Background()
+	nn := types.NamespacedName{Namespace: "default", Name: "reviews"}
+	istiodCondition := &istiometav1alpha1.IstioCondition{Type: "Reconciled", Status: "True"}
+	objs := []*networkingclient.DestinationRule{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: nn.Namespace, Name: nn.Name, Generation: 1},
+			Status: istiometav1alpha1.IstioStatus{
+				Conditions: []*istiometav1alpha1.IstioCondition{istiodCondition},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: nn.Namespace, Name: "valid", Generation: 1},
+		},
+	}
+	builder := fake.NewClientBuilder().WithScheme(schemes.DefaultScheme())
+	for _, obj := range objs {
+		builder = builder.WithObjects(obj).WithStatusSubresource(obj)
+	}
+	cl := builder.Build()
+
+	getConditions := func(name string) []*istiometav1alpha1.IstioCondition {
+		res := networkingclient.DestinationRule{}
+		require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: nn.Namespace, Name: name}, &res))
+		return res.Status.GetConditions()
+	}
+
+	// a valid destination rule is left untouched
+	require.NoError(t, updateDestRuleStatus(ctx, cl, destRuleStatus{
+		Named:      krt.Named{Namespace: nn.Namespace, Name: "valid"},
+		Generation: 1,
+	}))
+	assert.Empty(t, getConditions("valid"))
+
+	require.NoError(t, updateDestRuleStatus(ctx, cl, destRuleStatus{
+		Named:      krt.Named{Namespace: nn.Namespace, Name: nn.Name},
+		Generation: 1,
+		Errors:     []error{assert.AnError},
+	}))
+	conditions := getConditions(nn.Name)
+	require.Len(t, conditions, 2)
+	assert.Equal(t, istiodCondition.GetType(), conditions[0].GetType())
+	assert.Equal(t, "Accepted", conditions[1].GetType())
+	assert.Equal(t, "False", conditions[1].GetStatus())
+	assert.Contains(t, conditions[1].GetMessage(), assert.AnError.Error())
+	assert.Equal(t, int64(1), conditions[1].GetObservedGeneration())
+
+	// the error is cleared once fixed
+	require.NoError(t, updateDestRuleStatus(ctx, cl, destRuleStatus{
+		Named:      krt.Named{Namespace: nn.Namespace, Name: nn.Name},
+		Generation: 1,
+	}))
+	conditions = getConditions(nn.Name)
+	require.Len(t, conditions, 2)
+	assert.Equal(t, "True", conditions[1].GetStatus())
+}
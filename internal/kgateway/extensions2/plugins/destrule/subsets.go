@@ -0,0 +1,145 @@
+package destrule
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pkg/config/labels"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/ptr"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/network"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/common"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const SubsetClusterPrefix = "istio-subset"
+
+// subsetIR is the IR of the backend for a subset of the destination rules of a Service.
+type subsetIR struct {
+	subset string
+	labels map[string]string
+}
+
+func (s subsetIR) Equals(in any) bool {
+	o, ok := in.(subsetIR)
+	if !ok {
+		return false
+	}
+	return s.subset == o.subset && maps.Equal(s.labels, o.labels)
+}
+
+// newSubsetBackends builds a backend for each port of a Service and each subset of the destination
+// rules for the Service. Subsets are built regardless of where the destination rules are exported to,
+// their traffic policy is only applied for the proxies the destination rules are visible to.
+func newSubsetBackends(
+	destrules DestinationRuleIndex,
+	services krt.Collection[*corev1.Service],
+	commoncol *common.CommonCollections,
+) krt.Collection[ir.BackendObjectIR] {
+	return krt.NewManyCollection(services, func(kctx krt.HandlerContext, svc *corev1.Service) []ir.BackendObjectIR {
+		merged := mergeDestRules(krt.Fetch(kctx, destrules.Destrules, krt.FilterIndex(destrules.ByHost, serviceHostname(svc))))
+		if merged == nil {
+			return nil
+		}
+		var backends []ir.BackendObjectIR
+		for _, subset := range merged.Spec.GetSubsets() {
+			for _, port := range svc.Spec.Ports {
+				backends = append(backends, buildSubsetBackendObjectIR(svc, port, subset))
+			}
+		}
+		return backends
+	}, commoncol.KrtOpts.ToOptions("DestinationRuleSubsetBackends")...)
+}
+
+func buildSubsetBackendObjectIR(svc *corev1.Service, port corev1.ServicePort, subset *v1alpha3.Subset) ir.BackendObjectIR {
+	objSrc := ir.ObjectSource{
+		Group:     wellknown.SubsetGVK.Group,
+		Kind:      wellknown.SubsetGVK.Kind,
+		Namespace: svc.Namespace,
+		Name:      fmt.Sprintf("%s.%s", svc.Name, subset.GetName()),
+	}
+	backend := ir.NewBackendObjectIR(objSrc, port.Port, "")
+	backend.Obj = svc
+	backend.ObjIr = subsetIR{
+		subset: subset.GetName(),
+		labels: subset.GetLabels(),
+	}
+	backend.AppProtocol = ir.ParseAppProtocol(ptr.Of(ptr.OrDefault(port.AppProtocol, port.Name)))
+	backend.GvPrefix = SubsetClusterPrefix
+	backend.CanonicalHostname = serviceHostname(svc)
+	// policies targeting the Service also apply to its subsets
+	backend.Aliases = []ir.ObjectSource{{
+		Group:     wellknown.ServiceGVK.Group,
+		Kind:      wellknown.ServiceGVK.Kind,
+		Namespace: svc.Namespace,
+		Name:      svc.Name,
+	}}
+	return backend
+}
+
+func serviceHostname(svc *corev1.Service) string {
+	return fmt.Sprintf("%s.%s.svc.%s", svc.Name, svc.Namespace, network.GetClusterDomainName())
+}
+
+// newSubsetEndpoints builds the endpoints of the subset backends: the endpoints of the Service
+// whose labels match the labels of the subset.
+func newSubsetEndpoints(
+	ctx context.Context,
+	commoncol *common.CommonCollections,
+	backends krt.Collection[ir.BackendObjectIR],
+) krt.Collection[ir.EndpointsForBackend] {
+	inputs := krtcollections.NewGlooK8sEndpointInputs(commoncol.Settings, commoncol.KrtOpts, commoncol.EndpointSlices, commoncol.Pods, backends)
+	serviceEndpoints := krtcollections.NewK8sEndpoints(ctx, inputs)
+
+	return krt.NewCollection(serviceEndpoints, func(kctx krt.HandlerContext, eps ir.EndpointsForBackend) *ir.EndpointsForBackend {
+		subset := fetchSubset(kctx, backends, eps.ResourceName())
+		if subset == nil {
+			return nil
+		}
+		out := eps.EmptyCopy()
+		for locality, endpoints := range eps.LbEps {
+			for _, ep := range endpoints {
+				if labels.Instance(subset.labels).SubsetOf(ep.EndpointMd.Labels) {
+					out.Add(locality, ep)
+				}
+			}
+		}
+		return &out
+	}, commoncol.KrtOpts.ToOptions("DestinationRuleSubsetEndpoints")...)
+}
+
+func fetchSubset(kctx krt.HandlerContext, backends krt.Collection[ir.BackendObjectIR], resourceName string) *subsetIR {
+	backend := krt.FetchOne(kctx, backends, krt.FilterKey(resourceName))
+	if backend == nil {
+		return nil
+	}
+	subset, ok := backend.ObjIr.(subsetIR)
+	if !ok {
+		return nil
+	}
+	return &subset
+}
+
+func initSubsetBackend(ctx context.Context, in ir.BackendObjectIR, out *envoy_config_cluster_v3.Cluster) *ir.EndpointsForBackend {
+	out.ClusterDiscoveryType = &envoy_config_cluster_v3.Cluster_Type{
+		Type: envoy_config_cluster_v3.Cluster_EDS,
+	}
+	out.EdsClusterConfig = &envoy_config_cluster_v3.Cluster_EdsClusterConfig{
+		EdsConfig: &envoy_config_core_v3.ConfigSource{
+			ResourceApiVersion: envoy_config_core_v3.ApiVersion_V3,
+			ConfigSourceSpecifier: &envoy_config_core_v3.ConfigSource_Ads{
+				Ads: &envoy_config_core_v3.AggregatedConfigSource{},
+			},
+		},
+	}
+	out.IgnoreHealthOnHostRemoval = true
+	return nil
+}
@@ -0,0 +1,77 @@
+package destrule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pkg/kube/krt"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/common"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestSubsetEndpoints(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "reviews"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Name: "http", Port: 9080}},
+		},
+	}
+	slice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "reviews-abc",
+			Labels:    map[string]string{discoveryv1.LabelServiceName: "reviews"},
+		},
+		Ports: []discoveryv1.EndpointPort{{Name: ptr.To("http"), Port: ptr.To[int32](9080)}},
+		Endpoints: []discoveryv1.Endpoint{
+			{
+				Addresses: []string{"10.0.0.1"},
+				TargetRef: &corev1.ObjectReference{Kind: "Pod", Namespace: "default", Name: "reviews-v1"},
+			},
+			{
+				Addresses: []string{"10.0.0.2"},
+				TargetRef: &corev1.ObjectReference{Kind: "Pod", Namespace: "default", Name: "reviews-v2"},
+			},
+		},
+	}
+	pods := krt.NewStaticCollection([]krtcollections.LocalityPod{
+		{
+			Named:           krt.Named{Namespace: "default", Name: "reviews-v1"},
+			AugmentedLabels: map[string]string{"app": "reviews", "version": "v1"},
+		},
+		{
+			Named:           krt.Named{Namespace: "default", Name: "reviews-v2"},
+			AugmentedLabels: map[string]string{"app": "reviews", "version": "v2"},
+		},
+	})
+	backend := buildSubsetBackendObjectIR(svc, svc.Spec.Ports[0], &v1alpha3.Subset{
+		Name:   "v1",
+		Labels: map[string]string{"version": "v1"},
+	})
+
+	commoncol := &common.CommonCollections{
+		EndpointSlices: krt.NewStaticCollection([]*discoveryv1.EndpointSlice{slice}),
+		Pods:           pods,
+	}
+	endpoints := newSubsetEndpoints(context.Background(), commoncol, krt.NewStaticCollection([]ir.BackendObjectIR{backend}))
+	endpoints.WaitUntilSynced(nil)
+
+	eps := endpoints.GetKey(backend.ResourceName())
+	require.NotNil(t, eps, "subset backend has no endpoints")
+	var addresses []string
+	for _, lbEps := range eps.LbEps {
+		for _, ep := range lbEps {
+			addresses = append(addresses, ep.GetEndpoint().GetAddress().GetSocketAddress().GetAddress())
+		}
+	}
+	assert.Equal(t, []string{"10.0.0.1"}, addresses)
+}
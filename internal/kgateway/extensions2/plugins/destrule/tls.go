@@ -0,0 +1,169 @@
+package destrule
+
+import (
+	"fmt"
+	"strings"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	sockets_raw_buffer "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/raw_buffer/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/slices"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/istio"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	translatorutils "github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/utils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+)
+
+// applyTLS sets the transport socket of the cluster according to the TLS mode of the destination rule.
+// The Istio auto mTLS transport socket matches are dropped, as explicit settings take precedence.
+func applyTLS(
+	kctx krt.HandlerContext,
+	secrets *krtcollections.SecretIndex,
+	proxyNs string,
+	tls *v1alpha3.ClientTLSSettings,
+	backend ir.BackendObjectIR,
+	outCluster *envoy_config_cluster_v3.Cluster,
+) error {
+	if tls == nil {
+		return nil
+	}
+
+	var ts *envoy_config_core_v3.TransportSocket
+	switch tls.GetMode() {
+	case v1alpha3.ClientTLSSettings_DISABLE:
+		// an explicit raw buffer transport socket, so that auto mTLS is not applied
+		typedConfig, err := utils.MessageToAny(&sockets_raw_buffer.RawBuffer{})
+		if err != nil {
+			return err
+		}
+		ts = &envoy_config_core_v3.TransportSocket{
+			Name:       wellknown.TransportSocketRawBuffer,
+			ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
+		}
+	case v1alpha3.ClientTLSSettings_ISTIO_MUTUAL:
+		sni := tls.GetSni()
+		if sni == "" {
+			sni = istio.BuildSni(backend)
+		}
+		ts = istio.IstioMutualTransportSocket(sni)
+	case v1alpha3.ClientTLSSettings_SIMPLE, v1alpha3.ClientTLSSettings_MUTUAL:
+		tlsContext, err := translateClientTLSSettings(kctx, secrets, proxyNs, tls)
+		if err != nil {
+			return err
+		}
+		typedConfig, err := utils.MessageToAny(tlsContext)
+		if err != nil {
+			return err
+		}
+		ts = &envoy_config_core_v3.TransportSocket{
+			Name:       wellknown.TransportSocketTls,
+			ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
+		}
+	default:
+		return fmt.Errorf("unsupported tls mode %s", tls.GetMode())
+	}
+
+	outCluster.TransportSocketMatches = slices.FilterInPlace(outCluster.GetTransportSocketMatches(), func(m *envoy_config_cluster_v3.Cluster_TransportSocketMatch) bool {
+		return !istio.IsIstioTransportSocketMatch(m)
+	})
+	if len(outCluster.GetTransportSocketMatches()) == 0 {
+		outCluster.TransportSocketMatches = nil
+	}
+	return translatorutils.SetTransportSocket(outCluster, ts)
+}
+
+func translateClientTLSSettings(
+	kctx krt.HandlerContext,
+	secrets *krtcollections.SecretIndex,
+	proxyNs string,
+	tls *v1alpha3.ClientTLSSettings,
+) (*tlsv3.UpstreamTlsContext, error) {
+	var certChain, privateKey, rootCA, crl *envoy_config_core_v3.DataSource
+	if name := tls.GetCredentialName(); name != "" {
+		// like Istio gateways, the credential is read from the namespace of the proxy
+		secret, err := pluginutils.GetSecretIr(secrets, kctx, name, proxyNs)
+		if err != nil {
+			return nil, err
+		}
+		certChain = inlineDataSource(secret.Data, "tls.crt", "cert")
+		privateKey = inlineDataSource(secret.Data, "tls.key", "key")
+		rootCA = inlineDataSource(secret.Data, "ca.crt", "cacert")
+		crl = inlineDataSource(secret.Data, "ca.crl")
+	} else {
+		certChain = fileDataSource(tls.GetClientCertificate())
+		privateKey = fileDataSource(tls.GetPrivateKey())
+		rootCA = fileDataSource(tls.GetCaCertificates())
+		crl = fileDataSource(tls.GetCaCrl())
+	}
+
+	commonTlsContext := &tlsv3.CommonTlsContext{
+		TlsParams: &tlsv3.TlsParameters{},
+	}
+	if tls.GetMode() == v1alpha3.ClientTLSSettings_MUTUAL {
+		if certChain == nil || privateKey == nil {
+			return nil, fmt.Errorf("MUTUAL tls mode requires a client certificate and a private key")
+		}
+		commonTlsContext.TlsCertificates = []*tlsv3.TlsCertificate{{
+			CertificateChain: certChain,
+			PrivateKey:       privateKey,
+		}}
+	}
+	if !tls.GetInsecureSkipVerify().GetValue() && rootCA != nil {
+		commonTlsContext.ValidationContextType = &tlsv3.CommonTlsContext_ValidationContext{
+			ValidationContext: &tlsv3.CertificateValidationContext{
+				TrustedCa:                 rootCA,
+				Crl:                       crl,
+				MatchTypedSubjectAltNames: subjectAltNameMatchers(tls.GetSubjectAltNames()),
+			},
+		}
+	}
+
+	return &tlsv3.UpstreamTlsContext{
+		CommonTlsContext: commonTlsContext,
+		Sni:              tls.GetSni(),
+	}, nil
+}
+
+func subjectAltNameMatchers(subjectAltNames []string) []*tlsv3.SubjectAltNameMatcher {
+	return slices.Map(subjectAltNames, func(san string) *tlsv3.SubjectAltNameMatcher {
+		sanType := tlsv3.SubjectAltNameMatcher_DNS
+		if strings.HasPrefix(san, "spiffe://") {
+			sanType = tlsv3.SubjectAltNameMatcher_URI
+		}
+		return &tlsv3.SubjectAltNameMatcher{
+			SanType: sanType,
+			Matcher: &envoy_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_matcher_v3.StringMatcher_Exact{Exact: san},
+			},
+		}
+	})
+}
+
+func fileDataSource(filename string) *envoy_config_core_v3.DataSource {
+	if filename == "" {
+		return nil
+	}
+	return &envoy_config_core_v3.DataSource{
+		Specifier: &envoy_config_core_v3.DataSource_Filename{Filename: filename},
+	}
+}
+
+// inlineDataSource returns the data of the first of the keys present in the secret.
+func inlineDataSource(data map[string][]byte, keys ...string) *envoy_config_core_v3.DataSource {
+	for _, key := range keys {
+		if v := data[key]; len(v) > 0 {
+			return &envoy_config_core_v3.DataSource{
+				Specifier: &envoy_config_core_v3.DataSource_InlineBytes{InlineBytes: v},
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,268 @@
+package destrule
+
+import (
+	"fmt"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_upstreams_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	envoy_upstreams_tcp_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/tcp/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"istio.io/api/networking/v1alpha3"
+
+	translatorutils "github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/utils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const tcpProtocolOptionsKey = "envoy.extensions.upstreams.tcp.v3.TcpProtocolOptions"
+
+func applyOutlierDetection(trafficPolicy *v1alpha3.TrafficPolicy, outCluster *envoy_config_cluster_v3.Cluster) {
+	outlier := trafficPolicy.GetOutlierDetection()
+	if outlier == nil {
+		return
+	}
+	// like in Istio, locality load balancing only takes effect along with outlier detection
+	if getLocalityLbSetting(trafficPolicy) != nil {
+		if outCluster.GetCommonLbConfig() == nil {
+			outCluster.CommonLbConfig = &envoy_config_cluster_v3.Cluster_CommonLbConfig{}
+		}
+		outCluster.GetCommonLbConfig().LocalityConfigSpecifier = &envoy_config_cluster_v3.Cluster_CommonLbConfig_LocalityWeightedLbConfig_{
+			LocalityWeightedLbConfig: &envoy_config_cluster_v3.Cluster_CommonLbConfig_LocalityWeightedLbConfig{},
+		}
+	}
+	out := &envoy_config_cluster_v3.OutlierDetection{
+		Consecutive_5Xx:  outlier.GetConsecutive_5XxErrors(),
+		Interval:         outlier.GetInterval(),
+		BaseEjectionTime: outlier.GetBaseEjectionTime(),
+	}
+	if e := outlier.GetConsecutiveGatewayErrors(); e != nil {
+		v := e.GetValue()
+		out.ConsecutiveGatewayFailure = &wrapperspb.UInt32Value{Value: v}
+		if v > 0 {
+			v = 100
+		}
+		out.EnforcingConsecutiveGatewayFailure = &wrapperspb.UInt32Value{Value: v}
+	}
+	if outlier.GetMaxEjectionPercent() > 0 {
+		out.MaxEjectionPercent = &wrapperspb.UInt32Value{Value: uint32(outlier.GetMaxEjectionPercent())}
+	}
+	if outlier.GetSplitExternalLocalOriginErrors() {
+		out.SplitExternalLocalOriginErrors = true
+		if outlier.GetConsecutiveLocalOriginFailures().GetValue() > 0 {
+			out.ConsecutiveLocalOriginFailure = &wrapperspb.UInt32Value{Value: outlier.GetConsecutiveLocalOriginFailures().Value}
+			out.EnforcingConsecutiveLocalOriginFailure = &wrapperspb.UInt32Value{Value: 100}
+		}
+		// SuccessRate based outlier detection should be disabled.
+		out.EnforcingLocalOriginSuccessRate = &wrapperspb.UInt32Value{Value: 0}
+	}
+	minHealthPercent := outlier.GetMinHealthPercent()
+	if minHealthPercent >= 0 {
+		if outCluster.GetCommonLbConfig() == nil {
+			outCluster.CommonLbConfig = &envoy_config_cluster_v3.Cluster_CommonLbConfig{}
+		}
+		outCluster.GetCommonLbConfig().HealthyPanicThreshold = &envoy_type_v3.Percent{Value: float64(minHealthPercent)}
+	}
+
+	outCluster.OutlierDetection = out
+}
+
+func applyConnectionPool(connectionPool *v1alpha3.ConnectionPoolSettings, backend ir.BackendObjectIR, outCluster *envoy_config_cluster_v3.Cluster) {
+	if connectionPool == nil {
+		return
+	}
+	tcpSettings := connectionPool.GetTcp()
+	httpSettings := connectionPool.GetHttp()
+
+	thresholds := &envoy_config_cluster_v3.CircuitBreakers_Thresholds{}
+	if tcpSettings.GetMaxConnections() > 0 {
+		thresholds.MaxConnections = &wrapperspb.UInt32Value{Value: uint32(tcpSettings.GetMaxConnections())}
+	}
+	if httpSettings.GetHttp1MaxPendingRequests() > 0 {
+		thresholds.MaxPendingRequests = &wrapperspb.UInt32Value{Value: uint32(httpSettings.GetHttp1MaxPendingRequests())}
+	}
+	if httpSettings.GetHttp2MaxRequests() > 0 {
+		thresholds.MaxRequests = &wrapperspb.UInt32Value{Value: uint32(httpSettings.GetHttp2MaxRequests())}
+	}
+	if httpSettings.GetMaxRetries() > 0 {
+		thresholds.MaxRetries = &wrapperspb.UInt32Value{Value: uint32(httpSettings.GetMaxRetries())}
+	}
+	if thresholds.GetMaxConnections() != nil || thresholds.GetMaxPendingRequests() != nil ||
+		thresholds.GetMaxRequests() != nil || thresholds.GetMaxRetries() != nil {
+		// the thresholds set by a BackendConfigPolicy take precedence
+		translatorutils.MergeCircuitBreakers(outCluster, &envoy_config_cluster_v3.CircuitBreakers{
+			Thresholds: []*envoy_config_cluster_v3.CircuitBreakers_Thresholds{thresholds},
+		}, false)
+	}
+
+	if tcpSettings.GetConnectTimeout() != nil {
+		outCluster.ConnectTimeout = tcpSettings.GetConnectTimeout()
+	}
+	applyTcpKeepalive(tcpSettings.GetTcpKeepalive(), outCluster)
+	if tcpSettings.GetIdleTimeout() != nil {
+		tcpProtocolOptions, err := utils.MessageToAny(&envoy_upstreams_tcp_v3.TcpProtocolOptions{
+			IdleTimeout: tcpSettings.GetIdleTimeout(),
+		})
+		if err != nil {
+			logger.Error("failed to apply tcp idle timeout", "backend", backend.GetName(), "error", err)
+		} else {
+			if outCluster.GetTypedExtensionProtocolOptions() == nil {
+				outCluster.TypedExtensionProtocolOptions = map[string]*anypb.Any{}
+			}
+			outCluster.GetTypedExtensionProtocolOptions()[tcpProtocolOptionsKey] = tcpProtocolOptions
+		}
+	}
+
+	if httpSettings == nil && tcpSettings.GetMaxConnectionDuration() == nil {
+		return
+	}
+	// the upstream protocol is set before the common options, which require it
+	upgrade := httpSettings.GetH2UpgradePolicy() == v1alpha3.ConnectionPoolSettings_HTTPSettings_UPGRADE &&
+		backend.AppProtocol == ir.DefaultAppProtocol
+	if err := translatorutils.MutateHttpOptions(outCluster, func(opts *envoy_upstreams_v3.HttpProtocolOptions) {
+		switch {
+		case httpSettings.GetUseClientProtocol():
+			opts.UpstreamProtocolOptions = &envoy_upstreams_v3.HttpProtocolOptions_UseDownstreamProtocolConfig{
+				UseDownstreamProtocolConfig: &envoy_upstreams_v3.HttpProtocolOptions_UseDownstreamHttpConfig{
+					HttpProtocolOptions:  &envoy_config_core_v3.Http1ProtocolOptions{},
+					Http2ProtocolOptions: &envoy_config_core_v3.Http2ProtocolOptions{},
+				},
+			}
+		case upgrade:
+			opts.UpstreamProtocolOptions = &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+				ExplicitHttpConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig{
+					ProtocolConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_Http2ProtocolOptions{
+						Http2ProtocolOptions: &envoy_config_core_v3.Http2ProtocolOptions{},
+					},
+				},
+			}
+		case opts.GetUpstreamProtocolOptions() == nil:
+			// Envoy requires UpstreamProtocolOptions if CommonHttpProtocolOptions is set.
+			opts.UpstreamProtocolOptions = &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+				ExplicitHttpConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig{
+					ProtocolConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_HttpProtocolOptions{},
+				},
+			}
+		}
+		if maxConcurrentStreams := httpSettings.GetMaxConcurrentStreams(); maxConcurrentStreams > 0 {
+			if http2 := opts.GetExplicitHttpConfig().GetHttp2ProtocolOptions(); http2 != nil {
+				http2.MaxConcurrentStreams = &wrapperspb.UInt32Value{Value: uint32(maxConcurrentStreams)}
+			} else if http2 := opts.GetUseDownstreamProtocolConfig().GetHttp2ProtocolOptions(); http2 != nil {
+				http2.MaxConcurrentStreams = &wrapperspb.UInt32Value{Value: uint32(maxConcurrentStreams)}
+			}
+		}
+
+		if opts.GetCommonHttpProtocolOptions() == nil {
+			opts.CommonHttpProtocolOptions = &envoy_config_core_v3.HttpProtocolOptions{}
+		}
+		common := opts.GetCommonHttpProtocolOptions()
+		if httpSettings.GetIdleTimeout() != nil {
+			common.IdleTimeout = httpSettings.GetIdleTimeout()
+		}
+		if httpSettings.GetMaxRequestsPerConnection() > 0 {
+			common.MaxRequestsPerConnection = &wrapperspb.UInt32Value{Value: uint32(httpSettings.GetMaxRequestsPerConnection())}
+		}
+		if tcpSettings.GetMaxConnectionDuration() != nil {
+			common.MaxConnectionDuration = tcpSettings.GetMaxConnectionDuration()
+		}
+	}); err != nil {
+		logger.Error("failed to apply http connection pool settings", "backend", backend.GetName(), "error", err)
+	}
+}
+
+func applyTcpKeepalive(tcpKeepalive *v1alpha3.ConnectionPoolSettings_TCPSettings_TcpKeepalive, outCluster *envoy_config_cluster_v3.Cluster) {
+	if tcpKeepalive == nil {
+		return
+	}
+	if outCluster.GetUpstreamConnectionOptions() == nil {
+		outCluster.UpstreamConnectionOptions = &envoy_config_cluster_v3.UpstreamConnectionOptions{}
+	}
+	if outCluster.GetUpstreamConnectionOptions().GetTcpKeepalive() == nil {
+		outCluster.GetUpstreamConnectionOptions().TcpKeepalive = &envoy_config_core_v3.TcpKeepalive{}
+	}
+	if tcpKeepalive.GetTime() != nil {
+		outCluster.GetUpstreamConnectionOptions().GetTcpKeepalive().KeepaliveTime = &wrapperspb.UInt32Value{Value: uint32(tcpKeepalive.GetTime().GetSeconds())}
+	}
+	if tcpKeepalive.GetInterval() != nil {
+		outCluster.GetUpstreamConnectionOptions().GetTcpKeepalive().KeepaliveInterval = &wrapperspb.UInt32Value{Value: uint32(tcpKeepalive.GetInterval().GetSeconds())}
+	}
+	if tcpKeepalive.GetProbes() > 0 {
+		outCluster.GetUpstreamConnectionOptions().GetTcpKeepalive().KeepaliveProbes = &wrapperspb.UInt32Value{Value: uint32(tcpKeepalive.GetProbes())}
+	}
+}
+
+// applyLoadBalancer sets the load balancing policy of the cluster. The hash key of consistent
+// hashing is a route setting in Envoy, and the routes of a Gateway are translated without the
+// destination rules of its proxies, so only consistent hashing without a hash key is supported.
+// Consistent hashing with a hash key leaves the load balancing policy of the cluster unchanged
+// rather than hashing on nothing.
+func applyLoadBalancer(lb *v1alpha3.LoadBalancerSettings, outCluster *envoy_config_cluster_v3.Cluster) error {
+	if lb == nil {
+		return nil
+	}
+
+	var slowStart *envoy_config_cluster_v3.Cluster_SlowStartConfig
+	if warmup := lb.GetWarmup().GetDuration(); warmup != nil {
+		slowStart = &envoy_config_cluster_v3.Cluster_SlowStartConfig{SlowStartWindow: warmup}
+	} else if warmup := lb.GetWarmupDurationSecs(); warmup != nil {
+		slowStart = &envoy_config_cluster_v3.Cluster_SlowStartConfig{SlowStartWindow: warmup}
+	}
+
+	if consistentHash := lb.GetConsistentHash(); consistentHash != nil {
+		if consistentHash.GetHashKey() != nil {
+			return nil
+		}
+		outCluster.LbConfig = nil
+		if maglev := consistentHash.GetMaglev(); maglev != nil {
+			outCluster.LbPolicy = envoy_config_cluster_v3.Cluster_MAGLEV
+			if maglev.GetTableSize() > 0 {
+				outCluster.LbConfig = &envoy_config_cluster_v3.Cluster_MaglevLbConfig_{
+					MaglevLbConfig: &envoy_config_cluster_v3.Cluster_MaglevLbConfig{
+						TableSize: &wrapperspb.UInt64Value{Value: maglev.GetTableSize()},
+					},
+				}
+			}
+			return nil
+		}
+		outCluster.LbPolicy = envoy_config_cluster_v3.Cluster_RING_HASH
+		minimumRingSize := consistentHash.GetRingHash().GetMinimumRingSize()
+		if minimumRingSize == 0 {
+			minimumRingSize = consistentHash.GetMinimumRingSize()
+		}
+		if minimumRingSize > 0 {
+			outCluster.LbConfig = &envoy_config_cluster_v3.Cluster_RingHashLbConfig_{
+				RingHashLbConfig: &envoy_config_cluster_v3.Cluster_RingHashLbConfig{
+					MinimumRingSize: &wrapperspb.UInt64Value{Value: minimumRingSize},
+				},
+			}
+		}
+		return nil
+	}
+
+	switch lb.GetSimple() {
+	case v1alpha3.LoadBalancerSettings_ROUND_ROBIN:
+		outCluster.LbPolicy = envoy_config_cluster_v3.Cluster_ROUND_ROBIN
+		outCluster.LbConfig = nil
+		if slowStart != nil {
+			outCluster.LbConfig = &envoy_config_cluster_v3.Cluster_RoundRobinLbConfig_{
+				RoundRobinLbConfig: &envoy_config_cluster_v3.Cluster_RoundRobinLbConfig{SlowStartConfig: slowStart},
+			}
+		}
+	case v1alpha3.LoadBalancerSettings_LEAST_REQUEST, v1alpha3.LoadBalancerSettings_LEAST_CONN:
+		outCluster.LbPolicy = envoy_config_cluster_v3.Cluster_LEAST_REQUEST
+		outCluster.LbConfig = nil
+		if slowStart != nil {
+			outCluster.LbConfig = &envoy_config_cluster_v3.Cluster_LeastRequestLbConfig_{
+				LeastRequestLbConfig: &envoy_config_cluster_v3.Cluster_LeastRequestLbConfig{SlowStartConfig: slowStart},
+			}
+		}
+	case v1alpha3.LoadBalancerSettings_RANDOM:
+		outCluster.LbPolicy = envoy_config_cluster_v3.Cluster_RANDOM
+		outCluster.LbConfig = nil
+	case v1alpha3.LoadBalancerSettings_PASSTHROUGH:
+		return fmt.Errorf("PASSTHROUGH load balancing is not supported")
+	}
+	return nil
+}
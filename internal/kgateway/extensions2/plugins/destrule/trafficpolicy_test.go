@@ -0,0 +1,262 @@
+package destrule
+
+import (
+	"testing"
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_upstreams_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"istio.io/api/networking/v1alpha3"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestApplyConnectionPool(t *testing.T) {
+	out := &envoy_config_cluster_v3.Cluster{}
+	applyConnectionPool(&v1alpha3.ConnectionPoolSettings{
+		Tcp: &v1alpha3.ConnectionPoolSettings_TCPSettings{
+			MaxConnections: 100,
+			ConnectTimeout: durationpb.New(3 * time.Second),
+		},
+		Http: &v1alpha3.ConnectionPoolSettings_HTTPSettings{
+			Http1MaxPendingRequests:  10,
+			Http2MaxRequests:         20,
+			MaxRetries:               3,
+			MaxRequestsPerConnection: 1,
+			IdleTimeout:              durationpb.New(time.Minute),
+			H2UpgradePolicy:          v1alpha3.ConnectionPoolSettings_HTTPSettings_UPGRADE,
+			MaxConcurrentStreams:     50,
+		},
+	}, ir.BackendObjectIR{}, out)
+
+	a := assert.New(t)
+	a.True(proto.Equal(&envoy_config_cluster_v3.CircuitBreakers{
+		Thresholds: []*envoy_config_cluster_v3.CircuitBreakers_Thresholds{{
+			MaxConnections:     &wrapperspb.UInt32Value{Value: 100},
+			MaxPendingRequests: &wrapperspb.UInt32Value{Value: 10},
+			MaxRequests:        &wrapperspb.UInt32Value{Value: 20},
+			MaxRetries:         &wrapperspb.UInt32Value{Value: 3},
+		}},
+	}, out.GetCircuitBreakers()), "unexpected circuit breakers %v", out.GetCircuitBreakers())
+	a.True(proto.Equal(durationpb.New(3*time.Second), out.GetConnectTimeout()))
+
+	httpOptions := &envoy_upstreams_v3.HttpProtocolOptions{}
+	require.NoError(t, out.GetTypedExtensionProtocolOptions()["envoy.extensions.upstreams.http.v3.HttpProtocolOptions"].UnmarshalTo(httpOptions))
+	a.True(proto.Equal(&envoy_upstreams_v3.HttpProtocolOptions{
+		CommonHttpProtocolOptions: &envoy_config_core_v3.HttpProtocolOptions{
+			IdleTimeout:              durationpb.New(time.Minute),
+			MaxRequestsPerConnection: &wrapperspb.UInt32Value{Value: 1},
+		},
+		UpstreamProtocolOptions: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+			ExplicitHttpConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig{
+				ProtocolConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_Http2ProtocolOptions{
+					Http2ProtocolOptions: &envoy_config_core_v3.Http2ProtocolOptions{
+						MaxConcurrentStreams: &wrapperspb.UInt32Value{Value: 50},
+					},
+				},
+			},
+		},
+	}, httpOptions), "unexpected http protocol options %v", httpOptions)
+}
+
+func TestApplyConnectionPoolMergesCircuitBreakers(t *testing.T) {
+	// circuit breakers set by a BackendConfigPolicy, shared with its IR
+	policyCircuitBreakers := &envoy_config_cluster_v3.CircuitBreakers{
+		Thresholds: []*envoy_config_cluster_v3.CircuitBreakers_Thresholds{
+			{
+				MaxConnections: &wrapperspb.UInt32Value{Value: 50},
+			},
+			{
+				Priority:       envoy_config_core_v3.RoutingPriority_HIGH,
+				MaxConnections: &wrapperspb.UInt32Value{Value: 5},
+			},
+		},
+	}
+	out := &envoy_config_cluster_v3.Cluster{CircuitBreakers: policyCircuitBreakers}
+	applyConnectionPool(&v1alpha3.ConnectionPoolSettings{
+		Tcp:  &v1alpha3.ConnectionPoolSettings_TCPSettings{MaxConnections: 100},
+		Http: &v1alpha3.ConnectionPoolSettings_HTTPSettings{MaxRetries: 3},
+	}, ir.BackendObjectIR{}, out)
+
+	assert.True(t, proto.Equal(&envoy_config_cluster_v3.CircuitBreakers{
+		Thresholds: []*envoy_config_cluster_v3.CircuitBreakers_Thresholds{
+			{
+				MaxConnections: &wrapperspb.UInt32Value{Value: 50},
+				MaxRetries:     &wrapperspb.UInt32Value{Value: 3},
+			},
+			{
+				Priority:       envoy_config_core_v3.RoutingPriority_HIGH,
+				MaxConnections: &wrapperspb.UInt32Value{Value: 5},
+			},
+		},
+	}, out.GetCircuitBreakers()), "unexpected circuit breakers %v", out.GetCircuitBreakers())
+	assert.Nil(t, policyCircuitBreakers.GetThresholds()[0].GetMaxRetries(), "the policy circuit breakers should not be modified")
+}
+
+func TestApplyLoadBalancer(t *testing.T) {
+	tests := []struct {
+		name     string
+		lb       *v1alpha3.LoadBalancerSettings
+		expected *envoy_config_cluster_v3.Cluster
+		err      string
+	}{
+		{
+			name: "least request with warmup",
+			lb: &v1alpha3.LoadBalancerSettings{
+				LbPolicy: &v1alpha3.LoadBalancerSettings_Simple{Simple: v1alpha3.LoadBalancerSettings_LEAST_REQUEST},
+				Warmup:   &v1alpha3.WarmupConfiguration{Duration: durationpb.New(time.Minute)},
+			},
+			expected: &envoy_config_cluster_v3.Cluster{
+				LbPolicy: envoy_config_cluster_v3.Cluster_LEAST_REQUEST,
+				LbConfig: &envoy_config_cluster_v3.Cluster_LeastRequestLbConfig_{
+					LeastRequestLbConfig: &envoy_config_cluster_v3.Cluster_LeastRequestLbConfig{
+						SlowStartConfig: &envoy_config_cluster_v3.Cluster_SlowStartConfig{SlowStartWindow: durationpb.New(time.Minute)},
+					},
+				},
+			},
+		},
+		{
+			name: "random",
+			lb: &v1alpha3.LoadBalancerSettings{
+				LbPolicy: &v1alpha3.LoadBalancerSettings_Simple{Simple: v1alpha3.LoadBalancerSettings_RANDOM},
+			},
+			expected: &envoy_config_cluster_v3.Cluster{
+				LbPolicy: envoy_config_cluster_v3.Cluster_RANDOM,
+			},
+		},
+		{
+			name: "ring hash",
+			lb: &v1alpha3.LoadBalancerSettings{
+				LbPolicy: &v1alpha3.LoadBalancerSettings_ConsistentHash{ConsistentHash: &v1alpha3.LoadBalancerSettings_ConsistentHashLB{
+					HashAlgorithm: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_RingHash_{
+						RingHash: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_RingHash{MinimumRingSize: 1024},
+					},
+				}},
+			},
+			expected: &envoy_config_cluster_v3.Cluster{
+				LbPolicy: envoy_config_cluster_v3.Cluster_RING_HASH,
+				LbConfig: &envoy_config_cluster_v3.Cluster_RingHashLbConfig_{
+					RingHashLbConfig: &envoy_config_cluster_v3.Cluster_RingHashLbConfig{
+						MinimumRingSize: &wrapperspb.UInt64Value{Value: 1024},
+					},
+				},
+			},
+		},
+		{
+			name: "maglev",
+			lb: &v1alpha3.LoadBalancerSettings{
+				LbPolicy: &v1alpha3.LoadBalancerSettings_ConsistentHash{ConsistentHash: &v1alpha3.LoadBalancerSettings_ConsistentHashLB{
+					HashAlgorithm: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_Maglev{
+						Maglev: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_MagLev{TableSize: 65537},
+					},
+				}},
+			},
+			expected: &envoy_config_cluster_v3.Cluster{
+				LbPolicy: envoy_config_cluster_v3.Cluster_MAGLEV,
+				LbConfig: &envoy_config_cluster_v3.Cluster_MaglevLbConfig_{
+					MaglevLbConfig: &envoy_config_cluster_v3.Cluster_MaglevLbConfig{
+						TableSize: &wrapperspb.UInt64Value{Value: 65537},
+					},
+				},
+			},
+		},
+		{
+			name: "ring hash with a header hash key",
+			lb: &v1alpha3.LoadBalancerSettings{
+				LbPolicy: &v1alpha3.LoadBalancerSettings_ConsistentHash{ConsistentHash: &v1alpha3.LoadBalancerSettings_ConsistentHashLB{
+					HashKey: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_HttpHeaderName{HttpHeaderName: "x-user"},
+					HashAlgorithm: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_RingHash_{
+						RingHash: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_RingHash{MinimumRingSize: 1024},
+					},
+				}},
+			},
+			expected: &envoy_config_cluster_v3.Cluster{},
+		},
+		{
+			name: "maglev with the source ip as hash key",
+			lb: &v1alpha3.LoadBalancerSettings{
+				LbPolicy: &v1alpha3.LoadBalancerSettings_ConsistentHash{ConsistentHash: &v1alpha3.LoadBalancerSettings_ConsistentHashLB{
+					HashKey: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_UseSourceIp{UseSourceIp: true},
+					HashAlgorithm: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_Maglev{
+						Maglev: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_MagLev{TableSize: 65537},
+					},
+				}},
+			},
+			expected: &envoy_config_cluster_v3.Cluster{},
+		},
+		{
+			name: "passthrough",
+			lb: &v1alpha3.LoadBalancerSettings{
+				LbPolicy: &v1alpha3.LoadBalancerSettings_Simple{Simple: v1alpha3.LoadBalancerSettings_PASSTHROUGH},
+			},
+			expected: &envoy_config_cluster_v3.Cluster{},
+			err:      "PASSTHROUGH load balancing is not supported",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &envoy_config_cluster_v3.Cluster{}
+			err := applyLoadBalancer(tt.lb, out)
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.True(t, proto.Equal(tt.expected, out), "unexpected cluster %v", out)
+		})
+	}
+}
+
+func TestApplyTLS(t *testing.T) {
+	tests := []struct {
+		name       string
+		tls        *v1alpha3.ClientTLSSettings
+		socketName string
+	}{
+		{
+			name:       "disable",
+			tls:        &v1alpha3.ClientTLSSettings{Mode: v1alpha3.ClientTLSSettings_DISABLE},
+			socketName: wellknown.TransportSocketRawBuffer,
+		},
+		{
+			name:       "istio mutual",
+			tls:        &v1alpha3.ClientTLSSettings{Mode: v1alpha3.ClientTLSSettings_ISTIO_MUTUAL},
+			socketName: wellknown.TransportSocketTls,
+		},
+		{
+			name: "simple",
+			tls: &v1alpha3.ClientTLSSettings{
+				Mode:           v1alpha3.ClientTLSSettings_SIMPLE,
+				CaCertificates: "/etc/certs/ca.pem",
+				Sni:            "example.com",
+			},
+			socketName: wellknown.TransportSocketTls,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &envoy_config_cluster_v3.Cluster{
+				TransportSocketMatches: []*envoy_config_cluster_v3.Cluster_TransportSocketMatch{
+					{Name: "tlsMode-istio"},
+					{Name: "tlsMode-disabled"},
+				},
+			}
+			err := applyTLS(nil, nil, "default", tt.tls, ir.BackendObjectIR{}, out)
+			require.NoError(t, err)
+			assert.Equal(t, tt.socketName, out.GetTransportSocket().GetName())
+			assert.Empty(t, out.GetTransportSocketMatches(), "auto mTLS should be disabled")
+		})
+	}
+
+	t.Run("mutual without client certificate", func(t *testing.T) {
+		err := applyTLS(nil, nil, "default", &v1alpha3.ClientTLSSettings{Mode: v1alpha3.ClientTLSSettings_MUTUAL}, ir.BackendObjectIR{}, &envoy_config_cluster_v3.Cluster{})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,130 @@
+package httplistenerpolicy
+
+import (
+	"fmt"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	brotlicompressorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/brotli/compressor/v3"
+	brotlidecompressorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/brotli/decompressor/v3"
+	gzipcompressorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/gzip/compressor/v3"
+	gzipdecompressorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/gzip/decompressor/v3"
+	zstdcompressorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/zstd/compressor/v3"
+	zstddecompressorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/zstd/decompressor/v3"
+	compressorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
+	decompressorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/decompressor/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/plugins"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+)
+
+// The compressor and decompressor filters run first, so that the other filters see the
+// decompressed request body and the compressor sees the final response body.
+var compressionFilterStage = plugins.BeforeStage(plugins.FaultStage)
+
+// convertCompressionConfig returns a compressor filter and a decompressor filter per configured algorithm.
+// The filters are enabled by default and can be disabled for a route with a TrafficPolicy.
+func convertCompressionConfig(policy *v1alpha1.HTTPListenerPolicy) ([]plugins.StagedHttpFilter, error) {
+	config := policy.Spec.Compression
+	if config == nil {
+		return nil, nil
+	}
+
+	var filters []plugins.StagedHttpFilter
+	if rc := config.ResponseCompression; rc != nil {
+		for _, algorithm := range rc.Algorithms {
+			library, err := compressorLibrary(algorithm)
+			if err != nil {
+				return nil, err
+			}
+			compressor := &compressorv3.Compressor{
+				CompressorLibrary: library,
+				ResponseDirectionConfig: &compressorv3.Compressor_ResponseDirectionConfig{
+					CommonConfig: &compressorv3.Compressor_CommonDirectionConfig{
+						ContentType: rc.ContentTypes,
+					},
+				},
+			}
+			if rc.MinContentLength != nil {
+				compressor.GetResponseDirectionConfig().GetCommonConfig().MinContentLength = wrapperspb.UInt32(*rc.MinContentLength)
+			}
+			if rc.DisableOnETag != nil {
+				compressor.GetResponseDirectionConfig().DisableOnEtagHeader = *rc.DisableOnETag
+			}
+			filter, err := plugins.NewStagedFilter(wellknown.CompressionFilterName(wellknown.CompressorFilterNamePrefix, string(algorithm)), compressor, compressionFilterStage)
+			if err != nil {
+				return nil, err
+			}
+			filters = append(filters, filter)
+		}
+	}
+
+	if rd := config.RequestDecompression; rd != nil {
+		for _, algorithm := range rd.Algorithms {
+			library, err := decompressorLibrary(algorithm)
+			if err != nil {
+				return nil, err
+			}
+			filterName := wellknown.CompressionFilterName(wellknown.DecompressorFilterNamePrefix, string(algorithm))
+			decompressor := &decompressorv3.Decompressor{
+				DecompressorLibrary: library,
+				// only request bodies are decompressed
+				ResponseDirectionConfig: &decompressorv3.Decompressor_ResponseDirectionConfig{
+					CommonConfig: &decompressorv3.Decompressor_CommonDirectionConfig{
+						Enabled: &corev3.RuntimeFeatureFlag{
+							DefaultValue: wrapperspb.Bool(false),
+							RuntimeKey:   filterName + ".response_enabled",
+						},
+					},
+				},
+			}
+			filter, err := plugins.NewStagedFilter(filterName, decompressor, compressionFilterStage)
+			if err != nil {
+				return nil, err
+			}
+			filters = append(filters, filter)
+		}
+	}
+
+	return filters, nil
+}
+
+func compressorLibrary(algorithm v1alpha1.CompressionAlgorithm) (*corev3.TypedExtensionConfig, error) {
+	switch algorithm {
+	case v1alpha1.CompressionAlgorithmGzip:
+		return typedExtensionConfig("envoy.compression.gzip.compressor", &gzipcompressorv3.Gzip{})
+	case v1alpha1.CompressionAlgorithmBrotli:
+		return typedExtensionConfig("envoy.compression.brotli.compressor", &brotlicompressorv3.Brotli{})
+	case v1alpha1.CompressionAlgorithmZstd:
+		return typedExtensionConfig("envoy.compression.zstd.compressor", &zstdcompressorv3.Zstd{})
+	default:
+		return nil, fmt.Errorf("unsupported compression algorithm %q", algorithm)
+	}
+}
+
+func decompressorLibrary(algorithm v1alpha1.CompressionAlgorithm) (*corev3.TypedExtensionConfig, error) {
+	switch algorithm {
+	case v1alpha1.CompressionAlgorithmGzip:
+		return typedExtensionConfig("envoy.compression.gzip.decompressor", &gzipdecompressorv3.Gzip{})
+	case v1alpha1.CompressionAlgorithmBrotli:
+		return typedExtensionConfig("envoy.compression.brotli.decompressor", &brotlidecompressorv3.Brotli{})
+	case v1alpha1.CompressionAlgorithmZstd:
+		return typedExtensionConfig("envoy.compression.zstd.decompressor", &zstddecompressorv3.Zstd{})
+	default:
+		return nil, fmt.Errorf("unsupported compression algorithm %q", algorithm)
+	}
+}
+
+func typedExtensionConfig(name string, config proto.Message) (*corev3.TypedExtensionConfig, error) {
+	typedConfig, err := anypb.New(config)
+	if err != nil {
+		return nil, err
+	}
+	return &corev3.TypedExtensionConfig{
+		Name:        name,
+		TypedConfig: typedConfig,
+	}, nil
+}
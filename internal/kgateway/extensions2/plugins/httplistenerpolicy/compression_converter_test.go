@@ -0,0 +1,70 @@
+package httplistenerpolicy
+
+import (
+	"context"
+	"testing"
+
+	compressorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
+	decompressorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/decompressor/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+func TestCompressionConverter(t *testing.T) {
+	policy := &v1alpha1.HTTPListenerPolicy{
+		Spec: v1alpha1.HTTPListenerPolicySpec{
+			Compression: &v1alpha1.Compression{
+				ResponseCompression: &v1alpha1.ResponseCompression{
+					Algorithms:       []v1alpha1.CompressionAlgorithm{v1alpha1.CompressionAlgorithmGzip, v1alpha1.CompressionAlgorithmZstd},
+					MinContentLength: ptr.To(uint32(512)),
+					ContentTypes:     []string{"application/json"},
+					DisableOnETag:    ptr.To(true),
+				},
+				RequestDecompression: &v1alpha1.RequestDecompression{
+					Algorithms: []v1alpha1.CompressionAlgorithm{v1alpha1.CompressionAlgorithmBrotli},
+				},
+			},
+		},
+	}
+
+	filters, err := convertCompressionConfig(policy)
+	require.NoError(t, err)
+	require.Len(t, filters, 3)
+
+	assert.Equal(t, "envoy.filters.http.compressor/gzip", filters[0].Filter.GetName())
+	assert.Equal(t, "envoy.filters.http.compressor/zstd", filters[1].Filter.GetName())
+	assert.Equal(t, "envoy.filters.http.decompressor/brotli", filters[2].Filter.GetName())
+
+	compressor := &compressorv3.Compressor{}
+	require.NoError(t, filters[1].Filter.GetTypedConfig().UnmarshalTo(compressor))
+	require.NoError(t, compressor.Validate())
+	assert.Equal(t, "envoy.compression.zstd.compressor", compressor.GetCompressorLibrary().GetName())
+	assert.Equal(t, uint32(512), compressor.GetResponseDirectionConfig().GetCommonConfig().GetMinContentLength().GetValue())
+	assert.Equal(t, []string{"application/json"}, compressor.GetResponseDirectionConfig().GetCommonConfig().GetContentType())
+	assert.True(t, compressor.GetResponseDirectionConfig().GetDisableOnEtagHeader())
+
+	decompressor := &decompressorv3.Decompressor{}
+	require.NoError(t, filters[2].Filter.GetTypedConfig().UnmarshalTo(decompressor))
+	require.NoError(t, decompressor.Validate())
+	assert.Equal(t, "envoy.compression.brotli.decompressor", decompressor.GetDecompressorLibrary().GetName())
+	assert.False(t, decompressor.GetResponseDirectionConfig().GetCommonConfig().GetEnabled().GetDefaultValue().GetValue())
+
+	t.Run("listener policy replaces gateway policy", func(t *testing.T) {
+		ctx := context.Background()
+		pass := &httpListenerPolicyPluginGwPass{}
+		gatewayPolicy := &httpListenerPolicy{compression: filters}
+		listenerPolicy := &httpListenerPolicy{compression: filters[2:]}
+		for _, pol := range []*httpListenerPolicy{gatewayPolicy, listenerPolicy} {
+			require.NoError(t, pass.ApplyHCM(ctx, &ir.HcmContext{Policy: pol, FilterChainName: "fc"}, &envoy_hcm.HttpConnectionManager{}))
+		}
+		out, err := pass.HttpFilters(ctx, ir.FilterChainCommon{FilterChainName: "fc"})
+		require.NoError(t, err)
+		require.Len(t, out, 1)
+		assert.Equal(t, "envoy.filters.http.decompressor/brotli", out[0].Filter.GetName())
+	})
+}
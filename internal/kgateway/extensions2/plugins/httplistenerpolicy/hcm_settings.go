@@ -0,0 +1,332 @@
+package httplistenerpolicy
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"istio.io/istio/pkg/util/sets"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	translatorutils "github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/utils"
+	reports "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+)
+
+const (
+	// PolicyConditionConflicted is set on the ancestors of HTTPListenerPolicies. It is True when fields
+	// of the policy are overridden by another policy applied to the same listener.
+	PolicyConditionConflicted gwv1alpha2.PolicyConditionType   = "Conflicted"
+	PolicyReasonFieldConflict gwv1alpha2.PolicyConditionReason = "FieldConflict"
+	PolicyReasonNoConflicts   gwv1alpha2.PolicyConditionReason = "NoConflicts"
+)
+
+// hcmSetting is a field of the HttpConnectionManager set by a policy. When several policies apply
+// to the same filter chain, the last one applied wins: listener policies are applied after gateway
+// policies, and newer policies after older ones.
+type hcmSetting struct {
+	// field is the name of the field in the policy spec
+	field string
+	value proto.Message
+	apply func(out *envoy_hcm.HttpConnectionManager)
+}
+
+func (s hcmSetting) Equals(s2 hcmSetting) bool {
+	return s.field == s2.field && proto.Equal(s.value, s2.value)
+}
+
+func convertHcmSettings(
+	spec v1alpha1.HTTPListenerPolicySpec,
+	tracing *envoy_hcm.HttpConnectionManager_Tracing,
+) ([]hcmSetting, error) {
+	var settings []hcmSetting
+	if tracing != nil {
+		settings = append(settings, hcmSetting{"tracing", tracing, func(out *envoy_hcm.HttpConnectionManager) {
+			out.Tracing = tracing
+		}})
+	}
+	if spec.UseRemoteAddress != nil {
+		v := wrapperspb.Bool(*spec.UseRemoteAddress)
+		settings = append(settings, hcmSetting{"useRemoteAddress", v, func(out *envoy_hcm.HttpConnectionManager) {
+			out.UseRemoteAddress = v
+		}})
+	}
+	if spec.XffNumTrustedHops != nil {
+		v := wrapperspb.UInt32(*spec.XffNumTrustedHops)
+		settings = append(settings, hcmSetting{"xffNumTrustedHops", v, func(out *envoy_hcm.HttpConnectionManager) {
+			out.XffNumTrustedHops = v.GetValue()
+		}})
+	}
+	if t := convertServerHeaderTransformation(spec.ServerHeaderTransformation); t != nil {
+		v := wrapperspb.Int32(int32(*t))
+		settings = append(settings, hcmSetting{"serverHeaderTransformation", v, func(out *envoy_hcm.HttpConnectionManager) {
+			out.ServerHeaderTransformation = *t
+		}})
+	}
+	if spec.StreamIdleTimeout != nil {
+		v := durationpb.New(spec.StreamIdleTimeout.Duration)
+		settings = append(settings, hcmSetting{"streamIdleTimeout", v, func(out *envoy_hcm.HttpConnectionManager) {
+			out.StreamIdleTimeout = v
+		}})
+	}
+	if spec.IdleTimeout != nil {
+		v := durationpb.New(spec.IdleTimeout.Duration)
+		settings = append(settings, hcmSetting{"idleTimeout", v, func(out *envoy_hcm.HttpConnectionManager) {
+			commonHttpProtocolOptions(out).IdleTimeout = v
+		}})
+	}
+	if spec.MaxConnectionDuration != nil {
+		v := durationpb.New(spec.MaxConnectionDuration.Duration)
+		settings = append(settings, hcmSetting{"maxConnectionDuration", v, func(out *envoy_hcm.HttpConnectionManager) {
+			commonHttpProtocolOptions(out).MaxConnectionDuration = v
+		}})
+	}
+	if spec.MaxHeadersCount != nil {
+		v := wrapperspb.UInt32(*spec.MaxHeadersCount)
+		settings = append(settings, hcmSetting{"maxHeadersCount", v, func(out *envoy_hcm.HttpConnectionManager) {
+			commonHttpProtocolOptions(out).MaxHeadersCount = v
+		}})
+	}
+	if spec.RequestTimeout != nil {
+		v := durationpb.New(spec.RequestTimeout.Duration)
+		settings = append(settings, hcmSetting{"requestTimeout", v, func(out *envoy_hcm.HttpConnectionManager) {
+			out.RequestTimeout = v
+		}})
+	}
+	if spec.RequestHeadersTimeout != nil {
+		v := durationpb.New(spec.RequestHeadersTimeout.Duration)
+		settings = append(settings, hcmSetting{"requestHeadersTimeout", v, func(out *envoy_hcm.HttpConnectionManager) {
+			out.RequestHeadersTimeout = v
+		}})
+	}
+	if spec.MaxRequestHeadersKb != nil {
+		v := wrapperspb.UInt32(*spec.MaxRequestHeadersKb)
+		settings = append(settings, hcmSetting{"maxRequestHeadersKb", v, func(out *envoy_hcm.HttpConnectionManager) {
+			out.MaxRequestHeadersKb = v
+		}})
+	}
+	if spec.PreserveExternalRequestId != nil {
+		v := wrapperspb.Bool(*spec.PreserveExternalRequestId)
+		settings = append(settings, hcmSetting{"preserveExternalRequestId", v, func(out *envoy_hcm.HttpConnectionManager) {
+			out.PreserveExternalRequestId = v.GetValue()
+		}})
+	}
+	if spec.GenerateRequestId != nil {
+		v := wrapperspb.Bool(*spec.GenerateRequestId)
+		settings = append(settings, hcmSetting{"generateRequestId", v, func(out *envoy_hcm.HttpConnectionManager) {
+			out.GenerateRequestId = v
+		}})
+	}
+	if spec.NormalizePath != nil {
+		v := wrapperspb.Bool(*spec.NormalizePath)
+		settings = append(settings, hcmSetting{"normalizePath", v, func(out *envoy_hcm.HttpConnectionManager) {
+			out.NormalizePath = v
+		}})
+	}
+	if spec.MergeSlashes != nil {
+		v := wrapperspb.Bool(*spec.MergeSlashes)
+		settings = append(settings, hcmSetting{"mergeSlashes", v, func(out *envoy_hcm.HttpConnectionManager) {
+			out.MergeSlashes = v.GetValue()
+		}})
+	}
+	if a := convertPathWithEscapedSlashesAction(spec.PathWithEscapedSlashesAction); a != nil {
+		v := wrapperspb.Int32(int32(*a))
+		settings = append(settings, hcmSetting{"pathWithEscapedSlashesAction", v, func(out *envoy_hcm.HttpConnectionManager) {
+			out.PathWithEscapedSlashesAction = *a
+		}})
+	}
+	if d := convertForwardClientCertDetails(spec.ForwardClientCertDetails); d != nil {
+		v := wrapperspb.Int32(int32(*d))
+		settings = append(settings, hcmSetting{"forwardClientCertDetails", v, func(out *envoy_hcm.HttpConnectionManager) {
+			out.ForwardClientCertDetails = *d
+		}})
+	}
+	if d := spec.SetCurrentClientCertDetails; d != nil {
+		v := &envoy_hcm.HttpConnectionManager_SetCurrentClientCertDetails{
+			Subject: wrapperspb.Bool(ptr.Deref(d.Subject, false)),
+			Cert:    ptr.Deref(d.Cert, false),
+			Chain:   ptr.Deref(d.Chain, false),
+			Dns:     ptr.Deref(d.DNS, false),
+			Uri:     ptr.Deref(d.URI, false),
+		}
+		settings = append(settings, hcmSetting{"setCurrentClientCertDetails", v, func(out *envoy_hcm.HttpConnectionManager) {
+			out.SetCurrentClientCertDetails = v
+		}})
+	}
+	if spec.Http1ProtocolOptions != nil {
+		v, err := translatorutils.ToEnvoyHttp1ProtocolOptions(spec.Http1ProtocolOptions)
+		if err != nil {
+			return nil, err
+		}
+		settings = append(settings, hcmSetting{"http1ProtocolOptions", v, func(out *envoy_hcm.HttpConnectionManager) {
+			out.HttpProtocolOptions = v
+		}})
+	}
+	if spec.Http2ProtocolOptions != nil {
+		v := translatorutils.ToEnvoyHttp2ProtocolOptions(spec.Http2ProtocolOptions)
+		settings = append(settings, hcmSetting{"http2ProtocolOptions", v, func(out *envoy_hcm.HttpConnectionManager) {
+			out.Http2ProtocolOptions = v
+		}})
+	}
+	return settings, nil
+}
+
+func commonHttpProtocolOptions(out *envoy_hcm.HttpConnectionManager) *corev3.HttpProtocolOptions {
+	if out.GetCommonHttpProtocolOptions() == nil {
+		out.CommonHttpProtocolOptions = &corev3.HttpProtocolOptions{}
+	}
+	return out.GetCommonHttpProtocolOptions()
+}
+
+func convertPathWithEscapedSlashesAction(action *v1alpha1.PathWithEscapedSlashesAction) *envoy_hcm.HttpConnectionManager_PathWithEscapedSlashesAction {
+	if action == nil {
+		return nil
+	}
+
+	switch *action {
+	case v1alpha1.KeepUnchangedPathWithEscapedSlashesAction:
+		val := envoy_hcm.HttpConnectionManager_KEEP_UNCHANGED
+		return &val
+	case v1alpha1.RejectRequestPathWithEscapedSlashesAction:
+		val := envoy_hcm.HttpConnectionManager_REJECT_REQUEST
+		return &val
+	case v1alpha1.UnescapeAndRedirectPathWithEscapedSlashesAction:
+		val := envoy_hcm.HttpConnectionManager_UNESCAPE_AND_REDIRECT
+		return &val
+	case v1alpha1.UnescapeAndForwardPathWithEscapedSlashesAction:
+		val := envoy_hcm.HttpConnectionManager_UNESCAPE_AND_FORWARD
+		return &val
+	default:
+		return nil
+	}
+}
+
+func convertForwardClientCertDetails(details *v1alpha1.ForwardClientCertDetails) *envoy_hcm.HttpConnectionManager_ForwardClientCertDetails {
+	if details == nil {
+		return nil
+	}
+
+	switch *details {
+	case v1alpha1.SanitizeForwardClientCertDetails:
+		val := envoy_hcm.HttpConnectionManager_SANITIZE
+		return &val
+	case v1alpha1.ForwardOnlyForwardClientCertDetails:
+		val := envoy_hcm.HttpConnectionManager_FORWARD_ONLY
+		return &val
+	case v1alpha1.AppendForwardForwardClientCertDetails:
+		val := envoy_hcm.HttpConnectionManager_APPEND_FORWARD
+		return &val
+	case v1alpha1.SanitizeSetForwardClientCertDetails:
+		val := envoy_hcm.HttpConnectionManager_SANITIZE_SET
+		return &val
+	case v1alpha1.AlwaysForwardOnlyForwardClientCertDetails:
+		val := envoy_hcm.HttpConnectionManager_ALWAYS_FORWARD_ONLY
+		return &val
+	default:
+		return nil
+	}
+}
+
+// hcmFieldOwner is the policy that set a field of the HttpConnectionManager of a filter chain.
+type hcmFieldOwner struct {
+	ref        ir.AttachedPolicyRef
+	generation int64
+	value      proto.Message
+}
+
+// policyAncestor identifies the status of a policy for one of its ancestors.
+type policyAncestor struct {
+	ref      ir.AttachedPolicyRef
+	ancestor string
+}
+
+// applyHcmSettings applies the settings of the policy, and reports on the status of the policies
+// the fields that are overridden.
+func (p *httpListenerPolicyPluginGwPass) applyHcmSettings(
+	pCtx *ir.HcmContext,
+	settings []hcmSetting,
+	out *envoy_hcm.HttpConnectionManager,
+) {
+	for _, setting := range settings {
+		setting.apply(out)
+	}
+	if pCtx.PolicyRef == nil || p.reporter == nil {
+		return
+	}
+
+	if p.hcmFieldOwners == nil {
+		p.hcmFieldOwners = map[string]map[string]hcmFieldOwner{}
+		p.conflicts = map[policyAncestor]map[string]sets.Set[string]{}
+	}
+	owners := p.hcmFieldOwners[pCtx.FilterChainName]
+	if owners == nil {
+		owners = map[string]hcmFieldOwner{}
+		p.hcmFieldOwners[pCtx.FilterChainName] = owners
+	}
+	ancestor := pCtx.PolicyAncestorRef
+	ancestorKey := fmt.Sprintf("%v/%v/%v/%s", ancestor.Group, ancestor.Kind, ancestor.Namespace, ancestor.Name)
+	current := policyAncestor{ref: *pCtx.PolicyRef, ancestor: ancestorKey}
+	for _, setting := range settings {
+		if prev, ok := owners[setting.field]; ok && prev.ref != *pCtx.PolicyRef && !proto.Equal(prev.value, setting.value) {
+			overridden := policyAncestor{ref: prev.ref, ancestor: ancestorKey}
+			if p.conflicts[overridden] == nil {
+				p.conflicts[overridden] = map[string]sets.Set[string]{}
+			}
+			if p.conflicts[overridden][setting.field] == nil {
+				p.conflicts[overridden][setting.field] = sets.New[string]()
+			}
+			p.conflicts[overridden][setting.field].Insert(pCtx.PolicyRef.Namespace + "/" + pCtx.PolicyRef.Name)
+			p.reportConflicts(prev.ref, prev.generation, ancestor, p.conflicts[overridden])
+		}
+		owners[setting.field] = hcmFieldOwner{ref: *pCtx.PolicyRef, generation: pCtx.Generation, value: setting.value}
+	}
+	p.reportConflicts(*pCtx.PolicyRef, pCtx.Generation, ancestor, p.conflicts[current])
+}
+
+func (p *httpListenerPolicyPluginGwPass) reportConflicts(
+	ref ir.AttachedPolicyRef,
+	generation int64,
+	ancestor gwv1.ParentReference,
+	conflicts map[string]sets.Set[string],
+) {
+	key := reports.PolicyKey{
+		Group:     ref.Group,
+		Kind:      ref.Kind,
+		Namespace: ref.Namespace,
+		Name:      ref.Name,
+	}
+	r := p.reporter.Policy(key, generation).AncestorRef(ancestor)
+	if len(conflicts) == 0 {
+		r.SetCondition(reports.PolicyCondition{
+			Type:   PolicyConditionConflicted,
+			Status: metav1.ConditionFalse,
+			Reason: PolicyReasonNoConflicts,
+		})
+		return
+	}
+
+	fields := make([]string, 0, len(conflicts))
+	for field := range conflicts {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+	overrides := make([]string, 0, len(fields))
+	for _, field := range fields {
+		overrides = append(overrides, fmt.Sprintf("%s is overridden by %s", field, strings.Join(sets.SortedList(conflicts[field]), ", ")))
+	}
+	r.SetCondition(reports.PolicyCondition{
+		Type:    PolicyConditionConflicted,
+		Status:  metav1.ConditionTrue,
+		Reason:  PolicyReasonFieldConflict,
+		Message: strings.Join(overrides, "; "),
+	})
+}
@@ -9,11 +9,10 @@ import (
 	envoyaccesslog "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	"google.golang.org/protobuf/proto"
-	"google.golang.org/protobuf/types/known/durationpb"
-	"google.golang.org/protobuf/types/known/wrapperspb"
 	skubeclient "istio.io/istio/pkg/config/schema/kubeclient"
 	"istio.io/istio/pkg/kube/kclient"
 	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/util/sets"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -23,6 +22,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/common"
 	extensionsplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/plugins"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/pkg/client/clientset/versioned"
 	"github.com/kgateway-dev/kgateway/v2/pkg/logging"
@@ -33,14 +33,11 @@ import (
 var logger = logging.New("plugin/httplistenerpolicy")
 
 type httpListenerPolicy struct {
-	ct                         time.Time
-	accessLog                  []*envoyaccesslog.AccessLog
-	tracing                    *envoy_hcm.HttpConnectionManager_Tracing
-	upgradeConfigs             []*envoy_hcm.HttpConnectionManager_UpgradeConfig
-	useRemoteAddress           *bool
-	xffNumTrustedHops          *uint32
-	serverHeaderTransformation *envoy_hcm.HttpConnectionManager_ServerHeaderTransformation
-	streamIdleTimeout          *time.Duration
+	ct             time.Time
+	accessLog      []*envoyaccesslog.AccessLog
+	upgradeConfigs []*envoy_hcm.HttpConnectionManager_UpgradeConfig
+	hcmSettings    []hcmSetting
+	compression    []plugins.StagedHttpFilter
 }
 
 func (d *httpListenerPolicy) CreationTime() time.Time {
@@ -60,11 +57,6 @@ func (d *httpListenerPolicy) Equals(in any) bool {
 		return false
 	}
 
-	// Check tracing
-	if !proto.Equal(d.tracing, d2.tracing) {
-		return false
-	}
-
 	// Check upgrade configs
 	if !slices.EqualFunc(d.upgradeConfigs, d2.upgradeConfigs, func(cfg, cfg2 *envoy_hcm.HttpConnectionManager_UpgradeConfig) bool {
 		return proto.Equal(cfg, cfg2)
@@ -72,41 +64,15 @@ func (d *httpListenerPolicy) Equals(in any) bool {
 		return false
 	}
 
-	// Check useRemoteAddress
-	if d.useRemoteAddress == nil && d2.useRemoteAddress != nil {
-		return false
-	}
-	if d.useRemoteAddress != nil && d2.useRemoteAddress == nil {
-		return false
-	}
-	if d.useRemoteAddress != nil && d2.useRemoteAddress != nil && *d.useRemoteAddress != *d2.useRemoteAddress {
-		return false
-	}
-
-	// Check xffNumTrustedHops
-	if d.xffNumTrustedHops == nil && d2.xffNumTrustedHops != nil {
-		return false
-	}
-	if d.xffNumTrustedHops != nil && d2.xffNumTrustedHops == nil {
-		return false
-	}
-	if d.xffNumTrustedHops != nil && d2.xffNumTrustedHops != nil && *d.xffNumTrustedHops != *d2.xffNumTrustedHops {
-		return false
-	}
-
-	// Check serverHeaderTransformation
-	if d.serverHeaderTransformation != d2.serverHeaderTransformation {
+	// Check the HCM settings, including tracing
+	if !slices.EqualFunc(d.hcmSettings, d2.hcmSettings, hcmSetting.Equals) {
 		return false
 	}
 
-	// Check streamIdleTimeout
-	if d.streamIdleTimeout == nil && d2.streamIdleTimeout != nil {
-		return false
-	}
-	if d.streamIdleTimeout != nil && d2.streamIdleTimeout == nil {
-		return false
-	}
-	if d.streamIdleTimeout != nil && d2.streamIdleTimeout != nil && *d.streamIdleTimeout != *d2.streamIdleTimeout {
+	// Check compression
+	if !slices.EqualFunc(d.compression, d2.compression, func(f, f2 plugins.StagedHttpFilter) bool {
+		return f.Stage == f2.Stage && proto.Equal(f.Filter, f2.Filter)
+	}) {
 		return false
 	}
 
@@ -116,6 +82,13 @@ func (d *httpListenerPolicy) Equals(in any) bool {
 type httpListenerPolicyPluginGwPass struct {
 	ir.UnimplementedProxyTranslationPass
 	reporter reports.Reporter
+
+	compressionInChain map[string][]plugins.StagedHttpFilter
+
+	// hcmFieldOwners records, for each filter chain, the policy that last set each HCM field
+	hcmFieldOwners map[string]map[string]hcmFieldOwner
+	// conflicts records, for each policy and ancestor, the policies overriding each of its fields
+	conflicts map[policyAncestor]map[string]sets.Set[string]
 }
 
 var _ ir.ProxyTranslationPass = &httpListenerPolicyPluginGwPass{}
@@ -161,28 +134,29 @@ func NewPlugin(ctx context.Context, commoncol *common.CommonCollections) extensi
 			errs = append(errs, err)
 		}
 
+		compression, err := convertCompressionConfig(i)
+		if err != nil {
+			logger.Error("error translating compression", "error", err)
+			errs = append(errs, err)
+		}
+
 		upgradeConfigs := convertUpgradeConfig(i)
-		serverHeaderTransformation := convertServerHeaderTransformation(i.Spec.ServerHeaderTransformation)
 
-		// Convert streamIdleTimeout from metav1.Duration to time.Duration
-		var streamIdleTimeout *time.Duration
-		if i.Spec.StreamIdleTimeout != nil {
-			duration := i.Spec.StreamIdleTimeout.Duration
-			streamIdleTimeout = &duration
+		hcmSettings, err := convertHcmSettings(i.Spec, tracing)
+		if err != nil {
+			logger.Error("error translating http connection manager settings", "error", err)
+			errs = append(errs, err)
 		}
 
 		pol := &ir.PolicyWrapper{
 			ObjectSource: objSrc,
 			Policy:       i,
 			PolicyIR: &httpListenerPolicy{
-				ct:                         i.CreationTimestamp.Time,
-				accessLog:                  accessLog,
-				tracing:                    tracing,
-				upgradeConfigs:             upgradeConfigs,
-				useRemoteAddress:           i.Spec.UseRemoteAddress,
-				xffNumTrustedHops:          i.Spec.XffNumTrustedHops,
-				serverHeaderTransformation: serverHeaderTransformation,
-				streamIdleTimeout:          streamIdleTimeout,
+				ct:             i.CreationTimestamp.Time,
+				accessLog:      accessLog,
+				upgradeConfigs: upgradeConfigs,
+				hcmSettings:    hcmSettings,
+				compression:    compression,
 			},
 			TargetRefs: pluginsdkutils.TargetRefsToPolicyRefs(i.Spec.TargetRefs, i.Spec.TargetSelectors),
 			Errors:     errs,
@@ -227,37 +201,30 @@ func (p *httpListenerPolicyPluginGwPass) ApplyHCM(
 	// translate access logging configuration
 	out.AccessLog = append(out.GetAccessLog(), policy.accessLog...)
 
-	// translate tracing configuration
-	out.Tracing = policy.tracing
-
 	// translate upgrade configuration
 	if policy.upgradeConfigs != nil {
 		out.UpgradeConfigs = append(out.GetUpgradeConfigs(), policy.upgradeConfigs...)
 	}
 
-	// translate useRemoteAddress
-	if policy.useRemoteAddress != nil {
-		out.UseRemoteAddress = wrapperspb.Bool(*policy.useRemoteAddress)
-	}
+	// translate the other settings, such as tracing, timeouts and protocol options
+	p.applyHcmSettings(pCtx, policy.hcmSettings, out)
 
-	// translate xffNumTrustedHops
-	if policy.xffNumTrustedHops != nil {
-		out.XffNumTrustedHops = *policy.xffNumTrustedHops
-	}
-
-	// translate serverHeaderTransformation
-	if policy.serverHeaderTransformation != nil {
-		out.ServerHeaderTransformation = *policy.serverHeaderTransformation
-	}
-
-	// translate streamIdleTimeout
-	if policy.streamIdleTimeout != nil {
-		out.StreamIdleTimeout = durationpb.New(*policy.streamIdleTimeout)
+	// the compression filters are added to the filter chain in HttpFilters. Policies attached to
+	// the listener are applied after the ones attached to the Gateway and replace them.
+	if policy.compression != nil {
+		if p.compressionInChain == nil {
+			p.compressionInChain = make(map[string][]plugins.StagedHttpFilter)
+		}
+		p.compressionInChain[pCtx.FilterChainName] = policy.compression
 	}
 
 	return nil
 }
 
+func (p *httpListenerPolicyPluginGwPass) HttpFilters(ctx context.Context, fcc ir.FilterChainCommon) ([]plugins.StagedHttpFilter, error) {
+	return p.compressionInChain[fcc.FilterChainName], nil
+}
+
 func convertUpgradeConfig(policy *v1alpha1.HTTPListenerPolicy) []*envoy_hcm.HttpConnectionManager_UpgradeConfig {
 	if policy.Spec.UpgradeConfig == nil {
 		return nil
@@ -3,6 +3,8 @@ package httplistenerpolicy
 import (
 	"context"
 	"fmt"
+	"math"
+	"strconv"
 
 	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	tracev3 "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
@@ -14,6 +16,7 @@ import (
 	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"istio.io/istio/pkg/kube/krt"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/common"
@@ -33,11 +36,13 @@ func convertTracingConfig(
 		return nil, nil
 	}
 
-	if config.Provider.OpenTelemetry.GrpcService.BackendRef == nil {
-		return nil, fmt.Errorf("Tracing.OpenTelemetryConfig.GrpcService.BackendRef must be specified")
+	backendRef, err := tracingBackendRef(config.Provider)
+	if err != nil {
+		return nil, err
 	}
 
-	backend, err := commoncol.BackendIndex.GetBackendFromRef(krtctx, parentSrc, config.Provider.OpenTelemetry.GrpcService.BackendRef.BackendObjectReference)
+	// the collector is sent to as a cluster, so it must resolve to a backend
+	backend, err := commoncol.BackendIndex.GetBackendFromRef(krtctx, parentSrc, backendRef.BackendObjectReference)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrUnresolvedBackendRef, err)
 	}
@@ -45,6 +50,23 @@ func convertTracingConfig(
 	return translateTracing(config, backend)
 }
 
+// tracingBackendRef returns the reference to the collector of the provider.
+func tracingBackendRef(provider v1alpha1.TracingProvider) (*gwv1.BackendRef, error) {
+	switch {
+	case provider.OpenTelemetry != nil:
+		if provider.OpenTelemetry.GrpcService.BackendRef == nil {
+			return nil, fmt.Errorf("Tracing.OpenTelemetryConfig.GrpcService.BackendRef must be specified")
+		}
+		return provider.OpenTelemetry.GrpcService.BackendRef, nil
+	case provider.Zipkin != nil:
+		return &provider.Zipkin.BackendRef, nil
+	case provider.Datadog != nil:
+		return &provider.Datadog.BackendRef, nil
+	default:
+		return nil, fmt.Errorf("Tracing.Provider must specify one of OpenTelemetry, Zipkin or Datadog")
+	}
+}
+
 func translateTracing(
 	config *v1alpha1.Tracing,
 	backend *ir.BackendObjectIR,
@@ -53,11 +75,21 @@ func translateTracing(
 		return nil, nil
 	}
 
-	if config.Provider.OpenTelemetry == nil || config.Provider.OpenTelemetry.GrpcService.BackendRef == nil {
-		return nil, fmt.Errorf("Tracing.OpenTelemetryConfig.GrpcService.BackendRef must be specified")
+	var provider *tracev3.Tracing_Http
+	var err error
+	switch {
+	case config.Provider.OpenTelemetry != nil:
+		if config.Provider.OpenTelemetry.GrpcService.BackendRef == nil {
+			return nil, fmt.Errorf("Tracing.OpenTelemetryConfig.GrpcService.BackendRef must be specified")
+		}
+		provider, err = convertOTelTracingConfig(config.Provider.OpenTelemetry, backend)
+	case config.Provider.Zipkin != nil:
+		provider, err = convertZipkinTracingConfig(config.Provider.Zipkin, backend)
+	case config.Provider.Datadog != nil:
+		provider, err = convertDatadogTracingConfig(config.Provider.Datadog, backend)
+	default:
+		return nil, fmt.Errorf("Tracing.Provider must specify one of OpenTelemetry, Zipkin or Datadog")
 	}
-
-	provider, err := convertOTelTracingConfig(config.Provider.OpenTelemetry, backend)
 	if err != nil {
 		return nil, err
 	}
@@ -239,13 +271,11 @@ func convertOTelTracingConfig(
 	}
 
 	if config.Sampler != nil {
-		if config.Sampler.AlwaysOn != nil {
-			alwaysOnSampler, _ := utils.MessageToAny(&samplersv3.AlwaysOnSamplerConfig{})
-			tracingCfg.Sampler = &corev3.TypedExtensionConfig{
-				Name:        "envoy.tracers.opentelemetry.samplers.always_on",
-				TypedConfig: alwaysOnSampler,
-			}
+		sampler, err := convertOTelSampler(config.Sampler)
+		if err != nil {
+			return nil, err
 		}
+		tracingCfg.Sampler = sampler
 	}
 
 	otelCfg, err := utils.MessageToAny(tracingCfg)
@@ -260,3 +290,122 @@ func convertOTelTracingConfig(
 		},
 	}, nil
 }
+
+// convertOTelSampler translates the sampler of the OpenTelemetry tracer to its Envoy extension.
+func convertOTelSampler(sampler *v1alpha1.Sampler) (*corev3.TypedExtensionConfig, error) {
+	switch {
+	case sampler.AlwaysOn != nil:
+		return alwaysOnSampler(), nil
+	case sampler.TraceIdRatio != nil:
+		return traceIdRatioSampler(sampler.TraceIdRatio)
+	case sampler.ParentBased != nil:
+		root := alwaysOnSampler()
+		if sampler.ParentBased.Root != nil && sampler.ParentBased.Root.TraceIdRatio != nil {
+			var err error
+			root, err = traceIdRatioSampler(sampler.ParentBased.Root.TraceIdRatio)
+			if err != nil {
+				return nil, err
+			}
+		}
+		parentBasedSampler, _ := utils.MessageToAny(&samplersv3.ParentBasedSamplerConfig{
+			WrappedSampler: root,
+		})
+		return &corev3.TypedExtensionConfig{
+			Name:        "envoy.tracers.opentelemetry.samplers.parent_based",
+			TypedConfig: parentBasedSampler,
+		}, nil
+	}
+	return nil, nil
+}
+
+func alwaysOnSampler() *corev3.TypedExtensionConfig {
+	alwaysOnSampler, _ := utils.MessageToAny(&samplersv3.AlwaysOnSamplerConfig{})
+	return &corev3.TypedExtensionConfig{
+		Name:        "envoy.tracers.opentelemetry.samplers.always_on",
+		TypedConfig: alwaysOnSampler,
+	}
+}
+
+// traceIdRatioSampler samples the ratio of the traces as a fraction of a million, which is exact for
+// the 6 decimals allowed by the API.
+func traceIdRatioSampler(config *v1alpha1.TraceIdRatioSamplerConfig) (*corev3.TypedExtensionConfig, error) {
+	ratio, err := strconv.ParseFloat(config.Ratio, 64)
+	if err != nil || ratio < 0 || ratio > 1 {
+		return nil, fmt.Errorf("invalid TraceIdRatio sampler ratio %q: must be between 0 and 1", config.Ratio)
+	}
+	traceIdRatioSampler, _ := utils.MessageToAny(&samplersv3.TraceIdRatioBasedSamplerConfig{
+		SamplingPercentage: &typev3.FractionalPercent{
+			Numerator:   uint32(math.Round(ratio * 1_000_000)),
+			Denominator: typev3.FractionalPercent_MILLION,
+		},
+	})
+	return &corev3.TypedExtensionConfig{
+		Name:        "envoy.tracers.opentelemetry.samplers.trace_id_ratio_based",
+		TypedConfig: traceIdRatioSampler,
+	}, nil
+}
+
+func convertZipkinTracingConfig(
+	config *v1alpha1.ZipkinTracingConfig,
+	backend *ir.BackendObjectIR,
+) (*tracev3.Tracing_Http, error) {
+	tracingCfg := &tracev3.ZipkinConfig{
+		CollectorCluster:         backend.ClusterName(),
+		CollectorEndpoint:        "/api/v2/spans",
+		CollectorEndpointVersion: tracev3.ZipkinConfig_HTTP_JSON,
+	}
+	if config.CollectorEndpoint != nil {
+		tracingCfg.CollectorEndpoint = *config.CollectorEndpoint
+	}
+	if config.CollectorEndpointVersion != nil && *config.CollectorEndpointVersion == v1alpha1.ZipkinCollectorEndpointVersionHTTPProto {
+		tracingCfg.CollectorEndpointVersion = tracev3.ZipkinConfig_HTTP_PROTO
+	}
+	if config.CollectorHostname != nil {
+		tracingCfg.CollectorHostname = *config.CollectorHostname
+	}
+	if config.TraceId128Bit != nil {
+		tracingCfg.TraceId_128Bit = *config.TraceId128Bit
+	}
+	if config.SharedSpanContext != nil {
+		tracingCfg.SharedSpanContext = &wrapperspb.BoolValue{
+			Value: *config.SharedSpanContext,
+		}
+	}
+
+	zipkinCfg, err := utils.MessageToAny(tracingCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tracev3.Tracing_Http{
+		Name: "envoy.tracers.zipkin",
+		ConfigType: &tracev3.Tracing_Http_TypedConfig{
+			TypedConfig: zipkinCfg,
+		},
+	}, nil
+}
+
+func convertDatadogTracingConfig(
+	config *v1alpha1.DatadogTracingConfig,
+	backend *ir.BackendObjectIR,
+) (*tracev3.Tracing_Http, error) {
+	tracingCfg := &tracev3.DatadogConfig{
+		CollectorCluster: backend.ClusterName(),
+		ServiceName:      config.ServiceName,
+	}
+	if config.CollectorHostname != nil {
+		tracingCfg.CollectorHostname = *config.CollectorHostname
+	}
+
+	datadogCfg, err := utils.MessageToAny(tracingCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tracev3.Tracing_Http{
+		Name: "envoy.tracers.datadog",
+		ConfigType: &tracev3.Tracing_Http_TypedConfig{
+			TypedConfig: datadogCfg,
+		},
+	}, nil
+}
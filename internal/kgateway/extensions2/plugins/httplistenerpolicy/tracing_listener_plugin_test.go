@@ -17,6 +17,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"k8s.io/utils/pointer"
+	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
@@ -383,6 +384,162 @@ func TestTracingConverter(t *testing.T) {
 					SpawnUpstreamSpan: &wrapperspb.BoolValue{Value: true},
 				},
 			},
+			{
+				name: "Zipkin Tracing",
+				config: &v1alpha1.Tracing{
+					Provider: v1alpha1.TracingProvider{
+						Zipkin: &v1alpha1.ZipkinTracingConfig{
+							BackendRef: gwv1.BackendRef{
+								BackendObjectReference: gwv1.BackendObjectReference{
+									Name: "test-service",
+								},
+							},
+							CollectorEndpointVersion: ptr.To(v1alpha1.ZipkinCollectorEndpointVersionHTTPProto),
+							TraceId128Bit:            ptr.To(true),
+							SharedSpanContext:        ptr.To(false),
+						},
+					},
+					RandomSampling: ptr.To(uint32(5)),
+				},
+				expected: &envoy_hcm.HttpConnectionManager_Tracing{
+					Provider: &tracev3.Tracing_Http{
+						Name: "envoy.tracers.zipkin",
+						ConfigType: &tracev3.Tracing_Http_TypedConfig{
+							TypedConfig: mustMessageToAny(t, &tracev3.ZipkinConfig{
+								CollectorCluster:         "backend_default_test-service_0",
+								CollectorEndpoint:        "/api/v2/spans",
+								CollectorEndpointVersion: tracev3.ZipkinConfig_HTTP_PROTO,
+								TraceId_128Bit:           true,
+								SharedSpanContext:        &wrapperspb.BoolValue{Value: false},
+							}),
+						},
+					},
+					RandomSampling: &typev3.Percent{Value: 5},
+				},
+			},
+			{
+				name: "Datadog Tracing",
+				config: &v1alpha1.Tracing{
+					Provider: v1alpha1.TracingProvider{
+						Datadog: &v1alpha1.DatadogTracingConfig{
+							BackendRef: gwv1.BackendRef{
+								BackendObjectReference: gwv1.BackendObjectReference{
+									Name: "test-service",
+								},
+							},
+							ServiceName:       "gateway",
+							CollectorHostname: ptr.To("datadog-agent"),
+						},
+					},
+				},
+				expected: &envoy_hcm.HttpConnectionManager_Tracing{
+					Provider: &tracev3.Tracing_Http{
+						Name: "envoy.tracers.datadog",
+						ConfigType: &tracev3.Tracing_Http_TypedConfig{
+							TypedConfig: mustMessageToAny(t, &tracev3.DatadogConfig{
+								CollectorCluster:  "backend_default_test-service_0",
+								ServiceName:       "gateway",
+								CollectorHostname: "datadog-agent",
+							}),
+						},
+					},
+				},
+			},
+			{
+				name: "OTel Tracing with sampling percentages",
+				config: &v1alpha1.Tracing{
+					Provider: v1alpha1.TracingProvider{
+						OpenTelemetry: &v1alpha1.OpenTelemetryTracingConfig{
+							GrpcService: v1alpha1.CommonGrpcService{
+								BackendRef: &gwv1.BackendRef{
+									BackendObjectReference: gwv1.BackendObjectReference{
+										Name: "test-service",
+									},
+								},
+							},
+							ServiceName: "my:service",
+						},
+					},
+					RandomSampling:  ptr.To(uint32(1)),
+					OverallSampling: ptr.To(uint32(50)),
+				},
+				expected: &envoy_hcm.HttpConnectionManager_Tracing{
+					Provider: &tracev3.Tracing_Http{
+						Name: "envoy.tracers.opentelemetry",
+						ConfigType: &tracev3.Tracing_Http_TypedConfig{
+							TypedConfig: mustMessageToAny(t, &tracev3.OpenTelemetryConfig{
+								GrpcService: &corev3.GrpcService{
+									TargetSpecifier: &corev3.GrpcService_EnvoyGrpc_{
+										EnvoyGrpc: &corev3.GrpcService_EnvoyGrpc{
+											ClusterName: "backend_default_test-service_0",
+										},
+									},
+								},
+								ServiceName: "my:service",
+							}),
+						},
+					},
+					RandomSampling:  &typev3.Percent{Value: 1},
+					OverallSampling: &typev3.Percent{Value: 50},
+				},
+			},
+			{
+				name: "OTel Tracing with parent based ratio sampler",
+				config: &v1alpha1.Tracing{
+					Provider: v1alpha1.TracingProvider{
+						OpenTelemetry: &v1alpha1.OpenTelemetryTracingConfig{
+							GrpcService: v1alpha1.CommonGrpcService{
+								BackendRef: &gwv1.BackendRef{
+									BackendObjectReference: gwv1.BackendObjectReference{
+										Name: "test-service",
+									},
+								},
+							},
+							ServiceName: "my:service",
+							Sampler: &v1alpha1.Sampler{
+								ParentBased: &v1alpha1.ParentBasedSamplerConfig{
+									Root: &v1alpha1.ParentBasedRootSampler{
+										TraceIdRatio: &v1alpha1.TraceIdRatioSamplerConfig{
+											Ratio: "0.001",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				expected: &envoy_hcm.HttpConnectionManager_Tracing{
+					Provider: &tracev3.Tracing_Http{
+						Name: "envoy.tracers.opentelemetry",
+						ConfigType: &tracev3.Tracing_Http_TypedConfig{
+							TypedConfig: mustMessageToAny(t, &tracev3.OpenTelemetryConfig{
+								GrpcService: &corev3.GrpcService{
+									TargetSpecifier: &corev3.GrpcService_EnvoyGrpc_{
+										EnvoyGrpc: &corev3.GrpcService_EnvoyGrpc{
+											ClusterName: "backend_default_test-service_0",
+										},
+									},
+								},
+								ServiceName: "my:service",
+								Sampler: &corev3.TypedExtensionConfig{
+									Name: "envoy.tracers.opentelemetry.samplers.parent_based",
+									TypedConfig: mustMessageToAny(t, &samplersv3.ParentBasedSamplerConfig{
+										WrappedSampler: &corev3.TypedExtensionConfig{
+											Name: "envoy.tracers.opentelemetry.samplers.trace_id_ratio_based",
+											TypedConfig: mustMessageToAny(t, &samplersv3.TraceIdRatioBasedSamplerConfig{
+												SamplingPercentage: &typev3.FractionalPercent{
+													Numerator:   1000,
+													Denominator: typev3.FractionalPercent_MILLION,
+												},
+											}),
+										},
+									}),
+								},
+							}),
+						},
+					},
+				},
+			},
 		}
 		for _, tc := range testCases {
 			_, cancel := context.WithCancel(context.Background())
@@ -408,3 +565,53 @@ func TestTracingConverter(t *testing.T) {
 		}
 	})
 }
+
+func TestTracingConverterErrors(t *testing.T) {
+	otelProvider := func(sampler *v1alpha1.Sampler) v1alpha1.TracingProvider {
+		return v1alpha1.TracingProvider{
+			OpenTelemetry: &v1alpha1.OpenTelemetryTracingConfig{
+				GrpcService: v1alpha1.CommonGrpcService{
+					BackendRef: &gwv1.BackendRef{
+						BackendObjectReference: gwv1.BackendObjectReference{
+							Name: "test-service",
+						},
+					},
+				},
+				ServiceName: "my:service",
+				Sampler:     sampler,
+			},
+		}
+	}
+	testCases := []struct {
+		name   string
+		config *v1alpha1.Tracing
+		err    string
+	}{
+		{
+			name:   "No provider",
+			config: &v1alpha1.Tracing{},
+			err:    "Tracing.Provider must specify one of OpenTelemetry, Zipkin or Datadog",
+		},
+		{
+			name: "Invalid ratio",
+			config: &v1alpha1.Tracing{
+				Provider: otelProvider(&v1alpha1.Sampler{
+					TraceIdRatio: &v1alpha1.TraceIdRatioSamplerConfig{Ratio: "1.5"},
+				}),
+			},
+			err: `invalid TraceIdRatio sampler ratio "1.5": must be between 0 and 1`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := translateTracing(tc.config, &ir.BackendObjectIR{
+				ObjectSource: ir.ObjectSource{
+					Kind:      "Backend",
+					Name:      "test-service",
+					Namespace: "default",
+				},
+			})
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"google.golang.org/protobuf/types/known/structpb"
@@ -12,6 +13,7 @@ import (
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	proxy_protocol_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/proxy_protocol/v3"
 	sockets_raw_buffer "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/raw_buffer/v3"
 	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
@@ -89,11 +91,23 @@ func isDisabledForUpstream(_ ir.BackendObjectIR) bool {
 }
 
 // we don't have a good way of know if we have ssl on the upstream, so check cluster instead
-// this could be a problem if the policy that adds ssl runs after this one.
-// so we need to think about how's best to handle this.
-func doesClusterHaveSslConfigPresent(_ *envoy_config_cluster_v3.Cluster) bool {
-	// TODO: implement this
-	return false
+// this could be a problem if the policy that adds ssl runs after this one, so such policies
+// should drop the istio transport socket matches (see IsIstioTransportSocketMatch).
+func doesClusterHaveSslConfigPresent(c *envoy_config_cluster_v3.Cluster) bool {
+	ts := c.GetTransportSocket()
+	if ts.GetName() == ourwellknown.TransportSocketUpstreamProxyProtocol {
+		proxyProtocol := &proxy_protocol_v3.ProxyProtocolUpstreamTransport{}
+		if err := ts.GetTypedConfig().UnmarshalTo(proxyProtocol); err != nil {
+			return false
+		}
+		ts = proxyProtocol.GetTransportSocket()
+	}
+	return ts != nil
+}
+
+// IsIstioTransportSocketMatch reports whether the transport socket match was added by this plugin.
+func IsIstioTransportSocketMatch(m *envoy_config_cluster_v3.Cluster_TransportSocketMatch) bool {
+	return strings.HasPrefix(m.GetName(), ourwellknown.TLSModeLabelShortname+"-")
 }
 
 func (p istioPlugin) processBackend(ctx context.Context, ir ir.PolicyIR, in ir.BackendObjectIR, out *envoy_config_cluster_v3.Cluster) {
@@ -108,7 +122,7 @@ func (p istioPlugin) processBackend(ctx context.Context, ir ir.PolicyIR, in ir.B
 	// 2) the upstream has not disabled auto mtls
 	// 3) the upstream has no sslConfig
 	if st.EnableAutoMtls && !isDisabledForUpstream(in) && !doesClusterHaveSslConfigPresent(out) {
-		sni := buildSni(in)
+		sni := BuildSni(in)
 
 		socketmatches = []*envoy_config_cluster_v3.Cluster_TransportSocketMatch{
 			// add istio mtls match
@@ -128,6 +142,16 @@ func createIstioMatch(sni string) *envoy_config_cluster_v3.Cluster_TransportSock
 		},
 	}
 
+	return &envoy_config_cluster_v3.Cluster_TransportSocketMatch{
+		Name:            fmt.Sprintf("%s-%s", ourwellknown.TLSModeLabelShortname, ourwellknown.IstioMutualTLSModeLabel),
+		Match:           istioMtlsTransportSocketMatch,
+		TransportSocket: IstioMutualTransportSocket(sni),
+	}
+}
+
+// IstioMutualTransportSocket returns the transport socket originating Istio mTLS with the
+// workload certificates served by the Istio SDS server.
+func IstioMutualTransportSocket(sni string) *envoy_config_core_v3.TransportSocket {
 	sslSds := &tlsv3.UpstreamTlsContext{
 		Sni: sni,
 		CommonTlsContext: &tlsv3.CommonTlsContext{
@@ -185,16 +209,10 @@ func createIstioMatch(sni string) *envoy_config_cluster_v3.Cluster_TransportSock
 	}
 
 	typedConfig, _ := utils.MessageToAny(sslSds)
-	transportSocket := &envoy_config_core_v3.TransportSocket{
+	return &envoy_config_core_v3.TransportSocket{
 		Name:       wellknown.TransportSocketTls,
 		ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
 	}
-
-	return &envoy_config_cluster_v3.Cluster_TransportSocketMatch{
-		Name:            fmt.Sprintf("%s-%s", ourwellknown.TLSModeLabelShortname, ourwellknown.IstioMutualTLSModeLabel),
-		Match:           istioMtlsTransportSocketMatch,
-		TransportSocket: transportSocket,
-	}
 }
 
 func createDefaultIstioMatch() *envoy_config_cluster_v3.Cluster_TransportSocketMatch {
@@ -212,7 +230,8 @@ func createDefaultIstioMatch() *envoy_config_cluster_v3.Cluster_TransportSocketM
 	}
 }
 
-func buildSni(upstream ir.BackendObjectIR) string {
+// BuildSni returns the SNI Istio expects for mTLS connections to the backend.
+func BuildSni(upstream ir.BackendObjectIR) string {
 	switch us := upstream.Obj.(type) {
 	case *corev1.Service:
 		return buildDNSSrvSubsetKey(
@@ -9,7 +9,6 @@ import (
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
-	"istio.io/istio/pkg/kube/kclient"
 	"istio.io/istio/pkg/kube/krt"
 	"istio.io/istio/pkg/ptr"
 	corev1 "k8s.io/api/core/v1"
@@ -27,12 +26,7 @@ import (
 const BackendClusterPrefix = "kube"
 
 func NewPlugin(ctx context.Context, commonCol *common.CommonCollections) extensionsplug.Plugin {
-	epSliceClient := kclient.NewFiltered[*discoveryv1.EndpointSlice](
-		commonCol.Client,
-		kclient.Filter{ObjectFilter: commonCol.Client.ObjectFilter()},
-	)
-	endpointSlices := krt.WrapClient(epSliceClient, commonCol.KrtOpts.ToOptions("EndpointSlices")...)
-	return NewPluginFromCollections(ctx, commonCol.KrtOpts, commonCol.Pods, commonCol.Services, endpointSlices, commonCol.Settings)
+	return NewPluginFromCollections(ctx, commonCol.KrtOpts, commonCol.Pods, commonCol.Services, commonCol.EndpointSlices, commonCol.Settings)
 }
 
 func NewPluginFromCollections(
@@ -0,0 +1,308 @@
+package listenerpolicy
+
+import (
+	"context"
+	"time"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	proxyprotocolv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/listener/proxy_protocol/v3"
+	connectionlimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/connection_limit/v3"
+	envoywellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	skubeclient "istio.io/istio/pkg/config/schema/kubeclient"
+	"istio.io/istio/pkg/kube/kclient"
+	"istio.io/istio/pkg/kube/krt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/common"
+	extensionsplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/client/clientset/versioned"
+	reports "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+	pluginsdkutils "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/utils"
+)
+
+// ConnectionLimitFilterName is the name of the network filter limiting the connections of a filter chain.
+const ConnectionLimitFilterName = "envoy.filters.network.connection_limit"
+
+type listenerPolicy struct {
+	ct                            time.Time
+	proxyProtocol                 *proxyprotocolv3.ProxyProtocol
+	perConnectionBufferLimitBytes *wrapperspb.UInt32Value
+	connectionLimit               *connectionlimitv3.ConnectionLimit
+}
+
+var _ ir.PolicyIR = &listenerPolicy{}
+
+func (d *listenerPolicy) CreationTime() time.Time {
+	return d.ct
+}
+
+func (d *listenerPolicy) Equals(in any) bool {
+	d2, ok := in.(*listenerPolicy)
+	if !ok {
+		return false
+	}
+	return d.ct.Equal(d2.ct) &&
+		proto.Equal(d.proxyProtocol, d2.proxyProtocol) &&
+		proto.Equal(d.perConnectionBufferLimitBytes, d2.perConnectionBufferLimitBytes) &&
+		proto.Equal(d.connectionLimit, d2.connectionLimit)
+}
+
+type listenerPolicyPluginGwPass struct {
+	ir.UnimplementedProxyTranslationPass
+	reporter reports.Reporter
+
+	// the policies are applied from the most to the least specific one, so the settings
+	// already applied on a listener or filter chain by this pass are not overridden.
+	proxyProtocolListeners map[*listenerv3.Listener]bool
+	bufferLimitListeners   map[*listenerv3.Listener]bool
+	connectionLimitChains  map[*listenerv3.FilterChain]bool
+	// conditions are the Accepted conditions of the applied policies, by policy ID. They are
+	// reported once all the listeners are translated, so that they are not overridden when the
+	// policies are reported as accepted by the HTTP filter chains.
+	conditions map[string]policyCondition
+}
+
+type policyCondition struct {
+	key         reports.PolicyKey
+	generation  int64
+	ancestorRef gwv1.ParentReference
+	condition   reports.PolicyCondition
+}
+
+var _ ir.ProxyTranslationPass = &listenerPolicyPluginGwPass{}
+
+func registerTypes(ourCli versioned.Interface) {
+	skubeclient.Register[*v1alpha1.ListenerPolicy](
+		wellknown.ListenerPolicyGVR,
+		wellknown.ListenerPolicyGVK,
+		func(c skubeclient.ClientGetter, namespace string, o metav1.ListOptions) (runtime.Object, error) {
+			return ourCli.GatewayV1alpha1().ListenerPolicies(namespace).List(context.Background(), o)
+		},
+		func(c skubeclient.ClientGetter, namespace string, o metav1.ListOptions) (watch.Interface, error) {
+			return ourCli.GatewayV1alpha1().ListenerPolicies(namespace).Watch(context.Background(), o)
+		},
+	)
+}
+
+func NewPlugin(ctx context.Context, commoncol *common.CommonCollections) extensionsplug.Plugin {
+	registerTypes(commoncol.OurClient)
+
+	col := krt.WrapClient(kclient.NewFiltered[*v1alpha1.ListenerPolicy](
+		commoncol.Client,
+		kclient.Filter{ObjectFilter: commoncol.Client.ObjectFilter()},
+	), commoncol.KrtOpts.ToOptions("ListenerPolicy")...)
+	gk := wellknown.ListenerPolicyGVK.GroupKind()
+	policyCol := krt.NewCollection(col, func(krtctx krt.HandlerContext, i *v1alpha1.ListenerPolicy) *ir.PolicyWrapper {
+		return &ir.PolicyWrapper{
+			ObjectSource: ir.ObjectSource{
+				Group:     gk.Group,
+				Kind:      gk.Kind,
+				Namespace: i.Namespace,
+				Name:      i.Name,
+			},
+			Policy:     i,
+			PolicyIR:   translate(i),
+			TargetRefs: pluginsdkutils.TargetRefsToPolicyRefsWithSectionName(i.Spec.TargetRefs, i.Spec.TargetSelectors),
+		}
+	})
+
+	return extensionsplug.Plugin{
+		ContributesPolicies: map[schema.GroupKind]extensionsplug.PolicyPlugin{
+			wellknown.ListenerPolicyGVK.GroupKind(): {
+				Name:                      "listenerpolicies",
+				NewGatewayTranslationPass: NewGatewayTranslationPass,
+				Policies:                  policyCol,
+				GetPolicyStatus:           getPolicyStatusFn(commoncol.CrudClient),
+				PatchPolicyStatus:         patchPolicyStatusFn(commoncol.CrudClient),
+			},
+		},
+	}
+}
+
+func translate(p *v1alpha1.ListenerPolicy) *listenerPolicy {
+	out := &listenerPolicy{
+		ct: p.CreationTimestamp.Time,
+	}
+
+	if pp := p.Spec.ProxyProtocol; pp != nil {
+		out.proxyProtocol = &proxyprotocolv3.ProxyProtocol{
+			AllowRequestsWithoutProxyProtocol: pp.AllowRequestsWithoutProxyProtocol != nil && *pp.AllowRequestsWithoutProxyProtocol,
+		}
+		if len(pp.Versions) > 0 {
+			allowed := map[v1alpha1.ProxyProtocolVersion]bool{}
+			for _, v := range pp.Versions {
+				allowed[v] = true
+			}
+			if !allowed[v1alpha1.ProxyProtocolVersionV1] {
+				out.proxyProtocol.DisallowedVersions = append(out.proxyProtocol.DisallowedVersions, corev3.ProxyProtocolConfig_V1)
+			}
+			if !allowed[v1alpha1.ProxyProtocolVersionV2] {
+				out.proxyProtocol.DisallowedVersions = append(out.proxyProtocol.DisallowedVersions, corev3.ProxyProtocolConfig_V2)
+			}
+		}
+	}
+
+	if p.Spec.PerConnectionBufferLimitBytes != nil {
+		out.perConnectionBufferLimitBytes = wrapperspb.UInt32(uint32(*p.Spec.PerConnectionBufferLimitBytes))
+	}
+
+	if cl := p.Spec.ConnectionLimit; cl != nil {
+		// the stat prefix is set per filter chain when the filter is added
+		out.connectionLimit = &connectionlimitv3.ConnectionLimit{
+			MaxConnections: wrapperspb.UInt64(uint64(cl.MaxConnections)),
+		}
+		if cl.Delay != nil {
+			out.connectionLimit.Delay = durationpb.New(cl.Delay.Duration)
+		}
+	}
+
+	return out
+}
+
+func NewGatewayTranslationPass(ctx context.Context, tctx ir.GwTranslationCtx, reporter reports.Reporter) ir.ProxyTranslationPass {
+	return &listenerPolicyPluginGwPass{
+		reporter:               reporter,
+		proxyProtocolListeners: map[*listenerv3.Listener]bool{},
+		bufferLimitListeners:   map[*listenerv3.Listener]bool{},
+		connectionLimitChains:  map[*listenerv3.FilterChain]bool{},
+		conditions:             map[string]policyCondition{},
+	}
+}
+
+// ApplyListenerPlugin sets the settings that apply to the whole envoy listener.
+// The PROXY protocol listener filter is added first, so that the TLS inspector and the
+// other listener filters see the restored addresses. A PROXY protocol filter added by
+// another plugin, e.g. the one reading the zTunnel TLVs on waypoints, is never replaced:
+// the policy is reported as conflicted instead.
+// The settings of a policy that only targets some of the Gateway listeners sharing the
+// port of the envoy listener are not applied either, as they would also apply to the
+// other Gateway listeners.
+func (p *listenerPolicyPluginGwPass) ApplyListenerPlugin(ctx context.Context, pCtx *ir.ListenerContext, out *listenerv3.Listener) {
+	policy, ok := pCtx.Policy.(*listenerPolicy)
+	if !ok {
+		return
+	}
+
+	if pCtx.PartiallyAttached && (policy.proxyProtocol != nil || policy.perConnectionBufferLimitBytes != nil) {
+		p.reportCondition(pCtx, metav1.ConditionFalse, gwv1alpha2.PolicyReasonConflicted,
+			"proxyProtocol and perConnectionBufferLimitBytes are not applied, they apply to all the listeners "+
+				"sharing the port of the targeted listener but the policy only targets some of them")
+		return
+	}
+
+	conflicted := false
+	if policy.proxyProtocol != nil && !p.proxyProtocolListeners[out] {
+		if hasListenerFilter(out, envoywellknown.ProxyProtocol) {
+			conflicted = true
+		} else {
+			typedConfig, _ := utils.MessageToAny(policy.proxyProtocol)
+			filter := &listenerv3.ListenerFilter{
+				Name: envoywellknown.ProxyProtocol,
+				ConfigType: &listenerv3.ListenerFilter_TypedConfig{
+					TypedConfig: typedConfig,
+				},
+			}
+			out.ListenerFilters = append([]*listenerv3.ListenerFilter{filter}, out.GetListenerFilters()...)
+			p.proxyProtocolListeners[out] = true
+		}
+	}
+
+	if policy.perConnectionBufferLimitBytes != nil && !p.bufferLimitListeners[out] {
+		out.PerConnectionBufferLimitBytes = policy.perConnectionBufferLimitBytes
+		p.bufferLimitListeners[out] = true
+	}
+
+	if conflicted {
+		p.reportCondition(pCtx, metav1.ConditionFalse, gwv1alpha2.PolicyReasonConflicted,
+			"proxyProtocol is not applied, the listener already has a PROXY protocol listener filter configured by kgateway")
+		return
+	}
+	p.reportCondition(pCtx, metav1.ConditionTrue, gwv1alpha2.PolicyReasonAccepted, reports.PolicyAcceptedMsg)
+}
+
+func hasListenerFilter(l *listenerv3.Listener, name string) bool {
+	for _, f := range l.GetListenerFilters() {
+		if f.GetName() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyFilterChainPlugin adds the connection limit filter first in the filter chain, so that
+// connections above the limit are closed before any other filter processes them.
+func (p *listenerPolicyPluginGwPass) ApplyFilterChainPlugin(ctx context.Context, pCtx *ir.FilterChainContext, out *listenerv3.FilterChain) {
+	policy, ok := pCtx.Policy.(*listenerPolicy)
+	if !ok || policy.connectionLimit == nil || p.connectionLimitChains[out] {
+		return
+	}
+	p.connectionLimitChains[out] = true
+
+	cfg := proto.Clone(policy.connectionLimit).(*connectionlimitv3.ConnectionLimit)
+	cfg.StatPrefix = pCtx.FilterChainName
+	typedConfig, _ := utils.MessageToAny(cfg)
+	filter := &listenerv3.Filter{
+		Name: ConnectionLimitFilterName,
+		ConfigType: &listenerv3.Filter_TypedConfig{
+			TypedConfig: typedConfig,
+		},
+	}
+	out.Filters = append([]*listenerv3.Filter{filter}, out.GetFilters()...)
+}
+
+// reportCondition records the Accepted condition of the policy, as it is applied on listeners
+// that may not have any HTTP filter chain to report it. A policy conflicted on one of the
+// listeners of the Gateway is not reported as accepted by the other ones.
+func (p *listenerPolicyPluginGwPass) reportCondition(
+	pCtx *ir.ListenerContext,
+	status metav1.ConditionStatus,
+	reason gwv1alpha2.PolicyConditionReason,
+	message string,
+) {
+	if pCtx.PolicyRef == nil || p.reporter == nil {
+		return
+	}
+	id := pCtx.PolicyRef.ID()
+	if prev, ok := p.conditions[id]; ok && prev.condition.Status == metav1.ConditionFalse {
+		return
+	}
+	p.conditions[id] = policyCondition{
+		key: reports.PolicyKey{
+			Group:     pCtx.PolicyRef.Group,
+			Kind:      pCtx.PolicyRef.Kind,
+			Namespace: pCtx.PolicyRef.Namespace,
+			Name:      pCtx.PolicyRef.Name,
+		},
+		generation:  pCtx.Generation,
+		ancestorRef: pCtx.PolicyAncestorRef,
+		condition: reports.PolicyCondition{
+			Type:               gwv1alpha2.PolicyConditionAccepted,
+			Status:             status,
+			Reason:             reason,
+			Message:            message,
+			ObservedGeneration: pCtx.Generation,
+		},
+	}
+}
+
+// ResourcesToAdd reports the recorded conditions of the policies. It runs once all the
+// listeners of the Gateway are translated.
+func (p *listenerPolicyPluginGwPass) ResourcesToAdd(ctx context.Context) ir.Resources {
+	for _, c := range p.conditions {
+		p.reporter.Policy(c.key, c.generation).AncestorRef(c.ancestorRef).SetCondition(c.condition)
+	}
+	return ir.Resources{}
+}
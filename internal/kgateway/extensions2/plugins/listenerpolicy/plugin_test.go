@@ -0,0 +1,215 @@
+package listenerpolicy
+
+import (
+	"context"
+	"testing"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	proxyprotocolv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/listener/proxy_protocol/v3"
+	connectionlimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/connection_limit/v3"
+	envoywellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/sandwich"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
+)
+
+func TestTranslateProxyProtocol(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    *v1alpha1.DownstreamProxyProtocol
+		wants *proxyprotocolv3.ProxyProtocol
+	}{
+		{
+			name:  "all versions required",
+			in:    &v1alpha1.DownstreamProxyProtocol{},
+			wants: &proxyprotocolv3.ProxyProtocol{},
+		},
+		{
+			name: "v2 only, optional",
+			in: &v1alpha1.DownstreamProxyProtocol{
+				AllowRequestsWithoutProxyProtocol: ptr.To(true),
+				Versions:                          []v1alpha1.ProxyProtocolVersion{v1alpha1.ProxyProtocolVersionV2},
+			},
+			wants: &proxyprotocolv3.ProxyProtocol{
+				AllowRequestsWithoutProxyProtocol: true,
+				DisallowedVersions:                []corev3.ProxyProtocolConfig_Version{corev3.ProxyProtocolConfig_V1},
+			},
+		},
+		{
+			name: "both versions listed",
+			in: &v1alpha1.DownstreamProxyProtocol{
+				Versions: []v1alpha1.ProxyProtocolVersion{v1alpha1.ProxyProtocolVersionV1, v1alpha1.ProxyProtocolVersionV2},
+			},
+			wants: &proxyprotocolv3.ProxyProtocol{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pol := translate(&v1alpha1.ListenerPolicy{Spec: v1alpha1.ListenerPolicySpec{ProxyProtocol: tt.in}})
+			assert.True(t, proto.Equal(tt.wants, pol.proxyProtocol), "got %v", pol.proxyProtocol)
+		})
+	}
+}
+
+func TestApplyListenerPluginMostSpecificPolicyWins(t *testing.T) {
+	pass := NewGatewayTranslationPass(context.Background(), ir.GwTranslationCtx{}, nil)
+	out := &listenerv3.Listener{}
+
+	// listener policies are applied before the gateway ones
+	listenerPolicy := translate(&v1alpha1.ListenerPolicy{Spec: v1alpha1.ListenerPolicySpec{
+		ProxyProtocol: &v1alpha1.DownstreamProxyProtocol{AllowRequestsWithoutProxyProtocol: ptr.To(true)},
+	}})
+	gwPolicy := translate(&v1alpha1.ListenerPolicy{Spec: v1alpha1.ListenerPolicySpec{
+		ProxyProtocol:                 &v1alpha1.DownstreamProxyProtocol{},
+		PerConnectionBufferLimitBytes: ptr.To(1024),
+	}})
+	pass.ApplyListenerPlugin(context.Background(), &ir.ListenerContext{Policy: listenerPolicy}, out)
+	pass.ApplyListenerPlugin(context.Background(), &ir.ListenerContext{Policy: gwPolicy}, out)
+
+	require.Len(t, out.GetListenerFilters(), 1)
+	assert.Equal(t, envoywellknown.ProxyProtocol, out.GetListenerFilters()[0].GetName())
+	msg, err := utils.AnyToMessage(out.GetListenerFilters()[0].GetTypedConfig())
+	require.NoError(t, err)
+	assert.True(t, msg.(*proxyprotocolv3.ProxyProtocol).GetAllowRequestsWithoutProxyProtocol())
+	assert.Equal(t, uint32(1024), out.GetPerConnectionBufferLimitBytes().GetValue())
+}
+
+func TestApplyListenerPluginKeepsSandwichProxyProtocol(t *testing.T) {
+	rm := reports.NewReportMap()
+	reporter := reports.NewReporter(&rm)
+	pass := NewGatewayTranslationPass(context.Background(), ir.GwTranslationCtx{}, reporter)
+	out := &listenerv3.Listener{
+		ListenerFilters: []*listenerv3.ListenerFilter{sandwich.ProxyProtocolTLV},
+	}
+
+	policyRef := &ir.AttachedPolicyRef{
+		Group:     wellknown.ListenerPolicyGVK.Group,
+		Kind:      wellknown.ListenerPolicyGVK.Kind,
+		Namespace: "default",
+		Name:      "proxy-protocol",
+	}
+	ancestorRef := gwv1.ParentReference{Name: "waypoint"}
+	pol := translate(&v1alpha1.ListenerPolicy{Spec: v1alpha1.ListenerPolicySpec{
+		ProxyProtocol:                 &v1alpha1.DownstreamProxyProtocol{},
+		PerConnectionBufferLimitBytes: ptr.To(1024),
+	}})
+	pass.ApplyListenerPlugin(context.Background(), &ir.ListenerContext{
+		Policy:            pol,
+		PolicyAncestorRef: ancestorRef,
+		PolicyRef:         policyRef,
+	}, out)
+
+	require.Len(t, out.GetListenerFilters(), 1)
+	assert.Same(t, sandwich.ProxyProtocolTLV, out.GetListenerFilters()[0])
+	assert.Equal(t, uint32(1024), out.GetPerConnectionBufferLimitBytes().GetValue())
+
+	pass.ResourcesToAdd(context.Background())
+	report := rm.Policies[reports.PolicyKey{
+		Group:     policyRef.Group,
+		Kind:      policyRef.Kind,
+		Namespace: policyRef.Namespace,
+		Name:      policyRef.Name,
+	}]
+	require.NotNil(t, report)
+	require.Len(t, report.Ancestors, 1)
+	var cond *metav1.Condition
+	for _, ancestor := range report.Ancestors {
+		cond = meta.FindStatusCondition(ancestor.Conditions, string(gwv1alpha2.PolicyConditionAccepted))
+	}
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, string(gwv1alpha2.PolicyReasonConflicted), cond.Reason)
+}
+
+func TestApplyListenerPluginSkipsPartiallyAttachedPolicy(t *testing.T) {
+	rm := reports.NewReportMap()
+	reporter := reports.NewReporter(&rm)
+	pass := NewGatewayTranslationPass(context.Background(), ir.GwTranslationCtx{}, reporter)
+	shared := &listenerv3.Listener{}
+	dedicated := &listenerv3.Listener{}
+
+	policyRef := &ir.AttachedPolicyRef{
+		Group:       wellknown.ListenerPolicyGVK.Group,
+		Kind:        wellknown.ListenerPolicyGVK.Kind,
+		Namespace:   "default",
+		Name:        "behind-nlb",
+		SectionName: "https",
+	}
+	ancestorRef := gwv1.ParentReference{Name: "gw"}
+	pol := translate(&v1alpha1.ListenerPolicy{Spec: v1alpha1.ListenerPolicySpec{
+		ProxyProtocol:                 &v1alpha1.DownstreamProxyProtocol{},
+		PerConnectionBufferLimitBytes: ptr.To(1024),
+	}})
+	pass.ApplyListenerPlugin(context.Background(), &ir.ListenerContext{
+		Policy:            pol,
+		PolicyAncestorRef: ancestorRef,
+		PolicyRef:         policyRef,
+		PartiallyAttached: true,
+	}, shared)
+	// the policy is applied on the listeners it fully targets, but stays conflicted
+	pass.ApplyListenerPlugin(context.Background(), &ir.ListenerContext{
+		Policy:            pol,
+		PolicyAncestorRef: ancestorRef,
+		PolicyRef:         policyRef,
+	}, dedicated)
+
+	assert.Empty(t, shared.GetListenerFilters())
+	assert.Nil(t, shared.GetPerConnectionBufferLimitBytes())
+	require.Len(t, dedicated.GetListenerFilters(), 1)
+	assert.Equal(t, uint32(1024), dedicated.GetPerConnectionBufferLimitBytes().GetValue())
+
+	pass.ResourcesToAdd(context.Background())
+	report := rm.Policies[reports.PolicyKey{
+		Group:     policyRef.Group,
+		Kind:      policyRef.Kind,
+		Namespace: policyRef.Namespace,
+		Name:      policyRef.Name,
+	}]
+	require.NotNil(t, report)
+	require.Len(t, report.Ancestors, 1)
+	var cond *metav1.Condition
+	for _, ancestor := range report.Ancestors {
+		cond = meta.FindStatusCondition(ancestor.Conditions, string(gwv1alpha2.PolicyConditionAccepted))
+	}
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, string(gwv1alpha2.PolicyReasonConflicted), cond.Reason)
+	assert.Contains(t, cond.Message, "only targets some of them")
+}
+
+func TestApplyFilterChainPluginPrependsConnectionLimit(t *testing.T) {
+	pass := NewGatewayTranslationPass(context.Background(), ir.GwTranslationCtx{}, nil)
+	out := &listenerv3.FilterChain{
+		Filters: []*listenerv3.Filter{{Name: envoywellknown.TCPProxy}},
+	}
+
+	// the listener policy is applied before the gateway one
+	for _, maxConnections := range []int64{100, 1000} {
+		pol := translate(&v1alpha1.ListenerPolicy{Spec: v1alpha1.ListenerPolicySpec{
+			ConnectionLimit: &v1alpha1.ConnectionLimit{MaxConnections: maxConnections},
+		}})
+		pass.ApplyFilterChainPlugin(context.Background(), &ir.FilterChainContext{Policy: pol, FilterChainName: "tcp"}, out)
+	}
+
+	require.Len(t, out.GetFilters(), 2)
+	assert.Equal(t, ConnectionLimitFilterName, out.GetFilters()[0].GetName())
+	assert.Equal(t, envoywellknown.TCPProxy, out.GetFilters()[1].GetName())
+	msg, err := utils.AnyToMessage(out.GetFilters()[0].GetTypedConfig())
+	require.NoError(t, err)
+	cfg := msg.(*connectionlimitv3.ConnectionLimit)
+	assert.Equal(t, uint64(100), cfg.GetMaxConnections().GetValue())
+	assert.Equal(t, "tcp", cfg.GetStatPrefix())
+}
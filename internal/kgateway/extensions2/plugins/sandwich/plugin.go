@@ -108,7 +108,15 @@ func (s *sandwichedTranslationPass) ApplyListenerPlugin(ctx context.Context, pCt
 		return
 	}
 
-	out.ListenerFilters = append(out.GetListenerFilters(), ProxyProtocolTLV)
+	// the TLV rule is required to authorize the requests, so it takes precedence over a
+	// PROXY protocol filter a ListenerPolicy may have added before
+	filters := make([]*listenerv3.ListenerFilter, 0, len(out.GetListenerFilters())+1)
+	for _, f := range out.GetListenerFilters() {
+		if f.GetName() != wellknown.ProxyProtocol {
+			filters = append(filters, f)
+		}
+	}
+	out.ListenerFilters = append(filters, ProxyProtocolTLV)
 	s.isSandwiched = true
 
 	return
@@ -111,6 +111,23 @@ func (b *TrafficPolicyBuilder) Translate(
 
 	bufferForSpec(policyCR.Spec, &outSpec)
 
+	// Apply JWT specific translation
+	err = b.jwtForSpec(krtctx, policyCR, &outSpec)
+	if err != nil {
+		errors = append(errors, err)
+	}
+
+	// Apply authorization specific translation
+	err = rbacForSpec(policyCR.Spec, &outSpec)
+	if err != nil {
+		errors = append(errors, err)
+	}
+
+	faultForSpec(policyCR.Spec, &outSpec)
+	timeoutsForSpec(policyCR.Spec, &outSpec)
+	retryForSpec(policyCR.Spec, &outSpec)
+	compressionForSpec(policyCR.Spec, &outSpec)
+
 	for _, err := range errors {
 		logger.Error("error translating gateway extension", "namespace", policyCR.GetNamespace(), "name", policyCR.GetName(), "error", err)
 	}
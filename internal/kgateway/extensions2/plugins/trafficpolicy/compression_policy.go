@@ -0,0 +1,75 @@
+package trafficpolicy
+
+import (
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+)
+
+// compressionAlgorithms are all algorithms for which an HTTPListenerPolicy can add compression filters.
+// The filters present in the chain are not known when translating routes, so the per-route config is set
+// for all of them, and the translator then removes the configs of the filters missing from the chain.
+var compressionAlgorithms = []v1alpha1.CompressionAlgorithm{
+	v1alpha1.CompressionAlgorithmGzip,
+	v1alpha1.CompressionAlgorithmBrotli,
+	v1alpha1.CompressionAlgorithmZstd,
+}
+
+type compressionIR struct {
+	responseCompression  *routev3.FilterConfig
+	requestDecompression *routev3.FilterConfig
+}
+
+func (c *compressionIR) Equals(other *compressionIR) bool {
+	if c == nil && other == nil {
+		return true
+	}
+	if c == nil || other == nil {
+		return false
+	}
+
+	return proto.Equal(c.responseCompression, other.responseCompression) &&
+		proto.Equal(c.requestDecompression, other.requestDecompression)
+}
+
+// compressionForSpec translates the compression spec into per-route filter configs and stores them in the traffic policy IR
+func compressionForSpec(spec v1alpha1.TrafficPolicySpec, out *trafficPolicySpecIr) {
+	if spec.Compression == nil {
+		return
+	}
+
+	out.compression = &compressionIR{
+		responseCompression:  compressionFilterConfig(spec.Compression.ResponseCompression),
+		requestDecompression: compressionFilterConfig(spec.Compression.RequestDecompression),
+	}
+}
+
+func compressionFilterConfig(enablement *v1alpha1.CompressionEnablement) *routev3.FilterConfig {
+	if enablement == nil {
+		return nil
+	}
+	if *enablement == v1alpha1.CompressionDisable {
+		return &routev3.FilterConfig{Disabled: true}
+	}
+	return EnableFilterPerRoute
+}
+
+func (p *trafficPolicyPluginGwPass) handleCompression(pCtxTypedFilterConfig *ir.TypedFilterConfigMap, compression *compressionIR) {
+	if compression == nil {
+		return
+	}
+
+	// The compression filters are added to the chain by the HTTPListenerPolicy plugin,
+	// so only the per-route config is set here.
+	for _, algorithm := range compressionAlgorithms {
+		if compression.responseCompression != nil {
+			pCtxTypedFilterConfig.AddTypedConfig(wellknown.CompressionFilterName(wellknown.CompressorFilterNamePrefix, string(algorithm)), compression.responseCompression)
+		}
+		if compression.requestDecompression != nil {
+			pCtxTypedFilterConfig.AddTypedConfig(wellknown.CompressionFilterName(wellknown.DecompressorFilterNamePrefix, string(algorithm)), compression.requestDecompression)
+		}
+	}
+}
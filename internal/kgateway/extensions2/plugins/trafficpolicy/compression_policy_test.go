@@ -0,0 +1,47 @@
+package trafficpolicy
+
+import (
+	"context"
+	"testing"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+func TestCompressionForRoute(t *testing.T) {
+	out := &trafficPolicySpecIr{}
+	compressionForSpec(v1alpha1.TrafficPolicySpec{
+		Compression: &v1alpha1.CompressionPolicy{
+			ResponseCompression:  ptr.To(v1alpha1.CompressionDisable),
+			RequestDecompression: ptr.To(v1alpha1.CompressionEnable),
+		},
+	}, out)
+	require.NotNil(t, out.compression)
+
+	ctx := context.Background()
+	plugin := &trafficPolicyPluginGwPass{}
+	pCtx := &ir.RouteContext{
+		FilterChainName: "fc",
+		Policy:          &TrafficPolicy{spec: *out},
+	}
+	require.NoError(t, plugin.ApplyForRoute(ctx, pCtx, &envoy_config_route_v3.Route{}))
+
+	for _, name := range []string{"envoy.filters.http.compressor/gzip", "envoy.filters.http.compressor/brotli", "envoy.filters.http.compressor/zstd"} {
+		cfg, ok := pCtx.TypedFilterConfig[name].(*envoy_config_route_v3.FilterConfig)
+		require.True(t, ok, name)
+		assert.True(t, cfg.GetDisabled())
+	}
+	for _, name := range []string{"envoy.filters.http.decompressor/gzip", "envoy.filters.http.decompressor/brotli", "envoy.filters.http.decompressor/zstd"} {
+		assert.Equal(t, EnableFilterPerRoute, pCtx.TypedFilterConfig[name], name)
+	}
+
+	// the compression filters are added by the HTTPListenerPolicy plugin
+	filters, err := plugin.HttpFilters(ctx, ir.FilterChainCommon{FilterChainName: "fc"})
+	require.NoError(t, err)
+	assert.Empty(t, filters)
+}
@@ -2,17 +2,25 @@ package trafficpolicy
 
 import (
 	"fmt"
+	"regexp"
+	"time"
 
+	envoy_mutation_rules_v3 "github.com/envoyproxy/go-control-plane/envoy/config/common/mutation_rules/v3"
+	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_ext_authz_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
 	set_metadata "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/set_metadata/v3"
 	envoy_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	"istio.io/istio/pkg/kube/krt"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
 )
 
 var (
@@ -45,6 +53,157 @@ var (
 	}
 )
 
+// defaultExtAuthTimeout is the timeout of the requests to the authorization service when unset.
+// It is also the default of Envoy for GRPC services.
+const defaultExtAuthTimeout = 200 * time.Millisecond
+
+// translateExtAuthProvider translates an ExtAuth GatewayExtension into the ext_authz filter config.
+func translateExtAuthProvider(
+	krtctx krt.HandlerContext,
+	backends *krtcollections.BackendIndex,
+	objectSource ir.ObjectSource,
+	provider *v1alpha1.ExtAuthProvider,
+) (*envoy_ext_authz_v3.ExtAuthz, error) {
+	extAuth := &envoy_ext_authz_v3.ExtAuthz{
+		FailureModeAllow:          provider.FailOpen,
+		MetadataContextNamespaces: provider.MetadataContextNamespaces,
+		AllowedHeaders:            toEnvoyListStringMatcher(provider.AllowedRequestHeaders),
+		FilterEnabledMetadata:     ExtAuthzEnabledMetadataMatcher,
+	}
+	if provider.StatusOnError != nil {
+		extAuth.StatusOnError = &envoy_type_v3.HttpStatus{
+			Code: envoy_type_v3.StatusCode(*provider.StatusOnError),
+		}
+	}
+	if provider.HeaderMutationRules != nil {
+		rules, err := toEnvoyHeaderMutationRules(provider.HeaderMutationRules)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ExtAuth headerMutationRules: %w", err)
+		}
+		extAuth.DecoderHeaderMutationRules = rules
+	}
+
+	timeout := durationpb.New(defaultExtAuthTimeout)
+	if provider.Timeout != nil {
+		timeout = durationpb.New(provider.Timeout.Duration)
+	}
+
+	if provider.HttpService != nil {
+		httpService, err := resolveExtAuthHttpService(krtctx, backends, objectSource, provider.HttpService, timeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve ExtAuth backend: %w", err)
+		}
+		extAuth.Services = &envoy_ext_authz_v3.ExtAuthz_HttpService{
+			HttpService: httpService,
+		}
+		return extAuth, nil
+	}
+
+	grpcService, err := ResolveExtGrpcService(krtctx, backends, false, objectSource, provider.GrpcService)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve ExtAuth backend: %w", err)
+	}
+	if provider.Timeout != nil {
+		grpcService.Timeout = timeout
+	}
+	extAuth.Services = &envoy_ext_authz_v3.ExtAuthz_GrpcService{
+		GrpcService: grpcService,
+	}
+	return extAuth, nil
+}
+
+func resolveExtAuthHttpService(
+	krtctx krt.HandlerContext,
+	backends *krtcollections.BackendIndex,
+	objectSource ir.ObjectSource,
+	httpService *v1alpha1.ExtAuthHttpService,
+	timeout *durationpb.Duration,
+) (*envoy_ext_authz_v3.HttpService, error) {
+	backend, err := resolveExtBackend(krtctx, backends, false, objectSource, httpService.BackendRef)
+	if err != nil {
+		return nil, err
+	}
+	host := backend.CanonicalHostname
+	if host == "" {
+		host = backend.Name
+	}
+
+	out := &envoy_ext_authz_v3.HttpService{
+		ServerUri: &envoy_core_v3.HttpUri{
+			Uri: fmt.Sprintf("http://%s:%d", host, backend.Port),
+			HttpUpstreamType: &envoy_core_v3.HttpUri_Cluster{
+				Cluster: backend.ClusterName(),
+			},
+			Timeout: timeout,
+		},
+		PathPrefix: httpService.PathPrefix,
+	}
+	authzResponse := &envoy_ext_authz_v3.AuthorizationResponse{
+		AllowedUpstreamHeaders:        toEnvoyListStringMatcher(httpService.AllowedUpstreamHeaders),
+		AllowedClientHeaders:          toEnvoyListStringMatcher(httpService.AllowedClientHeaders),
+		AllowedClientHeadersOnSuccess: toEnvoyListStringMatcher(httpService.AllowedClientHeadersOnSuccess),
+	}
+	if proto.Size(authzResponse) > 0 {
+		out.AuthorizationResponse = authzResponse
+	}
+	return out, nil
+}
+
+func toEnvoyListStringMatcher(in []v1alpha1.StringMatcher) *envoy_matcher_v3.ListStringMatcher {
+	if len(in) == 0 {
+		return nil
+	}
+	out := &envoy_matcher_v3.ListStringMatcher{}
+	for i := range in {
+		if matcher := toEnvoyStringMatcher(&in[i]); matcher != nil {
+			out.Patterns = append(out.Patterns, matcher)
+		}
+	}
+	return out
+}
+
+func toEnvoyHeaderMutationRules(in *v1alpha1.HeaderMutationRules) (*envoy_mutation_rules_v3.HeaderMutationRules, error) {
+	out := &envoy_mutation_rules_v3.HeaderMutationRules{}
+	for _, rule := range []struct {
+		set bool
+		out **wrapperspb.BoolValue
+	}{
+		{in.AllowAllRouting, &out.AllowAllRouting},
+		{in.AllowEnvoy, &out.AllowEnvoy},
+		{in.DisallowSystem, &out.DisallowSystem},
+		{in.DisallowAll, &out.DisallowAll},
+		{in.DisallowIsError, &out.DisallowIsError},
+	} {
+		if rule.set {
+			*rule.out = wrapperspb.Bool(true)
+		}
+	}
+
+	var err error
+	if out.AllowExpression, err = toEnvoyRegexMatcher(in.AllowExpression); err != nil {
+		return nil, fmt.Errorf("allowExpression: %w", err)
+	}
+	if out.DisallowExpression, err = toEnvoyRegexMatcher(in.DisallowExpression); err != nil {
+		return nil, fmt.Errorf("disallowExpression: %w", err)
+	}
+	return out, nil
+}
+
+func toEnvoyRegexMatcher(regex *string) (*envoy_matcher_v3.RegexMatcher, error) {
+	if regex == nil {
+		return nil, nil
+	}
+	if _, err := regexp.Compile(*regex); err != nil {
+		return nil, err
+	}
+	return &envoy_matcher_v3.RegexMatcher{
+		EngineType: &envoy_matcher_v3.RegexMatcher_GoogleRe2{
+			GoogleRe2: &envoy_matcher_v3.RegexMatcher_GoogleRE2{},
+		},
+		Regex: *regex,
+	}, nil
+}
+
 type extAuthIR struct {
 	provider        *TrafficPolicyGatewayExtensionIR
 	enablement      v1alpha1.ExtAuthEnabled
@@ -10,6 +10,7 @@ import (
 	envoy_ext_authz_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
@@ -200,3 +201,28 @@ func TestExtAuthPolicyPlugin(t *testing.T) {
 		assert.NotEmpty(t, pCtx.TypedFilterConfig[extAuthGlobalDisableFilterName])
 	})
 }
+
+func TestToEnvoyHeaderMutationRules(t *testing.T) {
+	t.Run("translates the rules", func(t *testing.T) {
+		rules, err := toEnvoyHeaderMutationRules(&v1alpha1.HeaderMutationRules{
+			DisallowSystem:  true,
+			DisallowIsError: true,
+			AllowExpression: ptr.To("^x-auth-.*$"),
+		})
+
+		require.NoError(t, err)
+		assert.True(t, rules.GetDisallowSystem().GetValue())
+		assert.True(t, rules.GetDisallowIsError().GetValue())
+		assert.Nil(t, rules.GetAllowEnvoy())
+		assert.Equal(t, "^x-auth-.*$", rules.GetAllowExpression().GetRegex())
+		assert.Nil(t, rules.GetDisallowExpression())
+	})
+
+	t.Run("rejects invalid expressions", func(t *testing.T) {
+		_, err := toEnvoyHeaderMutationRules(&v1alpha1.HeaderMutationRules{
+			DisallowExpression: ptr.To("x-(auth"),
+		})
+
+		assert.ErrorContains(t, err, "disallowExpression")
+	})
+}
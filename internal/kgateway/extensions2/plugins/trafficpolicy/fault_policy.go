@@ -0,0 +1,109 @@
+package trafficpolicy
+
+import (
+	commonfaultv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/fault/v3"
+	faultv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+const (
+	faultFilterName = "envoy.filters.http.fault"
+	// defaultFaultPercentage is used when the percentage of a delay or abort is not specified.
+	defaultFaultPercentage = 100
+)
+
+type faultIR struct {
+	faultPerRoute *faultv3.HTTPFault
+}
+
+func (f *faultIR) Equals(other *faultIR) bool {
+	if f == nil && other == nil {
+		return true
+	}
+	if f == nil || other == nil {
+		return false
+	}
+
+	return proto.Equal(f.faultPerRoute, other.faultPerRoute)
+}
+
+// Validate performs PGV validation and checks that all regular expressions
+// in the generated fault configuration are valid RE2 expressions.
+func (f *faultIR) Validate() error {
+	if f == nil {
+		return nil
+	}
+	if err := f.faultPerRoute.Validate(); err != nil {
+		return err
+	}
+	return validateRegexes(f.faultPerRoute)
+}
+
+// faultForSpec translates the fault injection spec into an envoy fault policy and stores it in the traffic policy IR
+func faultForSpec(spec v1alpha1.TrafficPolicySpec, out *trafficPolicySpecIr) {
+	if spec.FaultInjection == nil {
+		return
+	}
+
+	fault := &faultv3.HTTPFault{}
+	if delay := spec.FaultInjection.Delay; delay != nil {
+		fault.Delay = &commonfaultv3.FaultDelay{
+			FaultDelaySecifier: &commonfaultv3.FaultDelay_FixedDelay{
+				FixedDelay: durationpb.New(delay.FixedDelay.Duration),
+			},
+			Percentage: faultPercentage(delay.Percentage),
+		}
+	}
+	if abort := spec.FaultInjection.Abort; abort != nil {
+		fault.Abort = &faultv3.FaultAbort{
+			Percentage: faultPercentage(abort.Percentage),
+		}
+		switch {
+		case abort.HTTPStatus != nil:
+			fault.GetAbort().ErrorType = &faultv3.FaultAbort_HttpStatus{HttpStatus: *abort.HTTPStatus}
+		case abort.GRPCStatus != nil:
+			fault.GetAbort().ErrorType = &faultv3.FaultAbort_GrpcStatus{GrpcStatus: *abort.GRPCStatus}
+		}
+	}
+	for _, header := range spec.FaultInjection.Headers {
+		fault.Headers = append(fault.Headers, toEnvoyHeaderMatcher(header))
+	}
+
+	out.fault = &faultIR{
+		faultPerRoute: fault,
+	}
+}
+
+func faultPercentage(percentage *uint32) *envoy_type_v3.FractionalPercent {
+	numerator := uint32(defaultFaultPercentage)
+	if percentage != nil {
+		numerator = *percentage
+	}
+	return &envoy_type_v3.FractionalPercent{
+		Numerator:   numerator,
+		Denominator: envoy_type_v3.FractionalPercent_HUNDRED,
+	}
+}
+
+func (p *trafficPolicyPluginGwPass) handleFault(fcn string, pCtxTypedFilterConfig *ir.TypedFilterConfigMap, fault *faultIR) {
+	if fault == nil {
+		return
+	}
+
+	// Add fault configuration to the typed_per_filter_config for route-level override
+	pCtxTypedFilterConfig.AddTypedConfig(faultFilterName, fault.faultPerRoute)
+
+	// Add a filter to the chain. When having a fault policy for a route we need to also have a
+	// globally disabled fault filter in the chain otherwise it will be ignored.
+	if p.faultInChain == nil {
+		p.faultInChain = make(map[string]*faultv3.HTTPFault)
+	}
+	if _, ok := p.faultInChain[fcn]; !ok {
+		p.faultInChain[fcn] = &faultv3.HTTPFault{}
+	}
+}
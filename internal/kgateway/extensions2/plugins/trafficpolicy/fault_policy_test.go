@@ -0,0 +1,116 @@
+package trafficpolicy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	faultv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+func TestFaultForSpec(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     *v1alpha1.FaultInjection
+		validate func(t *testing.T, fault *faultv3.HTTPFault)
+	}{
+		{
+			name: "delay with default percentage",
+			spec: &v1alpha1.FaultInjection{
+				Delay: &v1alpha1.FaultDelay{
+					FixedDelay: metav1.Duration{Duration: 2 * time.Second},
+				},
+			},
+			validate: func(t *testing.T, fault *faultv3.HTTPFault) {
+				assert.Nil(t, fault.GetAbort())
+				assert.Equal(t, 2*time.Second, fault.GetDelay().GetFixedDelay().AsDuration())
+				assert.Equal(t, uint32(100), fault.GetDelay().GetPercentage().GetNumerator())
+				assert.Equal(t, envoy_type_v3.FractionalPercent_HUNDRED, fault.GetDelay().GetPercentage().GetDenominator())
+			},
+		},
+		{
+			name: "http abort",
+			spec: &v1alpha1.FaultInjection{
+				Abort: &v1alpha1.FaultAbort{
+					HTTPStatus: ptr.To(uint32(503)),
+					Percentage: ptr.To(uint32(25)),
+				},
+			},
+			validate: func(t *testing.T, fault *faultv3.HTTPFault) {
+				assert.Nil(t, fault.GetDelay())
+				assert.Equal(t, uint32(503), fault.GetAbort().GetHttpStatus())
+				assert.Equal(t, uint32(25), fault.GetAbort().GetPercentage().GetNumerator())
+			},
+		},
+		{
+			name: "grpc abort gated by header",
+			spec: &v1alpha1.FaultInjection{
+				Abort: &v1alpha1.FaultAbort{
+					GRPCStatus: ptr.To(uint32(14)),
+				},
+				Headers: []gwv1.HTTPHeaderMatch{{
+					Name:  "x-chaos",
+					Value: "true",
+				}},
+			},
+			validate: func(t *testing.T, fault *faultv3.HTTPFault) {
+				assert.Equal(t, uint32(14), fault.GetAbort().GetGrpcStatus())
+				require.Len(t, fault.GetHeaders(), 1)
+				assert.Equal(t, "x-chaos", fault.GetHeaders()[0].GetName())
+				assert.Equal(t, "true", fault.GetHeaders()[0].GetStringMatch().GetExact())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &trafficPolicySpecIr{}
+			faultForSpec(v1alpha1.TrafficPolicySpec{FaultInjection: tt.spec}, out)
+			require.NotNil(t, out.fault)
+			require.NoError(t, out.fault.Validate())
+			tt.validate(t, out.fault.faultPerRoute)
+		})
+	}
+
+	t.Run("nil fault spec", func(t *testing.T) {
+		out := &trafficPolicySpecIr{}
+		faultForSpec(v1alpha1.TrafficPolicySpec{}, out)
+		assert.Nil(t, out.fault)
+	})
+}
+
+func TestFaultFilterInChain(t *testing.T) {
+	out := &trafficPolicySpecIr{}
+	faultForSpec(v1alpha1.TrafficPolicySpec{
+		FaultInjection: &v1alpha1.FaultInjection{
+			Abort: &v1alpha1.FaultAbort{HTTPStatus: ptr.To(uint32(500))},
+		},
+	}, out)
+
+	ctx := context.Background()
+	plugin := &trafficPolicyPluginGwPass{}
+	pCtx := &ir.RouteContext{
+		FilterChainName: "fc",
+		Policy:          &TrafficPolicy{spec: *out},
+	}
+	require.NoError(t, plugin.ApplyForRoute(ctx, pCtx, &envoy_config_route_v3.Route{}))
+	perRoute, ok := pCtx.TypedFilterConfig[faultFilterName].(*faultv3.HTTPFault)
+	require.True(t, ok)
+	assert.Equal(t, uint32(500), perRoute.GetAbort().GetHttpStatus())
+
+	filters, err := plugin.HttpFilters(ctx, ir.FilterChainCommon{FilterChainName: "fc"})
+	require.NoError(t, err)
+	require.Len(t, filters, 1)
+	assert.Equal(t, faultFilterName, filters[0].Filter.GetName())
+	assert.True(t, filters[0].Filter.GetDisabled())
+}
@@ -13,6 +13,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"istio.io/istio/pkg/kube/krt"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/common"
@@ -69,19 +70,13 @@ func TranslateGatewayExtensionBuilder(commoncol *common.CommonCollections) func(
 
 		switch gExt.Type {
 		case v1alpha1.GatewayExtensionTypeExtAuth:
-			envoyGrpcService, err := ResolveExtGrpcService(krtctx, commoncol.BackendIndex, false, gExt.ObjectSource, gExt.ExtAuth.GrpcService)
+			extAuth, err := translateExtAuthProvider(krtctx, commoncol.BackendIndex, gExt.ObjectSource, gExt.ExtAuth)
 			if err != nil {
 				// TODO: should this be a warning, and set cluster to blackhole?
-				p.Err = fmt.Errorf("failed to resolve ExtAuth backend: %w", err)
+				p.Err = err
 				return p
 			}
-
-			p.ExtAuth = &envoy_ext_authz_v3.ExtAuthz{
-				Services: &envoy_ext_authz_v3.ExtAuthz_GrpcService{
-					GrpcService: envoyGrpcService,
-				},
-				FilterEnabledMetadata: ExtAuthzEnabledMetadataMatcher,
-			}
+			p.ExtAuth = extAuth
 
 		case v1alpha1.GatewayExtensionTypeExtProc:
 			envoyGrpcService, err := ResolveExtGrpcService(krtctx, commoncol.BackendIndex, false, gExt.ObjectSource, gExt.ExtProc.GrpcService)
@@ -116,38 +111,21 @@ func TranslateGatewayExtensionBuilder(commoncol *common.CommonCollections) func(
 }
 
 func ResolveExtGrpcService(krtctx krt.HandlerContext, backends *krtcollections.BackendIndex, disableExtensionRefValidation bool, objectSource ir.ObjectSource, grpcService *v1alpha1.ExtGrpcService) (*envoy_core_v3.GrpcService, error) {
-	var clusterName string
-	var authority string
-	if grpcService != nil {
-		if grpcService.BackendRef == nil {
-			return nil, errors.New("backend not provided")
-		}
-		backendRef := grpcService.BackendRef.BackendObjectReference
-
-		var backend *ir.BackendObjectIR
-		var err error
-		if disableExtensionRefValidation {
-			backend, err = backends.GetBackendFromRefWithoutRefGrantValidation(krtctx, objectSource, backendRef)
-		} else {
-			backend, err = backends.GetBackendFromRef(krtctx, objectSource, backendRef)
-		}
-		if err != nil {
-			return nil, err
-		}
-		if backend != nil {
-			clusterName = backend.ClusterName()
-		}
-		if grpcService.Authority != nil {
-			authority = *grpcService.Authority
-		}
-	}
-	if clusterName == "" {
+	if grpcService == nil {
 		return nil, errors.New("backend not found")
 	}
+	backend, err := resolveExtBackend(krtctx, backends, disableExtensionRefValidation, objectSource, grpcService.BackendRef)
+	if err != nil {
+		return nil, err
+	}
+	var authority string
+	if grpcService.Authority != nil {
+		authority = *grpcService.Authority
+	}
 	envoyGrpcService := &envoy_core_v3.GrpcService{
 		TargetSpecifier: &envoy_core_v3.GrpcService_EnvoyGrpc_{
 			EnvoyGrpc: &envoy_core_v3.GrpcService_EnvoyGrpc{
-				ClusterName: clusterName,
+				ClusterName: backend.ClusterName(),
 				Authority:   authority,
 			},
 		},
@@ -155,6 +133,28 @@ func ResolveExtGrpcService(krtctx krt.HandlerContext, backends *krtcollections.B
 	return envoyGrpcService, nil
 }
 
+// resolveExtBackend returns the backend of a gateway extension service.
+func resolveExtBackend(krtctx krt.HandlerContext, backends *krtcollections.BackendIndex, disableExtensionRefValidation bool, objectSource ir.ObjectSource, backendRef *gwv1.BackendRef) (*ir.BackendObjectIR, error) {
+	if backendRef == nil {
+		return nil, errors.New("backend not provided")
+	}
+
+	var backend *ir.BackendObjectIR
+	var err error
+	if disableExtensionRefValidation {
+		backend, err = backends.GetBackendFromRefWithoutRefGrantValidation(krtctx, objectSource, backendRef.BackendObjectReference)
+	} else {
+		backend, err = backends.GetBackendFromRef(krtctx, objectSource, backendRef.BackendObjectReference)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if backend == nil || backend.ClusterName() == "" {
+		return nil, errors.New("backend not found")
+	}
+	return backend, nil
+}
+
 // FIXME: Should this live here instead of the global rate limit plugin?
 func resolveRateLimitService(grpcService *envoy_core_v3.GrpcService, rateLimit *v1alpha1.RateLimitProvider) *ratev3.RateLimit {
 	envoyRateLimit := &ratev3.RateLimit{
@@ -177,7 +177,9 @@ func resolveRateLimitService(grpcService *envoy_core_v3.GrpcService, rateLimit *
 	}
 	// Set defaults for other required fields
 	envoyRateLimit.StatPrefix = rateLimitStatPrefix
-	envoyRateLimit.EnableXRatelimitHeaders = ratev3.RateLimit_DRAFT_VERSION_03
+	if rateLimit.XRateLimitHeaders != v1alpha1.XRateLimitHeadersOff {
+		envoyRateLimit.EnableXRatelimitHeaders = ratev3.RateLimit_DRAFT_VERSION_03
+	}
 	envoyRateLimit.RequestType = "both"
 
 	return envoyRateLimit
@@ -6,7 +6,9 @@ import (
 
 	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	ratev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
+	metadatav3 "github.com/envoyproxy/go-control-plane/envoy/type/metadata/v3"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	"istio.io/istio/pkg/kube/krt"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
@@ -144,6 +146,41 @@ func createRateLimitActions(descriptors []v1alpha1.RateLimitDescriptor) ([]*rout
 						DescriptorKey: "path",
 					},
 				}
+			case v1alpha1.RateLimitDescriptorEntryTypeMethod:
+				action.ActionSpecifier = &routev3.RateLimit_Action_RequestHeaders_{
+					RequestHeaders: &routev3.RateLimit_Action_RequestHeaders{
+						HeaderName:    ":method",
+						DescriptorKey: "method",
+					},
+				}
+			case v1alpha1.RateLimitDescriptorEntryTypeQueryParameter:
+				if entry.QueryParameter == "" {
+					return nil, fmt.Errorf("query parameter entry requires QueryParameter field to be set")
+				}
+				action.ActionSpecifier = &routev3.RateLimit_Action_QueryParameters_{
+					QueryParameters: &routev3.RateLimit_Action_QueryParameters{
+						QueryParameterName: entry.QueryParameter,
+						DescriptorKey:      entry.QueryParameter, // Use query parameter name as key
+					},
+				}
+			case v1alpha1.RateLimitDescriptorEntryTypeMetadata:
+				if entry.Metadata == nil {
+					return nil, fmt.Errorf("metadata entry requires Metadata field to be set")
+				}
+				action.ActionSpecifier = &routev3.RateLimit_Action_Metadata{
+					Metadata: toMetadataAction(entry.Metadata),
+				}
+			case v1alpha1.RateLimitDescriptorEntryTypeMaskedRemoteAddress:
+				action.ActionSpecifier = &routev3.RateLimit_Action_MaskedRemoteAddress_{
+					MaskedRemoteAddress: toMaskedRemoteAddressAction(entry.MaskedRemoteAddress),
+				}
+			case v1alpha1.RateLimitDescriptorEntryTypeHeaderValueMatch:
+				if entry.HeaderValueMatch == nil {
+					return nil, fmt.Errorf("header value match entry requires HeaderValueMatch field to be set")
+				}
+				action.ActionSpecifier = &routev3.RateLimit_Action_HeaderValueMatch_{
+					HeaderValueMatch: toHeaderValueMatchAction(entry.HeaderValueMatch),
+				}
 			default:
 				return nil, fmt.Errorf("unsupported entry type: %s", entry.Type)
 			}
@@ -166,6 +203,53 @@ func createRateLimitActions(descriptors []v1alpha1.RateLimitDescriptor) ([]*rout
 	return result, nil
 }
 
+func toMetadataAction(in *v1alpha1.RateLimitDescriptorEntryMetadata) *routev3.RateLimit_Action_MetaData {
+	out := &routev3.RateLimit_Action_MetaData{
+		DescriptorKey: in.Key,
+		MetadataKey: &metadatav3.MetadataKey{
+			Key: in.Namespace,
+		},
+		Source: routev3.RateLimit_Action_MetaData_DYNAMIC,
+	}
+	for _, key := range in.Path {
+		out.MetadataKey.Path = append(out.MetadataKey.Path, &metadatav3.MetadataKey_PathSegment{
+			Segment: &metadatav3.MetadataKey_PathSegment_Key{Key: key},
+		})
+	}
+	if in.DefaultValue != nil {
+		out.DefaultValue = *in.DefaultValue
+	}
+	return out
+}
+
+func toMaskedRemoteAddressAction(in *v1alpha1.RateLimitDescriptorEntryMaskedRemoteAddress) *routev3.RateLimit_Action_MaskedRemoteAddress {
+	out := &routev3.RateLimit_Action_MaskedRemoteAddress{}
+	if in == nil {
+		return out
+	}
+	if in.V4PrefixLen != nil {
+		out.V4PrefixMaskLen = wrapperspb.UInt32(uint32(*in.V4PrefixLen))
+	}
+	if in.V6PrefixLen != nil {
+		out.V6PrefixMaskLen = wrapperspb.UInt32(uint32(*in.V6PrefixLen))
+	}
+	return out
+}
+
+func toHeaderValueMatchAction(in *v1alpha1.RateLimitDescriptorEntryHeaderValueMatch) *routev3.RateLimit_Action_HeaderValueMatch {
+	out := &routev3.RateLimit_Action_HeaderValueMatch{
+		DescriptorKey:   in.Key,
+		DescriptorValue: in.Value,
+	}
+	if in.ExpectMatch != nil {
+		out.ExpectMatch = wrapperspb.Bool(*in.ExpectMatch)
+	}
+	for _, header := range in.Headers {
+		out.Headers = append(out.Headers, toEnvoyHeaderMatcher(header))
+	}
+	return out
+}
+
 func getRateLimitFilterName(name string) string {
 	if name == "" {
 		return rateLimitFilterNamePrefix
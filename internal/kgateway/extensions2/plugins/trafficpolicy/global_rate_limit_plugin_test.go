@@ -14,6 +14,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/durationpb"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
@@ -106,6 +108,127 @@ func TestCreateRateLimitActions(t *testing.T) {
 				assert.Equal(t, "path", requestHeaders.DescriptorKey)
 			},
 		},
+		{
+			name: "with method descriptor",
+			descriptors: []v1alpha1.RateLimitDescriptor{
+				{
+					Entries: []v1alpha1.RateLimitDescriptorEntry{
+						{
+							Type: v1alpha1.RateLimitDescriptorEntryTypeMethod,
+						},
+					},
+				},
+			},
+			validateResult: func(t *testing.T, actions []*routeconfv3.RateLimit_Action) {
+				require.Len(t, actions, 1)
+				requestHeaders := actions[0].GetRequestHeaders()
+				require.NotNil(t, requestHeaders)
+				assert.Equal(t, ":method", requestHeaders.HeaderName)
+				assert.Equal(t, "method", requestHeaders.DescriptorKey)
+			},
+		},
+		{
+			name: "with query parameter descriptor",
+			descriptors: []v1alpha1.RateLimitDescriptor{
+				{
+					Entries: []v1alpha1.RateLimitDescriptorEntry{
+						{
+							Type:           v1alpha1.RateLimitDescriptorEntryTypeQueryParameter,
+							QueryParameter: "api_key",
+						},
+					},
+				},
+			},
+			validateResult: func(t *testing.T, actions []*routeconfv3.RateLimit_Action) {
+				require.Len(t, actions, 1)
+				queryParameters := actions[0].GetQueryParameters()
+				require.NotNil(t, queryParameters)
+				assert.Equal(t, "api_key", queryParameters.QueryParameterName)
+				assert.Equal(t, "api_key", queryParameters.DescriptorKey)
+			},
+		},
+		{
+			name: "with metadata descriptor",
+			descriptors: []v1alpha1.RateLimitDescriptor{
+				{
+					Entries: []v1alpha1.RateLimitDescriptorEntry{
+						{
+							Type: v1alpha1.RateLimitDescriptorEntryTypeMetadata,
+							Metadata: &v1alpha1.RateLimitDescriptorEntryMetadata{
+								Key:          "user",
+								Namespace:    "envoy.filters.http.jwt_authn",
+								Path:         []string{"principal", "sub"},
+								DefaultValue: ptr.To("anonymous"),
+							},
+						},
+					},
+				},
+			},
+			validateResult: func(t *testing.T, actions []*routeconfv3.RateLimit_Action) {
+				require.Len(t, actions, 1)
+				metadata := actions[0].GetMetadata()
+				require.NotNil(t, metadata)
+				assert.Equal(t, "user", metadata.DescriptorKey)
+				assert.Equal(t, "anonymous", metadata.DefaultValue)
+				assert.Equal(t, routeconfv3.RateLimit_Action_MetaData_DYNAMIC, metadata.Source)
+				assert.Equal(t, "envoy.filters.http.jwt_authn", metadata.MetadataKey.Key)
+				require.Len(t, metadata.MetadataKey.Path, 2)
+				assert.Equal(t, "principal", metadata.MetadataKey.Path[0].GetKey())
+				assert.Equal(t, "sub", metadata.MetadataKey.Path[1].GetKey())
+			},
+		},
+		{
+			name: "with masked remote address descriptor",
+			descriptors: []v1alpha1.RateLimitDescriptor{
+				{
+					Entries: []v1alpha1.RateLimitDescriptorEntry{
+						{
+							Type: v1alpha1.RateLimitDescriptorEntryTypeMaskedRemoteAddress,
+							MaskedRemoteAddress: &v1alpha1.RateLimitDescriptorEntryMaskedRemoteAddress{
+								V4PrefixLen: ptr.To[int32](24),
+							},
+						},
+					},
+				},
+			},
+			validateResult: func(t *testing.T, actions []*routeconfv3.RateLimit_Action) {
+				require.Len(t, actions, 1)
+				maskedRemoteAddress := actions[0].GetMaskedRemoteAddress()
+				require.NotNil(t, maskedRemoteAddress)
+				assert.Equal(t, uint32(24), maskedRemoteAddress.GetV4PrefixMaskLen().GetValue())
+				assert.Nil(t, maskedRemoteAddress.GetV6PrefixMaskLen())
+			},
+		},
+		{
+			name: "with header value match descriptor",
+			descriptors: []v1alpha1.RateLimitDescriptor{
+				{
+					Entries: []v1alpha1.RateLimitDescriptorEntry{
+						{
+							Type: v1alpha1.RateLimitDescriptorEntryTypeHeaderValueMatch,
+							HeaderValueMatch: &v1alpha1.RateLimitDescriptorEntryHeaderValueMatch{
+								Value:       "internal",
+								ExpectMatch: ptr.To(false),
+								Headers: []gwv1.HTTPHeaderMatch{
+									{Name: "x-client", Value: "internal"},
+								},
+							},
+						},
+					},
+				},
+			},
+			validateResult: func(t *testing.T, actions []*routeconfv3.RateLimit_Action) {
+				require.Len(t, actions, 1)
+				headerValueMatch := actions[0].GetHeaderValueMatch()
+				require.NotNil(t, headerValueMatch)
+				assert.Empty(t, headerValueMatch.DescriptorKey)
+				assert.Equal(t, "internal", headerValueMatch.DescriptorValue)
+				assert.False(t, headerValueMatch.GetExpectMatch().GetValue())
+				require.Len(t, headerValueMatch.Headers, 1)
+				assert.Equal(t, "x-client", headerValueMatch.Headers[0].Name)
+				assert.Equal(t, "internal", headerValueMatch.Headers[0].GetStringMatch().GetExact())
+			},
+		},
 		{
 			name: "with multiple descriptors",
 			descriptors: []v1alpha1.RateLimitDescriptor{
@@ -206,6 +329,19 @@ func TestCreateRateLimitActions(t *testing.T) {
 			},
 			expectedError: "header entry requires Header field to be set",
 		},
+		{
+			name: "with missing metadata",
+			descriptors: []v1alpha1.RateLimitDescriptor{
+				{
+					Entries: []v1alpha1.RateLimitDescriptorEntry{
+						{
+							Type: v1alpha1.RateLimitDescriptorEntryTypeMetadata,
+						},
+					},
+				},
+			},
+			expectedError: "metadata entry requires Metadata field to be set",
+		},
 		{
 			name: "with unsupported entry type",
 			descriptors: []v1alpha1.RateLimitDescriptor{
@@ -238,6 +374,16 @@ func TestCreateRateLimitActions(t *testing.T) {
 	}
 }
 
+func TestResolveRateLimitServiceXRateLimitHeaders(t *testing.T) {
+	provider := &v1alpha1.RateLimitProvider{Domain: "test-domain"}
+	rl := resolveRateLimitService(&corev3.GrpcService{}, provider)
+	assert.Equal(t, ratev3.RateLimit_DRAFT_VERSION_03, rl.EnableXRatelimitHeaders)
+
+	provider.XRateLimitHeaders = v1alpha1.XRateLimitHeadersOff
+	rl = resolveRateLimitService(&corev3.GrpcService{}, provider)
+	assert.Equal(t, ratev3.RateLimit_OFF, rl.EnableXRatelimitHeaders)
+}
+
 func TestToRateLimitFilterConfig(t *testing.T) {
 	defaultExtensionName := "test-ratelimit"
 	defaultNamespace := "test-namespace"
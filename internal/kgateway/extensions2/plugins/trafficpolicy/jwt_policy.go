@@ -0,0 +1,300 @@
+package trafficpolicy
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	jwtauthnv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"istio.io/istio/pkg/kube/krt"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+)
+
+const (
+	jwtFilterName = "envoy.filters.http.jwt_authn"
+	jwtStatPrefix = "jwt_authn"
+	// jwksSecretKey is the key in a Secret that holds a local JWKS.
+	jwksSecretKey = "jwks"
+	// defaultJwksFetchTimeout is the timeout used when fetching a remote JWKS without a timeout set.
+	defaultJwksFetchTimeout = 5 * time.Second
+)
+
+type jwtIR struct {
+	// requirementName is the unique name of the requirement in the filter's requirement map.
+	requirementName string
+	// providers are keyed by their unique filter-level name.
+	providers   map[string]*jwtauthnv3.JwtProvider
+	requirement *jwtauthnv3.JwtRequirement
+	perRoute    *jwtauthnv3.PerRouteConfig
+}
+
+func (j *jwtIR) Equals(other *jwtIR) bool {
+	if j == nil && other == nil {
+		return true
+	}
+	if j == nil || other == nil {
+		return false
+	}
+
+	if j.requirementName != other.requirementName {
+		return false
+	}
+	if !maps.EqualFunc(j.providers, other.providers, func(a, b *jwtauthnv3.JwtProvider) bool {
+		return proto.Equal(a, b)
+	}) {
+		return false
+	}
+	if !proto.Equal(j.requirement, other.requirement) {
+		return false
+	}
+	return proto.Equal(j.perRoute, other.perRoute)
+}
+
+// Validate performs PGV validation on the generated jwt_authn configuration
+func (j *jwtIR) Validate() error {
+	if j == nil {
+		return nil
+	}
+	for _, provider := range j.providers {
+		if err := provider.Validate(); err != nil {
+			return err
+		}
+	}
+	if err := j.requirement.Validate(); err != nil {
+		return err
+	}
+	return j.perRoute.Validate()
+}
+
+// jwtForSpec translates the JWT spec into the Envoy jwt_authn configuration and stores it in the traffic policy IR
+func (b *TrafficPolicyBuilder) jwtForSpec(
+	krtctx krt.HandlerContext,
+	policyCR *v1alpha1.TrafficPolicy,
+	out *trafficPolicySpecIr,
+) error {
+	spec := policyCR.Spec.JWT
+	if spec == nil {
+		return nil
+	}
+
+	policySrc := ir.ObjectSource{
+		Group:     wellknown.TrafficPolicyGVK.Group,
+		Kind:      wellknown.TrafficPolicyGVK.Kind,
+		Namespace: policyCR.GetNamespace(),
+		Name:      policyCR.GetName(),
+	}
+	requirementName := jwtRequirementName(policyCR.GetNamespace(), policyCR.GetName())
+
+	var errs []error
+	providers := make(map[string]*jwtauthnv3.JwtProvider, len(spec.Providers))
+	providerNames := make([]string, 0, len(spec.Providers))
+	for _, p := range spec.Providers {
+		provider, err := b.translateJwtProvider(krtctx, policySrc, p)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("jwt provider %s: %w", p.Name, err))
+			continue
+		}
+		name := jwtProviderName(requirementName, p.Name)
+		providers[name] = provider
+		providerNames = append(providerNames, name)
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	out.jwt = &jwtIR{
+		requirementName: requirementName,
+		providers:       providers,
+		requirement:     translateJwtRequirement(spec.Mode, providerNames),
+		perRoute: &jwtauthnv3.PerRouteConfig{
+			RequirementSpecifier: &jwtauthnv3.PerRouteConfig_RequirementName{
+				RequirementName: requirementName,
+			},
+		},
+	}
+	return nil
+}
+
+func (b *TrafficPolicyBuilder) translateJwtProvider(
+	krtctx krt.HandlerContext,
+	policySrc ir.ObjectSource,
+	in v1alpha1.JWTProvider,
+) (*jwtauthnv3.JwtProvider, error) {
+	provider := &jwtauthnv3.JwtProvider{
+		Issuer:    in.Issuer,
+		Audiences: in.Audiences,
+		// Expose the validated payload so that other filters, e.g. RBAC or rate limiting,
+		// can make decisions based on the token claims.
+		PayloadInMetadata: in.Name,
+	}
+	if in.Forward != nil {
+		provider.Forward = *in.Forward
+	}
+	for _, claim := range in.ClaimsToHeaders {
+		provider.ClaimToHeaders = append(provider.ClaimToHeaders, &jwtauthnv3.JwtClaimToHeader{
+			HeaderName: string(claim.Header),
+			ClaimName:  claim.Name,
+		})
+	}
+
+	switch {
+	case in.JWKS.Remote != nil:
+		remote := in.JWKS.Remote
+		backend, err := b.commoncol.BackendIndex.GetBackendFromRef(krtctx, policySrc, remote.BackendRef.BackendObjectReference)
+		if err != nil {
+			return nil, err
+		}
+		if backend == nil {
+			return nil, errors.New("jwks backend not found")
+		}
+		provider.JwksSourceSpecifier = &jwtauthnv3.JwtProvider_RemoteJwks{
+			RemoteJwks: translateRemoteJwks(remote, backend.ClusterName()),
+		}
+
+	case in.JWKS.Local != nil:
+		jwks, err := b.localJwks(krtctx, policySrc.Namespace, in.JWKS.Local)
+		if err != nil {
+			return nil, err
+		}
+		provider.JwksSourceSpecifier = &jwtauthnv3.JwtProvider_LocalJwks{
+			LocalJwks: &envoy_core_v3.DataSource{
+				Specifier: &envoy_core_v3.DataSource_InlineString{
+					InlineString: jwks,
+				},
+			},
+		}
+
+	default:
+		// Shouldn't happen because we validate that exactly one JWKS source is set
+		return nil, errors.New("jwks source not specified")
+	}
+
+	return provider, nil
+}
+
+// translateRemoteJwks builds the fetch of a remote JWKS from the cluster of its backend.
+func translateRemoteJwks(remote *v1alpha1.RemoteJWKS, clusterName string) *jwtauthnv3.RemoteJwks {
+	timeout := defaultJwksFetchTimeout
+	if remote.Timeout != nil {
+		timeout = remote.Timeout.Duration
+	}
+	remoteJwks := &jwtauthnv3.RemoteJwks{
+		HttpUri: &envoy_core_v3.HttpUri{
+			Uri: remote.URL,
+			HttpUpstreamType: &envoy_core_v3.HttpUri_Cluster{
+				Cluster: clusterName,
+			},
+			Timeout: durationpb.New(timeout),
+		},
+	}
+	if remote.CacheDuration != nil {
+		remoteJwks.CacheDuration = durationpb.New(remote.CacheDuration.Duration)
+	}
+	return remoteJwks
+}
+
+func (b *TrafficPolicyBuilder) localJwks(krtctx krt.HandlerContext, ns string, local *v1alpha1.LocalJWKS) (string, error) {
+	if local.Inline != nil {
+		return *local.Inline, nil
+	}
+	if local.SecretRef == nil {
+		return "", errors.New("local jwks must set either inline or secretRef")
+	}
+	secret, err := pluginutils.GetSecretIr(b.commoncol.Secrets, krtctx, local.SecretRef.Name, ns)
+	if err != nil {
+		return "", err
+	}
+	jwks, ok := secret.Data[jwksSecretKey]
+	if !ok || len(jwks) == 0 {
+		return "", fmt.Errorf("secret %s/%s does not contain key %q", ns, local.SecretRef.Name, jwksSecretKey)
+	}
+	return string(jwks), nil
+}
+
+// translateJwtRequirement builds the requirement for the given providers based on the validation mode.
+func translateJwtRequirement(mode v1alpha1.JWTValidationMode, providerNames []string) *jwtauthnv3.JwtRequirement {
+	providerNames = slices.Clone(providerNames)
+	slices.Sort(providerNames)
+
+	requirements := make([]*jwtauthnv3.JwtRequirement, 0, len(providerNames)+1)
+	for _, name := range providerNames {
+		requirements = append(requirements, &jwtauthnv3.JwtRequirement{
+			RequiresType: &jwtauthnv3.JwtRequirement_ProviderName{ProviderName: name},
+		})
+	}
+
+	switch mode {
+	case v1alpha1.JWTValidationModeRequireAll:
+		if len(requirements) == 1 {
+			return requirements[0]
+		}
+		return &jwtauthnv3.JwtRequirement{
+			RequiresType: &jwtauthnv3.JwtRequirement_RequiresAll{
+				RequiresAll: &jwtauthnv3.JwtRequirementAndList{Requirements: requirements},
+			},
+		}
+	case v1alpha1.JWTValidationModeAllowMissing:
+		requirements = append(requirements, &jwtauthnv3.JwtRequirement{
+			RequiresType: &jwtauthnv3.JwtRequirement_AllowMissing{AllowMissing: &emptypb.Empty{}},
+		})
+	case v1alpha1.JWTValidationModeAllowMissingOrFailed:
+		requirements = append(requirements, &jwtauthnv3.JwtRequirement{
+			RequiresType: &jwtauthnv3.JwtRequirement_AllowMissingOrFailed{AllowMissingOrFailed: &emptypb.Empty{}},
+		})
+	}
+
+	if len(requirements) == 1 {
+		return requirements[0]
+	}
+	return &jwtauthnv3.JwtRequirement{
+		RequiresType: &jwtauthnv3.JwtRequirement_RequiresAny{
+			RequiresAny: &jwtauthnv3.JwtRequirementOrList{Requirements: requirements},
+		},
+	}
+}
+
+// jwtRequirementName returns the name of the requirement generated for the policy.
+func jwtRequirementName(namespace, name string) string {
+	return fmt.Sprintf("%s/%s", namespace, name)
+}
+
+// jwtProviderName returns the name of a provider that is unique across all policies in a filter chain.
+func jwtProviderName(requirementName, providerName string) string {
+	return fmt.Sprintf("%s/%s", requirementName, providerName)
+}
+
+func (p *trafficPolicyPluginGwPass) handleJwt(fcn string, pCtxTypedFilterConfig *ir.TypedFilterConfigMap, jwt *jwtIR) {
+	if jwt == nil {
+		return
+	}
+
+	// Select the requirement of this policy on the route.
+	pCtxTypedFilterConfig.AddTypedConfig(jwtFilterName, jwt.perRoute)
+
+	// Add the providers and the requirement to the filter in the chain. The filter is disabled
+	// by default and only enabled on routes that have a per-route config.
+	if p.jwtInChain == nil {
+		p.jwtInChain = make(map[string]*jwtauthnv3.JwtAuthentication)
+	}
+	filter, ok := p.jwtInChain[fcn]
+	if !ok {
+		filter = &jwtauthnv3.JwtAuthentication{
+			Providers:      map[string]*jwtauthnv3.JwtProvider{},
+			RequirementMap: map[string]*jwtauthnv3.JwtRequirement{},
+			StatPrefix:     jwtStatPrefix,
+		}
+		p.jwtInChain[fcn] = filter
+	}
+	maps.Copy(filter.GetProviders(), jwt.providers)
+	filter.GetRequirementMap()[jwt.requirementName] = jwt.requirement
+}
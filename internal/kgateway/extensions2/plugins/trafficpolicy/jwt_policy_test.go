@@ -0,0 +1,196 @@
+package trafficpolicy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	jwtauthnv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+const testJwks = `{"keys":[{"kty":"oct","alg":"HS256","k":"c2VjcmV0"}]}`
+
+func TestJwtForSpec(t *testing.T) {
+	t.Run("translates providers with inline jwks", func(t *testing.T) {
+		policy := &v1alpha1.TrafficPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "jwt", Namespace: "default"},
+			Spec: v1alpha1.TrafficPolicySpec{
+				JWT: &v1alpha1.JWTAuthentication{
+					Providers: []v1alpha1.JWTProvider{
+						{
+							Name:      "example",
+							Issuer:    "https://issuer.example.com",
+							Audiences: []string{"api"},
+							JWKS: v1alpha1.JWKS{
+								Local: &v1alpha1.LocalJWKS{Inline: ptr.To(testJwks)},
+							},
+							ClaimsToHeaders: []v1alpha1.JWTClaimToHeader{
+								{Name: "sub", Header: "x-sub"},
+							},
+							Forward: ptr.To(true),
+						},
+					},
+				},
+			},
+		}
+		out := &trafficPolicySpecIr{}
+
+		err := (&TrafficPolicyBuilder{}).jwtForSpec(nil, policy, out)
+
+		require.NoError(t, err)
+		require.NotNil(t, out.jwt)
+		assert.Equal(t, "default/jwt", out.jwt.requirementName)
+		provider := out.jwt.providers["default/jwt/example"]
+		require.NotNil(t, provider)
+		assert.Equal(t, "https://issuer.example.com", provider.GetIssuer())
+		assert.Equal(t, []string{"api"}, provider.GetAudiences())
+		assert.True(t, provider.GetForward())
+		assert.Equal(t, "example", provider.GetPayloadInMetadata())
+		assert.Equal(t, testJwks, provider.GetLocalJwks().GetInlineString())
+		require.Len(t, provider.GetClaimToHeaders(), 1)
+		assert.Equal(t, "x-sub", provider.GetClaimToHeaders()[0].GetHeaderName())
+		assert.Equal(t, "default/jwt/example", out.jwt.requirement.GetProviderName())
+		assert.Equal(t, "default/jwt", out.jwt.perRoute.GetRequirementName())
+		assert.NoError(t, out.jwt.Validate())
+	})
+}
+
+func TestTranslateRemoteJwks(t *testing.T) {
+	remote := &v1alpha1.RemoteJWKS{
+		URL:           "https://issuer.example.com/jwks",
+		CacheDuration: &metav1.Duration{Duration: time.Minute},
+	}
+
+	out := translateRemoteJwks(remote, "jwks-cluster")
+	assert.Equal(t, "https://issuer.example.com/jwks", out.GetHttpUri().GetUri())
+	assert.Equal(t, "jwks-cluster", out.GetHttpUri().GetCluster())
+	assert.Equal(t, defaultJwksFetchTimeout, out.GetHttpUri().GetTimeout().AsDuration())
+	assert.Equal(t, time.Minute, out.GetCacheDuration().AsDuration())
+
+	remote.Timeout = &metav1.Duration{Duration: 30 * time.Second}
+	out = translateRemoteJwks(remote, "jwks-cluster")
+	assert.Equal(t, 30*time.Second, out.GetHttpUri().GetTimeout().AsDuration())
+}
+
+func TestTranslateJwtRequirement(t *testing.T) {
+	providerName := func(name string) *jwtauthnv3.JwtRequirement {
+		return &jwtauthnv3.JwtRequirement{
+			RequiresType: &jwtauthnv3.JwtRequirement_ProviderName{ProviderName: name},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		mode      v1alpha1.JWTValidationMode
+		providers []string
+		validate  func(t *testing.T, req *jwtauthnv3.JwtRequirement)
+	}{
+		{
+			name:      "single provider requires the provider",
+			mode:      v1alpha1.JWTValidationModeRequireAny,
+			providers: []string{"a"},
+			validate: func(t *testing.T, req *jwtauthnv3.JwtRequirement) {
+				assert.Equal(t, "a", req.GetProviderName())
+			},
+		},
+		{
+			name:      "require any of multiple providers",
+			mode:      v1alpha1.JWTValidationModeRequireAny,
+			providers: []string{"b", "a"},
+			validate: func(t *testing.T, req *jwtauthnv3.JwtRequirement) {
+				require.NotNil(t, req.GetRequiresAny())
+				assert.Equal(t, []*jwtauthnv3.JwtRequirement{providerName("a"), providerName("b")}, req.GetRequiresAny().GetRequirements())
+			},
+		},
+		{
+			name:      "require all providers",
+			mode:      v1alpha1.JWTValidationModeRequireAll,
+			providers: []string{"a", "b"},
+			validate: func(t *testing.T, req *jwtauthnv3.JwtRequirement) {
+				require.NotNil(t, req.GetRequiresAll())
+				assert.Len(t, req.GetRequiresAll().GetRequirements(), 2)
+			},
+		},
+		{
+			name:      "allow missing",
+			mode:      v1alpha1.JWTValidationModeAllowMissing,
+			providers: []string{"a"},
+			validate: func(t *testing.T, req *jwtauthnv3.JwtRequirement) {
+				reqs := req.GetRequiresAny().GetRequirements()
+				require.Len(t, reqs, 2)
+				assert.Equal(t, "a", reqs[0].GetProviderName())
+				assert.NotNil(t, reqs[1].GetAllowMissing())
+			},
+		},
+		{
+			name:      "allow missing or failed",
+			mode:      v1alpha1.JWTValidationModeAllowMissingOrFailed,
+			providers: []string{"a"},
+			validate: func(t *testing.T, req *jwtauthnv3.JwtRequirement) {
+				reqs := req.GetRequiresAny().GetRequirements()
+				require.Len(t, reqs, 2)
+				assert.NotNil(t, reqs[1].GetAllowMissingOrFailed())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := translateJwtRequirement(tt.mode, tt.providers)
+			require.NoError(t, req.Validate())
+			tt.validate(t, req)
+		})
+	}
+}
+
+func TestJwtFilterInChain(t *testing.T) {
+	newPolicy := func(name string) *TrafficPolicy {
+		requirementName := jwtRequirementName("default", name)
+		providerName := jwtProviderName(requirementName, "example")
+		return &TrafficPolicy{spec: trafficPolicySpecIr{
+			jwt: &jwtIR{
+				requirementName: requirementName,
+				providers: map[string]*jwtauthnv3.JwtProvider{
+					providerName: {Issuer: name},
+				},
+				requirement: translateJwtRequirement(v1alpha1.JWTValidationModeRequireAny, []string{providerName}),
+				perRoute: &jwtauthnv3.PerRouteConfig{
+					RequirementSpecifier: &jwtauthnv3.PerRouteConfig_RequirementName{RequirementName: requirementName},
+				},
+			},
+		}}
+	}
+
+	ctx := context.Background()
+	plugin := &trafficPolicyPluginGwPass{}
+	for _, name := range []string{"policy-a", "policy-b"} {
+		pCtx := &ir.RouteContext{
+			FilterChainName: "fc",
+			Policy:          newPolicy(name),
+		}
+		require.NoError(t, plugin.ApplyForRoute(ctx, pCtx, &envoy_config_route_v3.Route{}))
+		perRoute, ok := pCtx.TypedFilterConfig[jwtFilterName].(*jwtauthnv3.PerRouteConfig)
+		require.True(t, ok)
+		assert.Equal(t, "default/"+name, perRoute.GetRequirementName())
+	}
+
+	filters, err := plugin.HttpFilters(ctx, ir.FilterChainCommon{FilterChainName: "fc"})
+	require.NoError(t, err)
+	require.Len(t, filters, 1)
+	assert.Equal(t, jwtFilterName, filters[0].Filter.GetName())
+	assert.True(t, filters[0].Filter.GetDisabled())
+
+	jwtFilter := plugin.jwtInChain["fc"]
+	require.NotNil(t, jwtFilter)
+	assert.Len(t, jwtFilter.GetProviders(), 2)
+	assert.Contains(t, jwtFilter.GetRequirementMap(), "default/policy-a")
+	assert.Contains(t, jwtFilter.GetRequirementMap(), "default/policy-b")
+}
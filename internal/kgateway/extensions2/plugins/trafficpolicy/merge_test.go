@@ -5,11 +5,16 @@ import (
 	"testing"
 	"time"
 
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	faultv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	rbacfilterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/policy"
 )
 
 func TestMergePoliciesPreservesErrors(t *testing.T) {
@@ -36,3 +41,121 @@ func TestMergePoliciesPreservesErrors(t *testing.T) {
 	assert.Contains(t, merged.Errors, err1)
 	assert.Contains(t, merged.Errors, err2)
 }
+
+func TestMergeTrafficPolicies(t *testing.T) {
+	p2Ref := &ir.AttachedPolicyRef{Name: "p2"}
+
+	tests := []struct {
+		name   string
+		origin string
+		p1, p2 trafficPolicySpecIr
+		get    func(spec trafficPolicySpecIr) any
+	}{
+		{
+			name:   "jwt",
+			origin: "jwt",
+			p1:     trafficPolicySpecIr{jwt: &jwtIR{requirementName: "default/p1"}},
+			p2:     trafficPolicySpecIr{jwt: &jwtIR{requirementName: "default/p2"}},
+			get:    func(spec trafficPolicySpecIr) any { return spec.jwt },
+		},
+		{
+			name:   "rbac",
+			origin: "authorization",
+			p1:     trafficPolicySpecIr{rbac: &rbacIR{rbacPerRoute: &rbacfilterv3.RBACPerRoute{}}},
+			p2:     trafficPolicySpecIr{rbac: &rbacIR{rbacPerRoute: &rbacfilterv3.RBACPerRoute{}}},
+			get:    func(spec trafficPolicySpecIr) any { return spec.rbac },
+		},
+		{
+			name:   "fault",
+			origin: "faultInjection",
+			p1:     trafficPolicySpecIr{fault: &faultIR{faultPerRoute: &faultv3.HTTPFault{}}},
+			p2:     trafficPolicySpecIr{fault: &faultIR{faultPerRoute: &faultv3.HTTPFault{}}},
+			get:    func(spec trafficPolicySpecIr) any { return spec.fault },
+		},
+		{
+			name:   "timeouts",
+			origin: "timeouts",
+			p1:     trafficPolicySpecIr{timeouts: &timeoutsIR{request: durationpb.New(time.Second)}},
+			p2:     trafficPolicySpecIr{timeouts: &timeoutsIR{request: durationpb.New(time.Minute)}},
+			get:    func(spec trafficPolicySpecIr) any { return spec.timeouts },
+		},
+		{
+			name:   "retry",
+			origin: "retry",
+			p1:     trafficPolicySpecIr{retry: &retryIR{retryPolicy: &routev3.RetryPolicy{RetryOn: "5xx"}}},
+			p2:     trafficPolicySpecIr{retry: &retryIR{retryPolicy: &routev3.RetryPolicy{RetryOn: "reset"}}},
+			get:    func(spec trafficPolicySpecIr) any { return spec.retry },
+		},
+		{
+			name:   "compression",
+			origin: "compression",
+			p1:     trafficPolicySpecIr{compression: &compressionIR{responseCompression: &routev3.FilterConfig{}}},
+			p2:     trafficPolicySpecIr{compression: &compressionIR{requestDecompression: &routev3.FilterConfig{}}},
+			get:    func(spec trafficPolicySpecIr) any { return spec.compression },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+" is overridden", func(t *testing.T) {
+			p1 := &TrafficPolicy{spec: tt.p1}
+			origins := MergeTrafficPolicies(p1, &TrafficPolicy{spec: tt.p2}, p2Ref, policy.MergeOptions{Strategy: policy.OverridableMerge})
+			assert.Same(t, tt.get(tt.p2), tt.get(p1.spec))
+			assert.Equal(t, p2Ref, origins[tt.origin])
+		})
+		t.Run(tt.name+" is kept by an augmented merge", func(t *testing.T) {
+			p1 := &TrafficPolicy{spec: tt.p1}
+			origins := MergeTrafficPolicies(p1, &TrafficPolicy{spec: tt.p2}, p2Ref, policy.MergeOptions{Strategy: policy.AugmentedMerge})
+			assert.Same(t, tt.get(tt.p1), tt.get(p1.spec))
+			assert.NotContains(t, origins, tt.origin)
+		})
+		t.Run(tt.name+" is added by an augmented merge", func(t *testing.T) {
+			p1 := &TrafficPolicy{}
+			origins := MergeTrafficPolicies(p1, &TrafficPolicy{spec: tt.p2}, p2Ref, policy.MergeOptions{Strategy: policy.AugmentedMerge})
+			assert.Same(t, tt.get(tt.p2), tt.get(p1.spec))
+			assert.Equal(t, p2Ref, origins[tt.origin])
+		})
+	}
+}
+
+func TestMergePoliciesCombinesFields(t *testing.T) {
+	gk := schema.GroupKind{Group: "test", Kind: "TrafficPolicy"}
+	routeRef := &ir.AttachedPolicyRef{Name: "route"}
+	gatewayRef := &ir.AttachedPolicyRef{Name: "gateway"}
+
+	routeJwt := &jwtIR{requirementName: "default/route"}
+	gatewayBuffer := &BufferIR{maxRequestBytes: 1024}
+	gatewayRetry := &retryIR{retryPolicy: &routev3.RetryPolicy{RetryOn: "5xx"}}
+	gatewayCompression := &compressionIR{responseCompression: &routev3.FilterConfig{}}
+
+	// the policies are ordered by priority, the route policy wins over the gateway policy
+	merged := mergePolicies([]ir.PolicyAtt{
+		{
+			GroupKind: gk,
+			PolicyRef: routeRef,
+			PolicyIr:  &TrafficPolicy{spec: trafficPolicySpecIr{jwt: routeJwt}},
+		},
+		{
+			GroupKind: gk,
+			PolicyRef: gatewayRef,
+			PolicyIr: &TrafficPolicy{spec: trafficPolicySpecIr{
+				jwt:         &jwtIR{requirementName: "default/gateway"},
+				buffer:      gatewayBuffer,
+				retry:       gatewayRetry,
+				compression: gatewayCompression,
+			}},
+		},
+	})
+
+	spec := merged.PolicyIr.(*TrafficPolicy).spec
+	assert.Same(t, routeJwt, spec.jwt)
+	assert.Same(t, gatewayBuffer, spec.buffer)
+	assert.Same(t, gatewayRetry, spec.retry)
+	assert.Same(t, gatewayCompression, spec.compression)
+	assert.Nil(t, spec.cors)
+	assert.Equal(t, map[string]*ir.AttachedPolicyRef{
+		"jwt":         routeRef,
+		"buffer":      gatewayRef,
+		"retry":       gatewayRef,
+		"compression": gatewayRef,
+	}, merged.MergeOrigins)
+}
@@ -0,0 +1,373 @@
+package trafficpolicy
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+	"sync"
+
+	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	rbacv3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	rbacfilterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	envoy_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/google/cel-go/cel"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/regexutils"
+)
+
+const (
+	rbacFilterName = "envoy.filters.http.rbac"
+	// jwtPayloadMetadataNamespace is the dynamic metadata namespace the jwt_authn filter
+	// writes validated token payloads to.
+	jwtPayloadMetadataNamespace = jwtFilterName
+)
+
+type rbacIR struct {
+	rbacPerRoute *rbacfilterv3.RBACPerRoute
+}
+
+func (r *rbacIR) Equals(other *rbacIR) bool {
+	if r == nil && other == nil {
+		return true
+	}
+	if r == nil || other == nil {
+		return false
+	}
+
+	return proto.Equal(r.rbacPerRoute, other.rbacPerRoute)
+}
+
+// Validate performs PGV validation and checks that all regular expressions
+// in the generated RBAC configuration are valid RE2 expressions.
+func (r *rbacIR) Validate() error {
+	if r == nil {
+		return nil
+	}
+	if err := r.rbacPerRoute.Validate(); err != nil {
+		return err
+	}
+	return validateRegexes(r.rbacPerRoute)
+}
+
+// rbacForSpec translates the authorization spec into an envoy RBAC policy and stores it in the traffic policy IR
+func rbacForSpec(spec v1alpha1.TrafficPolicySpec, out *trafficPolicySpecIr) error {
+	if spec.Authorization == nil {
+		return nil
+	}
+
+	action := rbacv3.RBAC_ALLOW
+	if spec.Authorization.Action == v1alpha1.AuthorizationActionDeny {
+		action = rbacv3.RBAC_DENY
+	}
+
+	var errs []error
+	policies := make(map[string]*rbacv3.Policy, len(spec.Authorization.Rules))
+	for i, rule := range spec.Authorization.Rules {
+		policy, err := translateAuthorizationRule(rule)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("authorization rule %d: %w", i, err))
+			continue
+		}
+		policies[fmt.Sprintf("rule-%d", i)] = policy
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	out.rbac = &rbacIR{
+		rbacPerRoute: &rbacfilterv3.RBACPerRoute{
+			Rbac: &rbacfilterv3.RBAC{
+				Rules: &rbacv3.RBAC{
+					Action:   action,
+					Policies: policies,
+				},
+			},
+		},
+	}
+	return nil
+}
+
+// rbacCelEnv declares the attributes envoy provides to the conditions of RBAC policies, see
+// https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/advanced/attributes
+var rbacCelEnv = sync.OnceValues(func() (*cel.Env, error) {
+	attributes := cel.MapType(cel.StringType, cel.DynType)
+	return cel.NewEnv(
+		cel.Variable("request", attributes),
+		cel.Variable("response", attributes),
+		cel.Variable("connection", attributes),
+		cel.Variable("upstream", attributes),
+		cel.Variable("source", attributes),
+		cel.Variable("destination", attributes),
+		cel.Variable("xds", attributes),
+		cel.Variable("metadata", cel.DynType),
+		cel.Variable("filter_state", cel.MapType(cel.StringType, cel.BytesType)),
+	)
+})
+
+func translateAuthorizationRule(rule v1alpha1.AuthorizationRule) (*rbacv3.Policy, error) {
+	policy := &rbacv3.Policy{}
+
+	for _, principal := range rule.Principals {
+		p, err := translateAuthorizationPrincipal(principal)
+		if err != nil {
+			return nil, err
+		}
+		policy.Principals = append(policy.Principals, p)
+	}
+	if len(policy.GetPrincipals()) == 0 {
+		policy.Principals = []*rbacv3.Principal{{Identifier: &rbacv3.Principal_Any{Any: true}}}
+	}
+
+	for _, permission := range rule.Permissions {
+		policy.Permissions = append(policy.Permissions, translateAuthorizationPermission(permission))
+	}
+	if len(policy.GetPermissions()) == 0 {
+		policy.Permissions = []*rbacv3.Permission{{Rule: &rbacv3.Permission_Any{Any: true}}}
+	}
+
+	if rule.Condition != nil {
+		env, err := rbacCelEnv()
+		if err != nil {
+			return nil, err
+		}
+		ast, issues := env.Parse(*rule.Condition)
+		if issues != nil && issues.Err() != nil {
+			return nil, fmt.Errorf("invalid condition: %w", issues.Err())
+		}
+		checked, issues := env.Check(ast)
+		if issues != nil && issues.Err() != nil {
+			return nil, fmt.Errorf("invalid condition: %w", issues.Err())
+		}
+		if t := checked.OutputType(); !t.IsExactType(cel.BoolType) && !t.IsExactType(cel.DynType) {
+			return nil, fmt.Errorf("invalid condition: must evaluate to a bool, not %s", t)
+		}
+		parsed, err := cel.AstToParsedExpr(ast)
+		if err != nil {
+			return nil, fmt.Errorf("invalid condition: %w", err)
+		}
+		policy.Condition = parsed.GetExpr()
+	}
+
+	return policy, nil
+}
+
+func translateAuthorizationPrincipal(in v1alpha1.AuthorizationPrincipal) (*rbacv3.Principal, error) {
+	var ids []*rbacv3.Principal
+
+	if len(in.SourceCIDRs) > 0 {
+		var cidrs []*rbacv3.Principal
+		for _, cidr := range in.SourceCIDRs {
+			prefix, err := netip.ParsePrefix(cidr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid source CIDR %q: %w", cidr, err)
+			}
+			cidrs = append(cidrs, &rbacv3.Principal{
+				Identifier: &rbacv3.Principal_RemoteIp{
+					RemoteIp: &envoy_core_v3.CidrRange{
+						AddressPrefix: prefix.Addr().String(),
+						PrefixLen:     wrapperspb.UInt32(uint32(prefix.Bits())),
+					},
+				},
+			})
+		}
+		ids = append(ids, orPrincipals(cidrs))
+	}
+
+	for _, header := range in.Headers {
+		ids = append(ids, &rbacv3.Principal{
+			Identifier: &rbacv3.Principal_Header{Header: toEnvoyHeaderMatcher(header)},
+		})
+	}
+
+	for _, claim := range in.JWTClaims {
+		ids = append(ids, jwtClaimPrincipal(claim))
+	}
+
+	switch len(ids) {
+	case 0:
+		return &rbacv3.Principal{Identifier: &rbacv3.Principal_Any{Any: true}}, nil
+	case 1:
+		return ids[0], nil
+	default:
+		return &rbacv3.Principal{
+			Identifier: &rbacv3.Principal_AndIds{AndIds: &rbacv3.Principal_Set{Ids: ids}},
+		}, nil
+	}
+}
+
+// jwtClaimPrincipal matches a claim in the payload written to dynamic metadata by the jwt_authn filter.
+// The claim matches when it is a string equal to one of the values or a list containing one of them.
+func jwtClaimPrincipal(claim v1alpha1.JWTClaimMatch) *rbacv3.Principal {
+	path := []*envoy_matcher_v3.MetadataMatcher_PathSegment{{
+		Segment: &envoy_matcher_v3.MetadataMatcher_PathSegment_Key{Key: claim.Provider},
+	}}
+	for _, segment := range strings.Split(claim.Name, ".") {
+		path = append(path, &envoy_matcher_v3.MetadataMatcher_PathSegment{
+			Segment: &envoy_matcher_v3.MetadataMatcher_PathSegment_Key{Key: segment},
+		})
+	}
+
+	var ids []*rbacv3.Principal
+	for _, value := range claim.Values {
+		stringMatch := &envoy_matcher_v3.ValueMatcher{
+			MatchPattern: &envoy_matcher_v3.ValueMatcher_StringMatch{
+				StringMatch: &envoy_matcher_v3.StringMatcher{
+					MatchPattern: &envoy_matcher_v3.StringMatcher_Exact{Exact: value},
+				},
+			},
+		}
+		listMatch := &envoy_matcher_v3.ValueMatcher{
+			MatchPattern: &envoy_matcher_v3.ValueMatcher_ListMatch{
+				ListMatch: &envoy_matcher_v3.ListMatcher{
+					MatchPattern: &envoy_matcher_v3.ListMatcher_OneOf{OneOf: stringMatch},
+				},
+			},
+		}
+		for _, vm := range []*envoy_matcher_v3.ValueMatcher{stringMatch, listMatch} {
+			ids = append(ids, &rbacv3.Principal{
+				Identifier: &rbacv3.Principal_Metadata{
+					Metadata: &envoy_matcher_v3.MetadataMatcher{
+						Filter: jwtPayloadMetadataNamespace,
+						Path:   path,
+						Value:  vm,
+					},
+				},
+			})
+		}
+	}
+	return orPrincipals(ids)
+}
+
+func translateAuthorizationPermission(in v1alpha1.AuthorizationPermission) *rbacv3.Permission {
+	var rules []*rbacv3.Permission
+
+	if len(in.Paths) > 0 {
+		var paths []*rbacv3.Permission
+		for _, path := range in.Paths {
+			paths = append(paths, &rbacv3.Permission{
+				Rule: &rbacv3.Permission_UrlPath{
+					UrlPath: &envoy_matcher_v3.PathMatcher{
+						Rule: &envoy_matcher_v3.PathMatcher_Path{Path: toPathStringMatcher(path)},
+					},
+				},
+			})
+		}
+		rules = append(rules, orPermissions(paths))
+	}
+
+	if len(in.Methods) > 0 {
+		var methods []*rbacv3.Permission
+		for _, method := range in.Methods {
+			methods = append(methods, &rbacv3.Permission{
+				Rule: &rbacv3.Permission_Header{
+					Header: &routev3.HeaderMatcher{
+						Name: ":method",
+						HeaderMatchSpecifier: &routev3.HeaderMatcher_StringMatch{
+							StringMatch: &envoy_matcher_v3.StringMatcher{
+								MatchPattern: &envoy_matcher_v3.StringMatcher_Exact{Exact: string(method)},
+							},
+						},
+					},
+				},
+			})
+		}
+		rules = append(rules, orPermissions(methods))
+	}
+
+	switch len(rules) {
+	case 0:
+		return &rbacv3.Permission{Rule: &rbacv3.Permission_Any{Any: true}}
+	case 1:
+		return rules[0]
+	default:
+		return &rbacv3.Permission{
+			Rule: &rbacv3.Permission_AndRules{AndRules: &rbacv3.Permission_Set{Rules: rules}},
+		}
+	}
+}
+
+func toEnvoyHeaderMatcher(in gwv1.HTTPHeaderMatch) *routev3.HeaderMatcher {
+	matcher := &envoy_matcher_v3.StringMatcher{
+		MatchPattern: &envoy_matcher_v3.StringMatcher_Exact{Exact: in.Value},
+	}
+	if in.Type != nil && *in.Type == gwv1.HeaderMatchRegularExpression {
+		matcher.MatchPattern = &envoy_matcher_v3.StringMatcher_SafeRegex{
+			SafeRegex: regexutils.NewRegexWithProgramSize(in.Value, nil),
+		}
+	}
+	return &routev3.HeaderMatcher{
+		Name:                 string(in.Name),
+		HeaderMatchSpecifier: &routev3.HeaderMatcher_StringMatch{StringMatch: matcher},
+	}
+}
+
+func toPathStringMatcher(in gwv1.HTTPPathMatch) *envoy_matcher_v3.StringMatcher {
+	value := "/"
+	if in.Value != nil {
+		value = *in.Value
+	}
+	matchType := gwv1.PathMatchPathPrefix
+	if in.Type != nil {
+		matchType = *in.Type
+	}
+
+	switch matchType {
+	case gwv1.PathMatchExact:
+		return &envoy_matcher_v3.StringMatcher{
+			MatchPattern: &envoy_matcher_v3.StringMatcher_Exact{Exact: value},
+		}
+	case gwv1.PathMatchRegularExpression:
+		return &envoy_matcher_v3.StringMatcher{
+			MatchPattern: &envoy_matcher_v3.StringMatcher_SafeRegex{
+				SafeRegex: regexutils.NewRegexWithProgramSize(value, nil),
+			},
+		}
+	default:
+		return &envoy_matcher_v3.StringMatcher{
+			MatchPattern: &envoy_matcher_v3.StringMatcher_Prefix{Prefix: value},
+		}
+	}
+}
+
+func orPrincipals(ids []*rbacv3.Principal) *rbacv3.Principal {
+	if len(ids) == 1 {
+		return ids[0]
+	}
+	return &rbacv3.Principal{
+		Identifier: &rbacv3.Principal_OrIds{OrIds: &rbacv3.Principal_Set{Ids: ids}},
+	}
+}
+
+func orPermissions(rules []*rbacv3.Permission) *rbacv3.Permission {
+	if len(rules) == 1 {
+		return rules[0]
+	}
+	return &rbacv3.Permission{
+		Rule: &rbacv3.Permission_OrRules{OrRules: &rbacv3.Permission_Set{Rules: rules}},
+	}
+}
+
+func (p *trafficPolicyPluginGwPass) handleRbac(fcn string, pCtxTypedFilterConfig *ir.TypedFilterConfigMap, rbac *rbacIR) {
+	if rbac == nil {
+		return
+	}
+
+	// Add rbac configuration to the typed_per_filter_config for route-level override
+	pCtxTypedFilterConfig.AddTypedConfig(rbacFilterName, rbac.rbacPerRoute)
+
+	// Add a filter to the chain. When having a rbac policy for a route we need to also have a
+	// globally disabled rbac filter in the chain otherwise it will be ignored.
+	if p.rbacInChain == nil {
+		p.rbacInChain = make(map[string]*rbacfilterv3.RBAC)
+	}
+	if _, ok := p.rbacInChain[fcn]; !ok {
+		p.rbacInChain[fcn] = &rbacfilterv3.RBAC{}
+	}
+}
@@ -0,0 +1,186 @@
+package trafficpolicy
+
+import (
+	"testing"
+
+	rbacv3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+func TestRbacForSpec(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     *v1alpha1.Authorization
+		wantErr  string
+		validate func(t *testing.T, rules *rbacv3.RBAC)
+	}{
+		{
+			name: "allow by source cidr and method",
+			spec: &v1alpha1.Authorization{
+				Rules: []v1alpha1.AuthorizationRule{{
+					Principals: []v1alpha1.AuthorizationPrincipal{{
+						SourceCIDRs: []string{"10.0.0.0/8", "192.168.1.1/32"},
+					}},
+					Permissions: []v1alpha1.AuthorizationPermission{{
+						Methods: []gwv1.HTTPMethod{gwv1.HTTPMethodGet},
+						Paths: []gwv1.HTTPPathMatch{{
+							Type:  ptr.To(gwv1.PathMatchPathPrefix),
+							Value: ptr.To("/api"),
+						}},
+					}},
+				}},
+			},
+			validate: func(t *testing.T, rules *rbacv3.RBAC) {
+				assert.Equal(t, rbacv3.RBAC_ALLOW, rules.GetAction())
+				policy := rules.GetPolicies()["rule-0"]
+				require.NotNil(t, policy)
+
+				require.Len(t, policy.GetPrincipals(), 1)
+				cidrs := policy.GetPrincipals()[0].GetOrIds().GetIds()
+				require.Len(t, cidrs, 2)
+				assert.Equal(t, "10.0.0.0", cidrs[0].GetRemoteIp().GetAddressPrefix())
+				assert.Equal(t, uint32(8), cidrs[0].GetRemoteIp().GetPrefixLen().GetValue())
+
+				require.Len(t, policy.GetPermissions(), 1)
+				and := policy.GetPermissions()[0].GetAndRules().GetRules()
+				require.Len(t, and, 2)
+				assert.Equal(t, "/api", and[0].GetUrlPath().GetPath().GetPrefix())
+				assert.Equal(t, ":method", and[1].GetHeader().GetName())
+				assert.Equal(t, "GET", and[1].GetHeader().GetStringMatch().GetExact())
+			},
+		},
+		{
+			name: "deny by jwt claim and header",
+			spec: &v1alpha1.Authorization{
+				Action: v1alpha1.AuthorizationActionDeny,
+				Rules: []v1alpha1.AuthorizationRule{{
+					Principals: []v1alpha1.AuthorizationPrincipal{{
+						Headers: []gwv1.HTTPHeaderMatch{{
+							Name:  "x-tenant",
+							Value: "blocked",
+						}},
+						JWTClaims: []v1alpha1.JWTClaimMatch{{
+							Provider: "example",
+							Name:     "realm.roles",
+							Values:   []string{"guest"},
+						}},
+					}},
+				}},
+			},
+			validate: func(t *testing.T, rules *rbacv3.RBAC) {
+				assert.Equal(t, rbacv3.RBAC_DENY, rules.GetAction())
+				policy := rules.GetPolicies()["rule-0"]
+				require.NotNil(t, policy)
+				assert.True(t, policy.GetPermissions()[0].GetAny())
+
+				ids := policy.GetPrincipals()[0].GetAndIds().GetIds()
+				require.Len(t, ids, 2)
+				assert.Equal(t, "x-tenant", ids[0].GetHeader().GetName())
+
+				claims := ids[1].GetOrIds().GetIds()
+				require.Len(t, claims, 2)
+				md := claims[0].GetMetadata()
+				assert.Equal(t, jwtFilterName, md.GetFilter())
+				require.Len(t, md.GetPath(), 3)
+				assert.Equal(t, "example", md.GetPath()[0].GetKey())
+				assert.Equal(t, "roles", md.GetPath()[2].GetKey())
+				assert.Equal(t, "guest", md.GetValue().GetStringMatch().GetExact())
+				assert.Equal(t, "guest", claims[1].GetMetadata().GetValue().GetListMatch().GetOneOf().GetStringMatch().GetExact())
+			},
+		},
+		{
+			name: "condition is parsed",
+			spec: &v1alpha1.Authorization{
+				Rules: []v1alpha1.AuthorizationRule{{
+					Condition: ptr.To(`request.headers["x-env"] == "prod"`),
+				}},
+			},
+			validate: func(t *testing.T, rules *rbacv3.RBAC) {
+				policy := rules.GetPolicies()["rule-0"]
+				require.NotNil(t, policy)
+				assert.NotNil(t, policy.GetCondition().GetCallExpr())
+				assert.True(t, policy.GetPrincipals()[0].GetAny())
+			},
+		},
+		{
+			name: "invalid condition",
+			spec: &v1alpha1.Authorization{
+				Rules: []v1alpha1.AuthorizationRule{{
+					Condition: ptr.To(`request.headers[`),
+				}},
+			},
+			wantErr: "authorization rule 0: invalid condition",
+		},
+		{
+			name: "condition with an unknown attribute",
+			spec: &v1alpha1.Authorization{
+				Rules: []v1alpha1.AuthorizationRule{{
+					Condition: ptr.To(`req.headers["x-env"] == "prod"`),
+				}},
+			},
+			wantErr: "undeclared reference to 'req'",
+		},
+		{
+			name: "condition that is not a bool",
+			spec: &v1alpha1.Authorization{
+				Rules: []v1alpha1.AuthorizationRule{{
+					Condition: ptr.To(`size(request.path) + 1`),
+				}},
+			},
+			wantErr: "authorization rule 0: invalid condition: must evaluate to a bool, not int",
+		},
+		{
+			name: "invalid cidr",
+			spec: &v1alpha1.Authorization{
+				Rules: []v1alpha1.AuthorizationRule{{
+					Principals: []v1alpha1.AuthorizationPrincipal{{
+						SourceCIDRs: []string{"10.0.0.0"},
+					}},
+				}},
+			},
+			wantErr: "invalid source CIDR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &trafficPolicySpecIr{}
+			err := rbacForSpec(v1alpha1.TrafficPolicySpec{Authorization: tt.spec}, out)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				assert.Nil(t, out.rbac)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, out.rbac)
+			require.NoError(t, out.rbac.Validate())
+			tt.validate(t, out.rbac.rbacPerRoute.GetRbac().GetRules())
+		})
+	}
+}
+
+func TestRbacValidateRegex(t *testing.T) {
+	out := &trafficPolicySpecIr{}
+	err := rbacForSpec(v1alpha1.TrafficPolicySpec{
+		Authorization: &v1alpha1.Authorization{
+			Rules: []v1alpha1.AuthorizationRule{{
+				Permissions: []v1alpha1.AuthorizationPermission{{
+					Paths: []gwv1.HTTPPathMatch{{
+						Type:  ptr.To(gwv1.PathMatchRegularExpression),
+						Value: ptr.To("/api/(v1"),
+					}},
+				}},
+			}},
+		},
+	}, out)
+	require.NoError(t, err)
+
+	policy := &TrafficPolicy{spec: *out}
+	err = policy.validateProto()
+	require.ErrorContains(t, err, `invalid regex "/api/(v1"`)
+}
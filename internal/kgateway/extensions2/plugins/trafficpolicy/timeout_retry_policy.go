@@ -0,0 +1,271 @@
+package trafficpolicy
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+)
+
+type timeoutsIR struct {
+	request           *durationpb.Duration
+	streamIdle        *durationpb.Duration
+	maxStreamDuration *durationpb.Duration
+}
+
+func (t *timeoutsIR) Equals(other *timeoutsIR) bool {
+	if t == nil && other == nil {
+		return true
+	}
+	if t == nil || other == nil {
+		return false
+	}
+
+	return proto.Equal(t.request, other.request) &&
+		proto.Equal(t.streamIdle, other.streamIdle) &&
+		proto.Equal(t.maxStreamDuration, other.maxStreamDuration)
+}
+
+type retryIR struct {
+	retryPolicy *routev3.RetryPolicy
+}
+
+func (r *retryIR) Equals(other *retryIR) bool {
+	if r == nil && other == nil {
+		return true
+	}
+	if r == nil || other == nil {
+		return false
+	}
+
+	return proto.Equal(r.retryPolicy, other.retryPolicy)
+}
+
+// Validate performs PGV validation on the generated retry policy
+func (r *retryIR) Validate() error {
+	if r == nil {
+		return nil
+	}
+	return r.retryPolicy.Validate()
+}
+
+// timeoutsForSpec translates the timeouts spec and stores it in the traffic policy IR
+func timeoutsForSpec(spec v1alpha1.TrafficPolicySpec, out *trafficPolicySpecIr) {
+	if spec.Timeouts == nil {
+		return
+	}
+
+	out.timeouts = &timeoutsIR{
+		request:           toDurationpb(spec.Timeouts.Request),
+		streamIdle:        toDurationpb(spec.Timeouts.StreamIdle),
+		maxStreamDuration: toDurationpb(spec.Timeouts.MaxStreamDuration),
+	}
+}
+
+// retryForSpec translates the retry spec into an envoy retry policy and stores it in the traffic policy IR
+func retryForSpec(spec v1alpha1.TrafficPolicySpec, out *trafficPolicySpecIr) {
+	if spec.Retry == nil {
+		return
+	}
+
+	retryPolicy := &routev3.RetryPolicy{
+		RetryOn:       krtcollections.DefaultRetryOn,
+		NumRetries:    &wrapperspb.UInt32Value{Value: 1},
+		PerTryTimeout: toDurationpb(spec.Retry.PerTryTimeout),
+	}
+	if len(spec.Retry.RetryOn) > 0 {
+		retryOn := make([]string, 0, len(spec.Retry.RetryOn)+1)
+		for _, cond := range spec.Retry.RetryOn {
+			retryOn = append(retryOn, string(cond))
+		}
+		// Status codes are only retried with the retriable-status-codes condition
+		if len(spec.Retry.StatusCodes) > 0 && !slices.Contains(spec.Retry.RetryOn, v1alpha1.RetryOnRetriableStatusCodes) {
+			retryOn = append(retryOn, string(v1alpha1.RetryOnRetriableStatusCodes))
+		}
+		retryPolicy.RetryOn = strings.Join(retryOn, ",")
+	}
+	if spec.Retry.Attempts != nil {
+		retryPolicy.NumRetries = &wrapperspb.UInt32Value{Value: uint32(*spec.Retry.Attempts)}
+	}
+	for _, code := range spec.Retry.StatusCodes {
+		retryPolicy.RetriableStatusCodes = append(retryPolicy.RetriableStatusCodes, uint32(code))
+	}
+	if backoff := spec.Retry.Backoff; backoff != nil {
+		retryPolicy.RetryBackOff = &routev3.RetryPolicy_RetryBackOff{
+			BaseInterval: durationpb.New(backoff.BaseInterval.Duration),
+			MaxInterval:  toDurationpb(backoff.MaxInterval),
+		}
+	}
+
+	out.retry = &retryIR{
+		retryPolicy: retryPolicy,
+	}
+}
+
+func toDurationpb(d *metav1.Duration) *durationpb.Duration {
+	if d == nil {
+		return nil
+	}
+	return durationpb.New(d.Duration)
+}
+
+// applyTimeoutsRetry sets the timeouts and retry policy on the route action. When overwrite is false,
+// only the values that are not already set on the route are applied. This is used for policies attached
+// to a listener or Gateway, which have a lower precedence than the values set for a specific route.
+// A retry policy set by a more specific TrafficPolicy, as reported by retryFromPolicy, is kept as a
+// whole, while the values set by the HTTPRoute rule are merged into the retry policy.
+func applyTimeoutsRetry(action *routev3.RouteAction, timeouts *timeoutsIR, retry *retryIR, overwrite, retryFromPolicy bool) {
+	if action == nil {
+		return
+	}
+
+	if timeouts != nil {
+		if timeouts.request != nil && (overwrite || action.GetTimeout() == nil) {
+			action.Timeout = timeouts.request
+		}
+		if timeouts.streamIdle != nil && (overwrite || action.GetIdleTimeout() == nil) {
+			action.IdleTimeout = timeouts.streamIdle
+		}
+		if timeouts.maxStreamDuration != nil && (overwrite || action.GetMaxStreamDuration().GetMaxStreamDuration() == nil) {
+			if action.GetMaxStreamDuration() == nil {
+				action.MaxStreamDuration = &routev3.RouteAction_MaxStreamDuration{}
+			}
+			action.GetMaxStreamDuration().MaxStreamDuration = timeouts.maxStreamDuration
+		}
+	}
+
+	if retry == nil {
+		return
+	}
+	switch {
+	case overwrite || action.GetRetryPolicy() == nil:
+		action.RetryPolicy = proto.Clone(retry.retryPolicy).(*routev3.RetryPolicy)
+	case !retryFromPolicy:
+		action.RetryPolicy = mergeRuleRetry(retry.retryPolicy, action.GetRetryPolicy())
+	}
+}
+
+// mergeRuleRetry returns the retry policy of a TrafficPolicy with the values set by an HTTPRoute rule
+// taking precedence, the same way the builtin plugin merges them for the policies targeting the HTTPRoute.
+func mergeRuleRetry(policy, rule *routev3.RetryPolicy) *routev3.RetryPolicy {
+	merged := proto.Clone(policy).(*routev3.RetryPolicy)
+	if rule.GetNumRetries() != nil {
+		merged.NumRetries = rule.GetNumRetries()
+	}
+	if len(rule.GetRetriableStatusCodes()) > 0 {
+		merged.RetriableStatusCodes = rule.GetRetriableStatusCodes()
+	}
+	if rule.GetRetryBackOff() != nil {
+		merged.RetryBackOff = rule.GetRetryBackOff()
+	}
+	if rule.GetPerTryTimeout() != nil {
+		merged.PerTryTimeout = rule.GetPerTryTimeout()
+	}
+	return merged
+}
+
+// applyTimeoutsRetryToRoute applies the timeouts and retry policy of a policy attached to the route,
+// which take precedence over the ones of the policies attached to its listener or Gateway.
+func (p *trafficPolicyPluginGwPass) applyTimeoutsRetryToRoute(action *routev3.RouteAction, timeouts *timeoutsIR, retry *retryIR) {
+	applyTimeoutsRetry(action, timeouts, retry, true, false)
+	p.recordPolicyRetry(action, retry)
+}
+
+// applyTimeoutsRetryToVhosts applies the timeouts and retry policy to all routes of the virtual hosts
+// that do not set them already.
+func (p *trafficPolicyPluginGwPass) applyTimeoutsRetryToVhosts(vhosts []*routev3.VirtualHost, timeouts *timeoutsIR, retry *retryIR) {
+	if timeouts == nil && retry == nil {
+		return
+	}
+	for _, vhost := range vhosts {
+		for _, route := range vhost.GetRoutes() {
+			action := route.GetRoute()
+			applyTimeoutsRetry(action, timeouts, retry, false, p.policyRetries[action])
+			p.recordPolicyRetry(action, retry)
+		}
+	}
+}
+
+// recordPolicyRetry records that the retry policy of the route action is set by a TrafficPolicy.
+func (p *trafficPolicyPluginGwPass) recordPolicyRetry(action *routev3.RouteAction, retry *retryIR) {
+	if action == nil || retry == nil {
+		return
+	}
+	if p.policyRetries == nil {
+		p.policyRetries = make(map[*routev3.RouteAction]bool)
+	}
+	p.policyRetries[action] = true
+}
+
+// timeoutsRetryStatusMessage names the HTTPRoutes whose rules set timeouts or retry values that
+// take precedence over the ones of the policy, along with the policy fields that were skipped.
+func timeoutsRetryStatusMessage(timeouts *timeoutsIR, retry *retryIR, routes []*ir.HttpRouteIR) string {
+	if timeouts == nil && retry == nil {
+		return ""
+	}
+
+	var details []string
+	seen := make(map[types.NamespacedName]bool)
+	for _, route := range routes {
+		hr, ok := route.GetSourceObject().(*gwv1.HTTPRoute)
+		if !ok {
+			continue
+		}
+		nn := types.NamespacedName{Namespace: hr.Namespace, Name: hr.Name}
+		if seen[nn] {
+			continue
+		}
+		seen[nn] = true
+
+		if fields := skippedTimeoutsRetryFields(hr, timeouts, retry); len(fields) > 0 {
+			details = append(details, fmt.Sprintf("%s skipped on HTTPRoute %s as its rules take precedence",
+				strings.Join(fields, ", "), nn))
+		}
+	}
+	return strings.Join(details, "; ")
+}
+
+// skippedTimeoutsRetryFields returns the timeouts and retry fields of the policy that are
+// overridden by the rules of the HTTPRoute.
+func skippedTimeoutsRetryFields(route *gwv1.HTTPRoute, timeouts *timeoutsIR, retry *retryIR) []string {
+	var fields []string
+	add := func(field string) {
+		if !slices.Contains(fields, field) {
+			fields = append(fields, field)
+		}
+	}
+	for _, rule := range route.Spec.Rules {
+		ruleTimeouts := rule.Timeouts
+		hasRequestTimeout := ruleTimeouts != nil && (ruleTimeouts.Request != nil || ruleTimeouts.BackendRequest != nil)
+		if timeouts != nil && timeouts.request != nil && hasRequestTimeout {
+			add("timeouts.request")
+		}
+		if retry == nil || rule.Retry == nil {
+			continue
+		}
+		if retry.retryPolicy.GetPerTryTimeout() != nil && ruleTimeouts != nil && ruleTimeouts.BackendRequest != nil {
+			add("retry.perTryTimeout")
+		}
+		if rule.Retry.Attempts != nil {
+			add("retry.attempts")
+		}
+		if len(rule.Retry.Codes) > 0 && len(retry.retryPolicy.GetRetriableStatusCodes()) > 0 {
+			add("retry.statusCodes")
+		}
+		if rule.Retry.Backoff != nil && retry.retryPolicy.GetRetryBackOff() != nil {
+			add("retry.backoff")
+		}
+	}
+	return fields
+}
@@ -0,0 +1,167 @@
+package trafficpolicy
+
+import (
+	"testing"
+	"time"
+
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+)
+
+func TestRetryForSpec(t *testing.T) {
+	tests := []struct {
+		name     string
+		retry    *v1alpha1.Retry
+		validate func(t *testing.T, policy *routev3.RetryPolicy)
+	}{
+		{
+			name:  "defaults",
+			retry: &v1alpha1.Retry{},
+			validate: func(t *testing.T, policy *routev3.RetryPolicy) {
+				assert.Equal(t, krtcollections.DefaultRetryOn, policy.GetRetryOn())
+				assert.Equal(t, uint32(1), policy.GetNumRetries().GetValue())
+				assert.Nil(t, policy.GetPerTryTimeout())
+				assert.Nil(t, policy.GetRetryBackOff())
+			},
+		},
+		{
+			name: "status codes imply retriable-status-codes",
+			retry: &v1alpha1.Retry{
+				RetryOn:       []v1alpha1.RetryOnCondition{v1alpha1.RetryOnServerError},
+				Attempts:      ptr.To(int32(3)),
+				PerTryTimeout: &metav1.Duration{Duration: time.Second},
+				StatusCodes:   []gwv1.HTTPRouteRetryStatusCode{503},
+				Backoff: &v1alpha1.RetryBackoff{
+					BaseInterval: metav1.Duration{Duration: 100 * time.Millisecond},
+				},
+			},
+			validate: func(t *testing.T, policy *routev3.RetryPolicy) {
+				assert.Equal(t, "5xx,retriable-status-codes", policy.GetRetryOn())
+				assert.Equal(t, uint32(3), policy.GetNumRetries().GetValue())
+				assert.Equal(t, time.Second, policy.GetPerTryTimeout().AsDuration())
+				assert.Equal(t, []uint32{503}, policy.GetRetriableStatusCodes())
+				assert.Equal(t, 100*time.Millisecond, policy.GetRetryBackOff().GetBaseInterval().AsDuration())
+				assert.Nil(t, policy.GetRetryBackOff().GetMaxInterval())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &trafficPolicySpecIr{}
+			retryForSpec(v1alpha1.TrafficPolicySpec{Retry: tt.retry}, out)
+			require.NotNil(t, out.retry)
+			require.NoError(t, out.retry.Validate())
+			tt.validate(t, out.retry.retryPolicy)
+		})
+	}
+}
+
+func TestApplyTimeoutsRetry(t *testing.T) {
+	out := &trafficPolicySpecIr{}
+	timeoutsForSpec(v1alpha1.TrafficPolicySpec{
+		Timeouts: &v1alpha1.Timeouts{
+			Request:           &metav1.Duration{Duration: 10 * time.Second},
+			StreamIdle:        &metav1.Duration{Duration: 20 * time.Second},
+			MaxStreamDuration: &metav1.Duration{Duration: 30 * time.Second},
+		},
+	}, out)
+	retryForSpec(v1alpha1.TrafficPolicySpec{
+		Retry: &v1alpha1.Retry{
+			Attempts:      ptr.To(int32(3)),
+			StatusCodes:   []gwv1.HTTPRouteRetryStatusCode{500},
+			PerTryTimeout: &metav1.Duration{Duration: 5 * time.Second},
+		},
+	}, out)
+
+	existing := func() *routev3.RouteAction {
+		return &routev3.RouteAction{
+			Timeout:     durationpb.New(time.Second),
+			RetryPolicy: &routev3.RetryPolicy{RetryOn: "5xx"},
+		}
+	}
+
+	t.Run("overwrite", func(t *testing.T) {
+		action := existing()
+		applyTimeoutsRetry(action, out.timeouts, out.retry, true, false)
+		assert.Equal(t, 10*time.Second, action.GetTimeout().AsDuration())
+		assert.Equal(t, 20*time.Second, action.GetIdleTimeout().AsDuration())
+		assert.Equal(t, 30*time.Second, action.GetMaxStreamDuration().GetMaxStreamDuration().AsDuration())
+		assert.Equal(t, krtcollections.DefaultRetryOn, action.GetRetryPolicy().GetRetryOn())
+	})
+
+	t.Run("only unset values", func(t *testing.T) {
+		action := existing()
+		applyTimeoutsRetry(action, out.timeouts, out.retry, false, true)
+		assert.Equal(t, time.Second, action.GetTimeout().AsDuration())
+		assert.Equal(t, 20*time.Second, action.GetIdleTimeout().AsDuration())
+		assert.Equal(t, 30*time.Second, action.GetMaxStreamDuration().GetMaxStreamDuration().AsDuration())
+		assert.Equal(t, "5xx", action.GetRetryPolicy().GetRetryOn())
+		assert.Nil(t, action.GetRetryPolicy().GetNumRetries())
+	})
+
+	t.Run("merge rule retry", func(t *testing.T) {
+		action := &routev3.RouteAction{
+			RetryPolicy: &routev3.RetryPolicy{
+				RetryOn:              krtcollections.DefaultRetryOn,
+				NumRetries:           wrapperspb.UInt32(2),
+				RetriableStatusCodes: []uint32{503},
+			},
+		}
+		applyTimeoutsRetry(action, nil, out.retry, false, false)
+		policy := action.GetRetryPolicy()
+		assert.Equal(t, krtcollections.DefaultRetryOn, policy.GetRetryOn())
+		assert.Equal(t, uint32(2), policy.GetNumRetries().GetValue())
+		assert.Equal(t, []uint32{503}, policy.GetRetriableStatusCodes())
+		assert.Equal(t, 5*time.Second, policy.GetPerTryTimeout().AsDuration())
+		assert.Equal(t, uint32(3), out.retry.retryPolicy.GetNumRetries().GetValue())
+	})
+}
+
+func TestTimeoutsRetryStatusMessage(t *testing.T) {
+	out := &trafficPolicySpecIr{}
+	timeoutsForSpec(v1alpha1.TrafficPolicySpec{
+		Timeouts: &v1alpha1.Timeouts{Request: &metav1.Duration{Duration: 10 * time.Second}},
+	}, out)
+	retryForSpec(v1alpha1.TrafficPolicySpec{
+		Retry: &v1alpha1.Retry{
+			Attempts:      ptr.To(int32(3)),
+			PerTryTimeout: &metav1.Duration{Duration: time.Second},
+		},
+	}, out)
+
+	route := func(name string, rules ...gwv1.HTTPRouteRule) *ir.HttpRouteIR {
+		return &ir.HttpRouteIR{
+			SourceObject: &gwv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+				Spec:       gwv1.HTTPRouteSpec{Rules: rules},
+			},
+		}
+	}
+	withTimeouts := route("with-timeouts", gwv1.HTTPRouteRule{
+		Timeouts: &gwv1.HTTPRouteTimeouts{BackendRequest: ptr.To(gwv1.Duration("1s"))},
+	})
+	withRetry := route("with-retry", gwv1.HTTPRouteRule{
+		Timeouts: &gwv1.HTTPRouteTimeouts{BackendRequest: ptr.To(gwv1.Duration("1s"))},
+		Retry:    &gwv1.HTTPRouteRetry{Attempts: ptr.To(2), Codes: []gwv1.HTTPRouteRetryStatusCode{503}},
+	})
+	without := route("without", gwv1.HTTPRouteRule{})
+
+	assert.Empty(t, timeoutsRetryStatusMessage(nil, nil, []*ir.HttpRouteIR{withTimeouts, withRetry}))
+	assert.Empty(t, timeoutsRetryStatusMessage(out.timeouts, out.retry, []*ir.HttpRouteIR{without}))
+	assert.Equal(t,
+		"timeouts.request skipped on HTTPRoute default/with-timeouts as its rules take precedence; "+
+			"timeouts.request, retry.perTryTimeout, retry.attempts skipped on HTTPRoute default/with-retry as its rules take precedence",
+		timeoutsRetryStatusMessage(out.timeouts, out.retry, []*ir.HttpRouteIR{withTimeouts, without, withRetry, withTimeouts}),
+	)
+}
@@ -14,7 +14,10 @@ import (
 	corsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cors/v3"
 	envoy_csrf_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/csrf/v3"
 	dynamicmodulesv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/dynamic_modules/v3"
+	faultv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	jwtauthnv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
 	localratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	rbacfilterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
 	envoy_wellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -89,6 +92,12 @@ type trafficPolicySpecIr struct {
 	csrf                       *CsrfIR
 	autoHostRewrite            *wrapperspb.BoolValue
 	buffer                     *BufferIR
+	jwt                        *jwtIR
+	rbac                       *rbacIR
+	fault                      *faultIR
+	timeouts                   *timeoutsIR
+	retry                      *retryIR
+	compression                *compressionIR
 }
 
 func (d *TrafficPolicy) CreationTime() time.Time {
@@ -162,6 +171,30 @@ func (d *TrafficPolicy) Equals(in any) bool {
 		return false
 	}
 
+	if !d.spec.jwt.Equals(d2.spec.jwt) {
+		return false
+	}
+
+	if !d.spec.rbac.Equals(d2.spec.rbac) {
+		return false
+	}
+
+	if !d.spec.fault.Equals(d2.spec.fault) {
+		return false
+	}
+
+	if !d.spec.timeouts.Equals(d2.spec.timeouts) {
+		return false
+	}
+
+	if !d.spec.retry.Equals(d2.spec.retry) {
+		return false
+	}
+
+	if !d.spec.compression.Equals(d2.spec.compression) {
+		return false
+	}
+
 	return true
 }
 
@@ -180,6 +213,11 @@ type trafficPolicyPluginGwPass struct {
 	corsInChain           map[string]*corsv3.Cors
 	csrfInChain           map[string]*envoy_csrf_v3.CsrfPolicy
 	bufferInChain         map[string]*bufferv3.Buffer
+	jwtInChain            map[string]*jwtauthnv3.JwtAuthentication
+	rbacInChain           map[string]*rbacfilterv3.RBAC
+	faultInChain          map[string]*faultv3.HTTPFault
+	// policyRetries are the route actions whose retry policy is set by a TrafficPolicy
+	policyRetries map[*routev3.RouteAction]bool
 }
 
 var _ ir.ProxyTranslationPass = &trafficPolicyPluginGwPass{}
@@ -259,6 +297,14 @@ func NewGatewayTranslationPass(ctx context.Context, tctx ir.GwTranslationCtx, re
 	}
 }
 
+var _ ir.PolicyIRStatusMessage = &TrafficPolicy{}
+
+// StatusMessage surfaces the timeouts and retry of the policy that are skipped on the HTTPRoutes
+// as the values set on their rules take precedence.
+func (p *TrafficPolicy) StatusMessage(routes []*ir.HttpRouteIR) string {
+	return timeoutsRetryStatusMessage(p.spec.timeouts, p.spec.retry, routes)
+}
+
 func (p *TrafficPolicy) Name() string {
 	return "trafficpolicies"
 }
@@ -274,6 +320,10 @@ func (p *trafficPolicyPluginGwPass) ApplyRouteConfigPlugin(
 	}
 
 	p.handlePolicies(pCtx.FilterChainName, &pCtx.TypedFilterConfig, policy.spec)
+
+	// Policies attached to the Gateway have the lowest precedence and only set
+	// timeouts and retries on routes that don't have them yet.
+	p.applyTimeoutsRetryToVhosts(out.GetVirtualHosts(), policy.spec.timeouts, policy.spec.retry)
 }
 
 func (p *trafficPolicyPluginGwPass) ApplyVhostPlugin(
@@ -287,6 +337,10 @@ func (p *trafficPolicyPluginGwPass) ApplyVhostPlugin(
 	}
 
 	p.handlePolicies(pCtx.FilterChainName, &pCtx.TypedFilterConfig, policy.spec)
+
+	// Policies attached to the listener only set timeouts and retries on routes
+	// that don't have them yet.
+	p.applyTimeoutsRetryToVhosts([]*routev3.VirtualHost{out}, policy.spec.timeouts, policy.spec.retry)
 }
 
 // called 0 or more times
@@ -383,6 +437,10 @@ func (p *trafficPolicyPluginGwPass) ApplyForRoute(ctx context.Context, pCtx *ir.
 		}
 	}
 
+	// HTTPRoute rule timeouts and retries are applied by the builtin plugin after this
+	// and take precedence over the values set here.
+	p.applyTimeoutsRetryToRoute(outputRoute.GetRoute(), policy.spec.timeouts, policy.spec.retry)
+
 	p.handlePolicies(pCtx.FilterChainName, &pCtx.TypedFilterConfig, policy.spec)
 
 	return nil
@@ -499,6 +557,36 @@ func (p *trafficPolicyPluginGwPass) HttpFilters(ctx context.Context, fcc ir.Filt
 		filters = AddDisableFilterIfNeeded(filters)
 	}
 
+	// Add fault injection filter for listener.
+	// Requires the fault policy to be set as typed_per_filter_config.
+	if p.faultInChain[fcc.FilterChainName] != nil {
+		filter := plugins.MustNewStagedFilter(faultFilterName,
+			p.faultInChain[fcc.FilterChainName],
+			plugins.DuringStage(plugins.FaultStage))
+		filter.Filter.Disabled = true
+		filters = append(filters, filter)
+	}
+
+	// Add JWT authentication filter for listener.
+	// Requires the jwt requirement to be selected in typed_per_filter_config.
+	if p.jwtInChain[fcc.FilterChainName] != nil {
+		filter := plugins.MustNewStagedFilter(jwtFilterName,
+			p.jwtInChain[fcc.FilterChainName],
+			plugins.DuringStage(plugins.AuthNStage))
+		filter.Filter.Disabled = true
+		filters = append(filters, filter)
+	}
+
+	// Add RBAC filter for listener.
+	// Requires the rbac policy to be set as typed_per_filter_config.
+	if p.rbacInChain[fcc.FilterChainName] != nil {
+		filter := plugins.MustNewStagedFilter(rbacFilterName,
+			p.rbacInChain[fcc.FilterChainName],
+			plugins.DuringStage(plugins.AuthZStage))
+		filter.Filter.Disabled = true
+		filters = append(filters, filter)
+	}
+
 	// Add Ext_authz filter for listener
 	for providerName, provider := range p.extAuthPerProvider.Providers[fcc.FilterChainName] {
 		extAuthFilter := provider.ExtAuth
@@ -592,6 +680,18 @@ func (p *trafficPolicyPluginGwPass) handlePolicies(fcn string, typedFilterConfig
 	p.handleCsrf(fcn, typedFilterConfig, spec.csrf)
 
 	p.handleBuffer(fcn, typedFilterConfig, spec.buffer)
+
+	// Apply JWT authentication configuration if present
+	p.handleJwt(fcn, typedFilterConfig, spec.jwt)
+
+	// Apply RBAC configuration if present
+	p.handleRbac(fcn, typedFilterConfig, spec.rbac)
+
+	// Apply fault injection configuration if present
+	p.handleFault(fcn, typedFilterConfig, spec.fault)
+
+	// Apply compression configuration if present
+	p.handleCompression(typedFilterConfig, spec.compression)
 }
 
 func (p *trafficPolicyPluginGwPass) SupportsPolicyMerge() bool {
@@ -720,5 +820,41 @@ func MergeTrafficPolicies(
 		mergeOrigins["buffer"] = p2Ref
 	}
 
+	// Handle JWT policy merging
+	if policy.IsMergeable(p1.spec.jwt, p2.spec.jwt, mergeOpts) {
+		p1.spec.jwt = p2.spec.jwt
+		mergeOrigins["jwt"] = p2Ref
+	}
+
+	// Handle authorization policy merging
+	if policy.IsMergeable(p1.spec.rbac, p2.spec.rbac, mergeOpts) {
+		p1.spec.rbac = p2.spec.rbac
+		mergeOrigins["authorization"] = p2Ref
+	}
+
+	// Handle fault injection policy merging
+	if policy.IsMergeable(p1.spec.fault, p2.spec.fault, mergeOpts) {
+		p1.spec.fault = p2.spec.fault
+		mergeOrigins["faultInjection"] = p2Ref
+	}
+
+	// Handle timeouts policy merging
+	if policy.IsMergeable(p1.spec.timeouts, p2.spec.timeouts, mergeOpts) {
+		p1.spec.timeouts = p2.spec.timeouts
+		mergeOrigins["timeouts"] = p2Ref
+	}
+
+	// Handle retry policy merging
+	if policy.IsMergeable(p1.spec.retry, p2.spec.retry, mergeOpts) {
+		p1.spec.retry = p2.spec.retry
+		mergeOrigins["retry"] = p2Ref
+	}
+
+	// Handle compression policy merging
+	if policy.IsMergeable(p1.spec.compression, p2.spec.compression, mergeOpts) {
+		p1.spec.compression = p2.spec.compression
+		mergeOrigins["compression"] = p2Ref
+	}
+
 	return mergeOrigins
 }
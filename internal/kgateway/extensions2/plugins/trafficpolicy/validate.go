@@ -2,12 +2,16 @@ package trafficpolicy
 
 import (
 	"context"
+	"fmt"
 
+	envoy_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/pkg/settings"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/regexutils"
 	"github.com/kgateway-dev/kgateway/v2/pkg/validator"
 	"github.com/kgateway-dev/kgateway/v2/pkg/xds/bootstrap"
 )
@@ -75,6 +79,18 @@ func (p *TrafficPolicy) validateProto() error {
 	if p.spec.csrf != nil {
 		validators = append(validators, p.spec.csrf.csrfPolicy.Validate)
 	}
+	if p.spec.jwt != nil {
+		validators = append(validators, p.spec.jwt.Validate)
+	}
+	if p.spec.rbac != nil {
+		validators = append(validators, p.spec.rbac.Validate)
+	}
+	if p.spec.fault != nil {
+		validators = append(validators, p.spec.fault.Validate)
+	}
+	if p.spec.retry != nil {
+		validators = append(validators, p.spec.retry.Validate)
+	}
 	for _, validator := range validators {
 		if err := validator(); err != nil {
 			return err
@@ -83,6 +99,46 @@ func (p *TrafficPolicy) validateProto() error {
 	return nil
 }
 
+// validateRegexes walks the given message and checks that every RegexMatcher in it
+// holds a valid RE2 expression. PGV validation does not compile regexes, so without
+// this check an invalid expression would only be rejected by envoy.
+func validateRegexes(msg proto.Message) error {
+	var err error
+	var walk func(m protoreflect.Message) bool
+	walk = func(m protoreflect.Message) bool {
+		if regex, ok := m.Interface().(*envoy_matcher_v3.RegexMatcher); ok {
+			if err = regexutils.CheckRegexString(regex.GetRegex()); err != nil {
+				err = fmt.Errorf("invalid regex %q: %w", regex.GetRegex(), err)
+				return false
+			}
+		}
+		m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			switch {
+			case fd.IsList() && fd.Message() != nil:
+				list := v.List()
+				for i := 0; i < list.Len(); i++ {
+					if !walk(list.Get(i).Message()) {
+						return false
+					}
+				}
+			case fd.IsMap() && fd.MapValue().Message() != nil:
+				cont := true
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					cont = walk(mv.Message())
+					return cont
+				})
+				return cont
+			case fd.Message() != nil && !fd.IsList() && !fd.IsMap():
+				return walk(v.Message())
+			}
+			return true
+		})
+		return err == nil
+	}
+	walk(msg.ProtoReflect())
+	return err
+}
+
 // validateXDS builds a partial bootstrap config and validates it via envoy
 // validate mode. It re-uses the ApplyForRoute method to ensure that the translation
 // and validation logic go through the same code path as normal.
@@ -8,6 +8,7 @@ import (
 	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	istioannot "istio.io/api/annotation"
 	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/slices"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -36,13 +37,22 @@ func NewPlugin(
 		commonCols,
 		queries,
 	)
+	originalDstBackends := newOriginalDstBackends(commonCols, waypointQueries)
 	plugin := extensionsplug.Plugin{
+		ContributesBackends: map[schema.GroupKind]extensionsplug.BackendPlugin{
+			waypointquery.OriginalDstGK: {
+				BackendInit: ir.BackendInit{
+					InitBackend: initOriginalDstBackend,
+				},
+				Backends: originalDstBackends,
+			},
+		},
 		ContributesGwTranslator: func(gw *gwv1.Gateway) extensionsplug.KGwTranslator {
 			if string(gw.Spec.GatewayClassName) != waypointGatewayClassName {
 				return nil
 			}
 
-			return NewTranslator(queries, waypointQueries, commonCols.Settings)
+			return NewTranslator(queries, waypointQueries, commonCols.ConfigMaps, commonCols.Settings)
 		},
 		ExtraHasSynced: func() bool {
 			return waypointQueries.HasSynced()
@@ -73,6 +83,33 @@ func NewPlugin(
 	return plugin
 }
 
+// newOriginalDstBackends builds a backend for every port of the headless Services
+// attached to a waypoint. Headless Services have no VIP to load balance behind, the
+// waypoint forwards traffic to the workload address the client resolved instead.
+func newOriginalDstBackends(
+	commonCols *common.CommonCollections,
+	waypointQueries waypointquery.WaypointQueries,
+) krt.Collection[ir.BackendObjectIR] {
+	return krt.NewManyCollection(waypointQueries.WaypointedServices(), func(kctx krt.HandlerContext, ws waypointquery.WaypointedService) []ir.BackendObjectIR {
+		if !ws.Service.IsHeadless() {
+			return nil
+		}
+		return slices.Map(ws.Service.Ports, func(port waypointquery.ServicePort) ir.BackendObjectIR {
+			return ws.Service.OriginalDstBackendObject(port)
+		})
+	}, commonCols.KrtOpts.ToOptions("WaypointOriginalDstBackends")...)
+}
+
+// initOriginalDstBackend builds an ORIGINAL_DST cluster. The proxy_protocol listener filter
+// restores the original destination the zTunnel received, so that is where traffic goes.
+func initOriginalDstBackend(ctx context.Context, in ir.BackendObjectIR, out *envoy_config_cluster_v3.Cluster) *ir.EndpointsForBackend {
+	out.ClusterDiscoveryType = &envoy_config_cluster_v3.Cluster_Type{
+		Type: envoy_config_cluster_v3.Cluster_ORIGINAL_DST,
+	}
+	out.LbPolicy = envoy_config_cluster_v3.Cluster_CLUSTER_PROVIDED
+	return nil
+}
+
 type PerClientProcessor struct {
 	waypointQueries          waypointquery.WaypointQueries
 	commonCols               *common.CommonCollections
@@ -3,7 +3,6 @@ package waypoint
 import (
 	"fmt"
 
-	"google.golang.org/protobuf/types/known/anypb"
 	authpb "istio.io/api/security/v1"
 	authcr "istio.io/client-go/pkg/apis/security/v1"
 	"istio.io/istio/pilot/pkg/config/kube/crdclient"
@@ -18,18 +17,15 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 )
 
-const (
-	// TODO: Add configuration for trustDomain and trustDomainAliases in settings
-	// This will allow users to customize the trust domain and its aliases for their cluster
-	defaultTrustDomain = "cluster.local"
-)
-
 // BuildRBAC uses whatever policies received, assumes that the policy attachment is done outside
 // gives the following lists of filters:
 // tcpRBAC - only used in tcp chains (using this on an HTTP chain could cause improper DENY)
 // httpRBAC - only used in http chains
+// Principals using the trust domain of the bundle, one of its aliases or "cluster.local" match
+// the identities of all of them.
 func BuildRBAC(
 	authzPolicies []*authcr.AuthorizationPolicy,
+	trustBundle trustdomain.Bundle,
 	gw *gwapi.Gateway,
 	svc *waypointquery.Service,
 ) (
@@ -45,7 +41,6 @@ func BuildRBAC(
 	}
 
 	// Create the builder with our separated policies
-	trustBundle := trustdomain.NewBundle(defaultTrustDomain, nil)
 	authzBuilder := builder.New(trustBundle, nil, policyResult, builder.Option{
 		IsCustomBuilder: false,
 		UseFilterState:  true,
@@ -86,13 +81,12 @@ func applyTCPRBACFilters(tcpChain *ir.TcpIR, tcpRBAC []*ir.CustomEnvoyFilter, sv
 		return
 	}
 	// Apply RBAC filters regardless of the presence of proxy_protocol_authority
-	if tcpChain.NetworkFilters == nil {
-		tcpChain.NetworkFilters = []*anypb.Any{}
-	}
-
-	// Add RBAC filters as built-in network filters
+	// Add RBAC filters to CustomNetworkFilters so they are staged before the tcp_proxy
 	for _, f := range tcpRBAC {
-		tcpChain.NetworkFilters = append(tcpChain.NetworkFilters, f.Config)
+		if f == nil {
+			continue
+		}
+		tcpChain.CustomNetworkFilters = append(tcpChain.CustomNetworkFilters, *f)
 	}
 }
 
@@ -6,7 +6,13 @@ import (
 	"github.com/onsi/gomega"
 	authpb "istio.io/api/security/v1"
 	authcr "istio.io/client-go/pkg/apis/security/v1"
+	"istio.io/istio/pilot/pkg/security/trustdomain"
+	"istio.io/istio/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
 )
 
 type policyTestExpectation struct {
@@ -374,3 +380,90 @@ func TestSeparateAndDeduplicatePolicies(t *testing.T) {
 		})
 	}
 }
+
+func TestCollectTrustDomains(t *testing.T) {
+	policy := &authcr.AuthorizationPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "principals",
+			Namespace: "test-ns",
+		},
+		Spec: authpb.AuthorizationPolicy{
+			Rules: []*authpb.Rule{{
+				From: []*authpb.Rule_From{{
+					Source: &authpb.Source{
+						Principals: []string{
+							"example.org/ns/a/sa/a",
+							"cluster.local/ns/b/sa/b",
+							"*/ns/c/sa/c",
+							"old.example.org/ns/d/sa/d",
+							"staging-td/ns/e/sa/e",
+						},
+						NotPrincipals: []string{"other.org/ns/f/sa/f"},
+					},
+				}},
+				When: []*authpb.Condition{
+					{Key: "source.principal", Values: []string{"legacy.org/ns/g/sa/g"}},
+					{Key: "request.headers[x-td]", Values: []string{"ignored.org/ns/h/sa/h"}},
+				},
+			}},
+		},
+	}
+
+	g := gomega.NewWithT(t)
+	trustDomains := sets.New[string]()
+	collectTrustDomains([]*authcr.AuthorizationPolicy{policy}, trustDomains)
+	g.Expect(sets.SortedList(trustDomains)).To(gomega.Equal([]string{
+		"cluster.local", "example.org", "legacy.org", "old.example.org", "other.org", "staging-td",
+	}))
+
+	unknown := unknownTrustDomains(
+		trustdomain.NewBundle("example.org", []string{"old.example.org", "*-td"}),
+		trustDomains,
+	)
+	g.Expect(sets.SortedList(unknown)).To(gomega.Equal([]string{"legacy.org", "other.org"}))
+}
+
+func TestReportTrustDomains(t *testing.T) {
+	bundle := trustdomain.NewBundle("example.org", []string{"old.example.org"})
+	tests := []struct {
+		name         string
+		trustDomains sets.Set[string]
+		status       metav1.ConditionStatus
+		reason       string
+	}{
+		{
+			name:         "no principals",
+			trustDomains: sets.New[string](),
+		},
+		{
+			name:         "known trust domains",
+			trustDomains: sets.New("example.org", "old.example.org"),
+			status:       metav1.ConditionTrue,
+			reason:       TrustDomainsResolvedReason,
+		},
+		{
+			name:         "unknown trust domain",
+			trustDomains: sets.New("example.org", "other.org"),
+			status:       metav1.ConditionFalse,
+			reason:       UnknownTrustDomainReason,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			gw := &gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "waypoint", Namespace: "test-ns"}}
+			reportMap := reports.NewReportMap()
+			reportTrustDomains(reports.NewReporter(&reportMap).Gateway(gw), bundle, tc.trustDomains)
+
+			condition := meta.FindStatusCondition(reportMap.Gateway(gw).GetConditions(), TrustDomainsResolvedConditionType)
+			if tc.status == "" {
+				g.Expect(condition).To(gomega.BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(gomega.BeNil())
+			g.Expect(condition.Status).To(gomega.Equal(tc.status))
+			g.Expect(condition.Reason).To(gomega.Equal(tc.reason))
+		})
+	}
+}
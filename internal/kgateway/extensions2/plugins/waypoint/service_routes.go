@@ -0,0 +1,98 @@
+package waypoint
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/waypoint/waypointquery"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/query"
+	reports "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+)
+
+// httpRoutesForPort returns the HTTPRoutes attached to the Service that apply to the port,
+// and a key identifying that set of routes, so virtual hosts can be shared between ports.
+func httpRoutesForPort(httpRoutes []query.RouteInfo, port waypointquery.ServicePort) ([]*query.RouteInfo, string) {
+	var routes []*query.RouteInfo
+	var key []string
+	for i, r := range httpRoutes {
+		if appliesToPort(r, port) {
+			routes = append(routes, &httpRoutes[i])
+			key = append(key, strconv.Itoa(i))
+		}
+	}
+	return routes, strings.Join(key, ",")
+}
+
+// reportUnmatchedHTTPRoutes sets the Accepted condition of the HTTPRoutes that apply to
+// none of the HTTP ports of the Service. HTTPRoutes in use are reported when translating
+// their rules.
+func reportUnmatchedHTTPRoutes(
+	baseReporter reports.Reporter,
+	svc waypointquery.Service,
+	httpRoutes []query.RouteInfo,
+) {
+	for _, r := range httpRoutes {
+		if !hasMatchingPort(svc, r, true) {
+			parentRefReporter := baseReporter.Route(r.Object.GetSourceObject()).ParentRef(&r.ParentRef)
+			parentRefReporter.SetCondition(noMatchingPortCondition(svc, r, "HTTP"))
+		}
+	}
+}
+
+func hasMatchingPort(svc waypointquery.Service, r query.RouteInfo, isHTTP bool) bool {
+	for _, port := range svc.Ports {
+		if port.IsHTTP() == isHTTP && appliesToPort(r, port) {
+			return true
+		}
+	}
+	return false
+}
+
+func noMatchingPortCondition(svc waypointquery.Service, r query.RouteInfo, protocol string) reports.RouteCondition {
+	var message string
+	if r.ParentRef.Port == nil {
+		message = fmt.Sprintf("%s has no %s ports", svc.String(), protocol)
+	} else if !hasPort(svc, int32(*r.ParentRef.Port)) {
+		message = fmt.Sprintf("%s has no port %d", svc.String(), *r.ParentRef.Port)
+	} else {
+		message = fmt.Sprintf("port %d of %s is not a %s port", *r.ParentRef.Port, svc.String(), protocol)
+	}
+	return reports.RouteCondition{
+		Type:    gwv1.RouteConditionAccepted,
+		Status:  metav1.ConditionFalse,
+		Reason:  gwv1.RouteReasonNoMatchingParent,
+		Message: message,
+	}
+}
+
+func hasPort(svc waypointquery.Service, port int32) bool {
+	for _, p := range svc.Ports {
+		if p.Port == port {
+			return true
+		}
+	}
+	return false
+}
+
+// appliesToPort returns whether a route attached to a Service applies to the port:
+// routes without a port on their parentRef apply to every port of the Service.
+func appliesToPort(r query.RouteInfo, port waypointquery.ServicePort) bool {
+	return r.ParentRef.Port == nil || int32(*r.ParentRef.Port) == port.Port
+}
+
+// compareAge orders routes by creation time, then by namespace and name.
+func compareAge(a, b query.RouteInfo) int {
+	ta := a.Object.GetSourceObject().GetCreationTimestamp()
+	tb := b.Object.GetSourceObject().GetCreationTimestamp()
+	switch {
+	case ta.Before(&tb):
+		return -1
+	case tb.Before(&ta):
+		return 1
+	}
+	return strings.Compare(namespacedName(a).String(), namespacedName(b).String())
+}
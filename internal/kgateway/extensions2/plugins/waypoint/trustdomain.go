@@ -0,0 +1,179 @@
+package waypoint
+
+import (
+	"fmt"
+	"strings"
+
+	meshconfig "istio.io/api/mesh/v1alpha1"
+	authcr "istio.io/client-go/pkg/apis/security/v1"
+	"istio.io/istio/pilot/pkg/security/trustdomain"
+	"istio.io/istio/pkg/config/mesh"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/util/sets"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	reports "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+)
+
+const (
+	defaultTrustDomain = "cluster.local"
+
+	// istioMeshConfigMapName is the name of the ConfigMap, in the Istio namespace,
+	// holding the MeshConfig under the istioMeshConfigKey key.
+	istioMeshConfigMapName = "istio"
+	istioMeshConfigKey     = "mesh"
+
+	sourcePrincipalKey = "source.principal"
+
+	// TrustDomainsResolvedConditionType is set on waypoints to report whether the principals of
+	// the AuthorizationPolicies applied to the waypoint all use a known trust domain. It is only
+	// set on waypoints with AuthorizationPolicy principals that enforce a trust domain.
+	TrustDomainsResolvedConditionType = "TrustDomainsResolved"
+	UnknownTrustDomainReason          = "UnknownTrustDomain"
+	TrustDomainsResolvedReason        = "TrustDomainsResolved"
+)
+
+// trustBundle returns the trust domain of the mesh and its aliases. The Settings take precedence
+// over the MeshConfig of the Istio installation.
+func (w *waypointTranslator) trustBundle(kctx krt.HandlerContext) trustdomain.Bundle {
+	trustDomain, aliases := w.trustDomain, w.trustDomainAliases
+	if trustDomain == "" || len(aliases) == 0 {
+		meshConfig := w.fetchMeshConfig(kctx)
+		if trustDomain == "" {
+			trustDomain = meshConfig.GetTrustDomain()
+		}
+		if len(aliases) == 0 {
+			aliases = meshConfig.GetTrustDomainAliases()
+		}
+	}
+	if trustDomain == "" {
+		trustDomain = defaultTrustDomain
+	}
+	return trustdomain.NewBundle(trustDomain, aliases)
+}
+
+func (w *waypointTranslator) fetchMeshConfig(kctx krt.HandlerContext) *meshconfig.MeshConfig {
+	if w.configMaps == nil {
+		return nil
+	}
+	cm := krt.FetchOne(kctx, w.configMaps, krt.FilterObjectName(types.NamespacedName{
+		Namespace: w.rootNamespace,
+		Name:      istioMeshConfigMapName,
+	}))
+	if cm == nil {
+		return nil
+	}
+	meshConfig, err := mesh.ApplyMeshConfigDefaults((*cm).Data[istioMeshConfigKey])
+	if err != nil {
+		logger.Error("failed parsing Istio MeshConfig", "namespace", w.rootNamespace, "name", istioMeshConfigMapName, "error", err)
+		return nil
+	}
+	return meshConfig
+}
+
+// collectTrustDomains adds to trustDomains the trust domains enforced by the principals of the
+// policies. Principals with a trust domain that is neither the trust domain of the mesh nor one
+// of its aliases are kept as-is by the RBAC builder, so they only match peers of that exact
+// trust domain.
+func collectTrustDomains(policies []*authcr.AuthorizationPolicy, trustDomains sets.Set[string]) {
+	check := func(principals []string) {
+		for _, principal := range principals {
+			if td, ok := principalTrustDomain(principal); ok {
+				trustDomains.Insert(td)
+			}
+		}
+	}
+	for _, policy := range policies {
+		for _, rule := range policy.Spec.GetRules() {
+			for _, from := range rule.GetFrom() {
+				check(from.GetSource().GetPrincipals())
+				check(from.GetSource().GetNotPrincipals())
+			}
+			for _, when := range rule.GetWhen() {
+				if when.GetKey() != sourcePrincipalKey {
+					continue
+				}
+				check(when.GetValues())
+				check(when.GetNotValues())
+			}
+		}
+	}
+}
+
+// unknownTrustDomains returns the trust domains that are neither the trust domain of the bundle
+// nor one of its aliases.
+func unknownTrustDomains(bundle trustdomain.Bundle, trustDomains sets.Set[string]) sets.Set[string] {
+	unknown := sets.New[string]()
+	for td := range trustDomains {
+		if !isKnownTrustDomain(td, bundle.TrustDomains) {
+			unknown.Insert(td)
+		}
+	}
+	return unknown
+}
+
+// principalTrustDomain returns the trust domain of a principal in the
+// <trust-domain>/ns/<namespace>/sa/<service-account> format, if it is enforced.
+func principalTrustDomain(principal string) (string, bool) {
+	parts := strings.Split(principal, "/")
+	if len(parts) != 5 || parts[0] == "*" {
+		return "", false
+	}
+	return parts[0], true
+}
+
+// isKnownTrustDomain matches the trust domain the same way the RBAC builder does: "cluster.local"
+// always refers to the local trust domain, and either side may use a * prefix or suffix.
+func isKnownTrustDomain(td string, trustDomains []string) bool {
+	if td == defaultTrustDomain {
+		return true
+	}
+	for _, known := range trustDomains {
+		if td == known || known == "*" ||
+			wildcardMatch(td, known) || wildcardMatch(known, td) {
+			return true
+		}
+	}
+	return false
+}
+
+func wildcardMatch(s, pattern string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok && strings.HasPrefix(s, prefix) {
+		return true
+	}
+	if suffix, ok := strings.CutPrefix(pattern, "*"); ok && strings.HasSuffix(s, suffix) {
+		return true
+	}
+	return false
+}
+
+// reportTrustDomains sets the TrustDomainsResolved condition of a waypoint whose RBAC depends on
+// the trust domains of AuthorizationPolicy principals. Waypoints without such principals are left
+// without the condition.
+func reportTrustDomains(gwReporter reports.GatewayReporter, bundle trustdomain.Bundle, trustDomains sets.Set[string]) {
+	if trustDomains.Len() == 0 {
+		return
+	}
+	unknown := unknownTrustDomains(bundle, trustDomains)
+	if unknown.Len() == 0 {
+		gwReporter.SetCondition(reports.GatewayCondition{
+			Type:    TrustDomainsResolvedConditionType,
+			Status:  metav1.ConditionTrue,
+			Reason:  TrustDomainsResolvedReason,
+			Message: "All AuthorizationPolicy principals use the trust domain or one of its aliases",
+		})
+		return
+	}
+	gwReporter.SetCondition(reports.GatewayCondition{
+		Type:   TrustDomainsResolvedConditionType,
+		Status: metav1.ConditionFalse,
+		Reason: UnknownTrustDomainReason,
+		Message: fmt.Sprintf(
+			"AuthorizationPolicy principals reference trust domains %s that are neither the trust domain %q nor one of its aliases %v",
+			strings.Join(sets.SortedList(unknown), ", "),
+			bundle.TrustDomains[0],
+			bundle.TrustDomains[1:],
+		),
+	})
+}
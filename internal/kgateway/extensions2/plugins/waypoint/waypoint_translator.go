@@ -2,14 +2,18 @@ package waypoint
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 
+	v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	authcr "istio.io/client-go/pkg/apis/security/v1"
+	"istio.io/istio/pilot/pkg/security/trustdomain"
 	"istio.io/istio/pkg/kube/krt"
 	"istio.io/istio/pkg/slices"
 	"istio.io/istio/pkg/util/sets"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -45,9 +49,13 @@ type waypointTranslator struct {
 	queries         query.GatewayQueries
 	waypointQueries waypointquery.WaypointQueries
 
-	localBind     bool
-	rootNamespace string
-	bindIpv6      bool
+	configMaps krt.Collection[*corev1.ConfigMap]
+
+	localBind          bool
+	rootNamespace      string
+	bindIpv6           bool
+	trustDomain        string
+	trustDomainAliases []string
 }
 
 var _ extensionsplug.KGwTranslator = &waypointTranslator{}
@@ -55,14 +63,18 @@ var _ extensionsplug.KGwTranslator = &waypointTranslator{}
 func NewTranslator(
 	queries query.GatewayQueries,
 	waypointQueries waypointquery.WaypointQueries,
+	configMaps krt.Collection[*corev1.ConfigMap],
 	settings settings.Settings,
 ) extensionsplug.KGwTranslator {
 	return &waypointTranslator{
-		queries:         queries,
-		waypointQueries: waypointQueries,
-		localBind:       settings.WaypointLocalBinding,
-		rootNamespace:   settings.IstioNamespace,
-		bindIpv6:        settings.ListenerBindIpv6,
+		queries:            queries,
+		waypointQueries:    waypointQueries,
+		configMaps:         configMaps,
+		localBind:          settings.WaypointLocalBinding,
+		rootNamespace:      settings.IstioNamespace,
+		bindIpv6:           settings.ListenerBindIpv6,
+		trustDomain:        settings.IstioTrustDomain,
+		trustDomainAliases: settings.IstioTrustDomainAliases,
 	}
 }
 
@@ -98,6 +110,11 @@ func (w *waypointTranslator) Translate(
 
 	waypointFor := waypointquery.GetWaypointFor(gateway.Obj)
 
+	// track the trust domains of AuthorizationPolicy principals to report the ones
+	// that won't match the identities of the mesh
+	trustBundle := w.trustBundle(kctx)
+	principalTrustDomains := sets.New[string]()
+
 	if waypointFor.ForService() {
 		http, tcp := w.buildServiceChains(
 			kctx,
@@ -109,10 +126,13 @@ func (w *waypointTranslator) Translate(
 			gwListener,
 			attachedRoutes,
 			w.rootNamespace,
+			trustBundle,
+			principalTrustDomains,
 		)
 		proxyListener.HttpFilterChain = append(proxyListener.HttpFilterChain, http...)
 		proxyListener.TcpFilterChain = append(proxyListener.TcpFilterChain, tcp...)
 	}
+	reportTrustDomains(gwReporter, trustBundle, principalTrustDomains)
 
 	// ensure consistent ordering in outputs
 	proxyListener.HttpFilterChain = slices.SortBy(proxyListener.HttpFilterChain, func(fc ir.HttpFilterChainIR) string {
@@ -260,6 +280,8 @@ func (t *waypointTranslator) buildServiceChains(
 	gwListener *ir.Listener,
 	attachedRoutes sets.Set[types.NamespacedName],
 	rootNamespace string,
+	trustBundle trustdomain.Bundle,
+	principalTrustDomains sets.Set[string],
 ) ([]ir.HttpFilterChainIR, []ir.TcpIR) {
 	var httpOut []ir.HttpFilterChainIR
 	var tcpOut []ir.TcpIR
@@ -277,13 +299,16 @@ func (t *waypointTranslator) buildServiceChains(
 
 	// for each service:
 	// * 1:1 Service port -> filter chain
+	// * headless services match the addresses of their workloads
+	//   and forward to the original destination by default
 	// For HTTP:
-	// * shared virtualhost across all the per-port chains for HTTPRoute config
+	// * virtualhost shared across the per-port chains with the same HTTPRoutes
+	//   (routes may target a single port with parentRef.port)
 	// * if there are no HTTPRoutes, per-port virtualhosts that just forward
 	//   traffic without modification
 	// For TCP:
-	// * Just forward traffic
-	// * TODO TCPRoute
+	// * the backends of the oldest TCPRoute attached to the port, if any
+	// * otherwise just forward traffic
 
 	for _, svc := range services {
 		serviceSpecificPolicies := t.waypointQueries.GetAuthorizationPoliciesForService(kctx, ctx, &svc)
@@ -294,41 +319,49 @@ func (t *waypointTranslator) buildServiceChains(
 		combinedPolicies = append(combinedPolicies, gwAuthzPolicies...)
 		combinedPolicies = append(combinedPolicies, serviceSpecificPolicies...)
 
-		tcpRBAC, httpRBAC := BuildRBAC(combinedPolicies, gw.Obj, &svc)
-
-		// get Service-specific routes
-		httpRoutes := gwRoutes
-		svcRoutes := t.waypointQueries.GetHTTPRoutesForService(kctx, ctx, &svc)
-		for _, r := range svcRoutes {
-			attachedRoutes.Insert(namespacedName(r))
-			httpRoutes = append(httpRoutes, &r)
+		collectTrustDomains(combinedPolicies, principalTrustDomains)
+		tcpRBAC, httpRBAC := BuildRBAC(combinedPolicies, trustBundle, gw.Obj, &svc)
+
+		prefixRanges, err := t.serviceCidrRanges(kctx, ctx, svc)
+		if err != nil {
+			logger.Debug(
+				"service had invalid or missing VIPs",
+				"service",
+				svc.GetName(),
+				"namespace",
+				svc.GetNamespace(),
+				"addresses",
+				svc.Addresses,
+			)
+			continue
 		}
 
-		// build a single virtual host from HTTPRoutes
-		// HTTPRoutes apply at the Service level, not the port
-		// level so we don't need to generate this multiple times
-		// TODO respect `port` on parentRef
-		httpRoutesVirtualHost := t.buildHTTPVirtualHost(ctx, baseReporter, gw, gwListener, svc, httpRoutes)
+		// get Service-specific routes, these may target a single port of the Service
+		httpRoutes := t.waypointQueries.GetHTTPRoutesForService(kctx, ctx, &svc)
+		reportUnmatchedHTTPRoutes(baseReporter, svc, httpRoutes)
+
+		// build a virtual host from the HTTPRoutes applying to each port
+		// ports with the same routes share the same virtual host
+		virtualHosts := map[string]*ir.VirtualHost{}
+
+		// TCPRoutes apply to the non-HTTP ports
+		tcpRoutes := t.waypointQueries.GetTCPRoutesForService(kctx, ctx, &svc)
+		tcpRouteBackends := buildTCPRouteBackends(baseReporter, svc, tcpRoutes, attachedRoutes)
 
 		for _, svcPort := range svc.Ports {
-			filterChain, err := initServiceChain(svc, svcPort)
-			if err != nil {
-				// TODO if/when we support headless, initServiceChain should be infallible
-				logger.Debug(
-					"service had invalid or missing VIPs",
-					"service",
-					svc.GetName(),
-					"namespace",
-					svc.GetNamespace(),
-					"addresses",
-					svc.Addresses,
-				)
-				continue
-			}
+			filterChain := initServiceChain(svc, svcPort, prefixRanges)
 
 			if svcPort.IsHTTP() {
-				virtualHostForPort := httpRoutesVirtualHost
-				if httpRoutesVirtualHost == nil {
+				svcRoutes, key := httpRoutesForPort(httpRoutes, svcPort)
+				virtualHostForPort, ok := virtualHosts[key]
+				if !ok {
+					for _, r := range svcRoutes {
+						attachedRoutes.Insert(namespacedName(r))
+					}
+					virtualHostForPort = t.buildHTTPVirtualHost(ctx, baseReporter, gw, gwListener, svc, append(slices.Clone(gwRoutes), svcRoutes...))
+					virtualHosts[key] = virtualHostForPort
+				}
+				if virtualHostForPort == nil {
 					// no routes, build a host with a default route
 					// that just forwards traffic
 					virtualHostForPort = buildDefaultToPortVirtualHost(svc, svcPort)
@@ -342,9 +375,13 @@ func (t *waypointTranslator) buildServiceChains(
 				applyHTTPRBACFilters(&httpChain, httpRBAC)
 				httpOut = append(httpOut, httpChain)
 			} else {
+				backends, ok := tcpRouteBackends[svcPort.Port]
+				if !ok {
+					backends = []ir.BackendRefIR{defaultBackendRef(svc, svcPort)}
+				}
 				tcpChain := ir.TcpIR{
 					FilterChainCommon: filterChain,
-					BackendRefs:       []ir.BackendRefIR{svc.BackendRef(svcPort)},
+					BackendRefs:       backends,
 				}
 
 				// Apply TCP RBAC filters to this TCP filter chain
@@ -372,21 +409,39 @@ func filterChainName(
 func initServiceChain(
 	svc waypointquery.Service,
 	port waypointquery.ServicePort,
-) (ir.FilterChainCommon, error) {
-	prefixRanges, err := svc.CidrRanges()
-	if err != nil {
-		return ir.FilterChainCommon{}, err
-	}
+	prefixRanges []*v3.CidrRange,
+) ir.FilterChainCommon {
 	match := ir.FilterChainMatch{
 		PrefixRanges:    prefixRanges,
 		DestinationPort: &wrapperspb.UInt32Value{Value: uint32(port.Port)},
 	}
 
-	fcCommon := ir.FilterChainCommon{
+	return ir.FilterChainCommon{
 		FilterChainName: filterChainName(svc, port),
 		Matcher:         match,
 	}
-	return fcCommon, nil
+}
+
+// serviceCidrRanges returns the addresses the filter chains of the Service match.
+// Headless Services have no VIPs, so their chains match the addresses of their workloads.
+func (t *waypointTranslator) serviceCidrRanges(
+	kctx krt.HandlerContext,
+	ctx context.Context,
+	svc waypointquery.Service,
+) ([]*v3.CidrRange, error) {
+	if len(svc.Addresses) == 0 && svc.IsHeadless() {
+		return waypointquery.CidrRanges(t.waypointQueries.GetWorkloadAddresses(kctx, ctx, &svc))
+	}
+	return svc.CidrRanges()
+}
+
+// defaultBackendRef is where traffic for a port goes when no route applies to it.
+// Traffic for headless Services goes to the workload the client picked.
+func defaultBackendRef(svc waypointquery.Service, port waypointquery.ServicePort) ir.BackendRefIR {
+	if svc.IsHeadless() {
+		return svc.OriginalDstBackendRef(port)
+	}
+	return svc.BackendRef(port)
 }
 
 // buildHTTPVirtualHost translates httpRoutes attached to the Service
@@ -428,6 +483,88 @@ func (t *waypointTranslator) buildHTTPVirtualHost(
 	}
 }
 
+// buildTCPRouteBackends translates the TCPRoutes attached to a Service into the
+// weighted backends of its TCP filter chains, by port. Like on Gateway listeners,
+// only a single TCPRoute is supported per port, the oldest one wins.
+// Ports without an entry have no TCPRoute applying, their traffic is just forwarded.
+func buildTCPRouteBackends(
+	baseReporter reports.Reporter,
+	svc waypointquery.Service,
+	tcpRoutes []query.RouteInfo,
+	attachedRoutes sets.Set[types.NamespacedName],
+) map[int32][]ir.BackendRefIR {
+	if len(tcpRoutes) == 0 {
+		return nil
+	}
+
+	// oldest first, so that it claims the ports it applies to
+	tcpRoutes = slices.SortStableFunc(slices.Clone(tcpRoutes), compareAge)
+
+	routeByPort := map[int32]*ir.TcpRouteIR{}
+	for _, r := range tcpRoutes {
+		tRoute := r.Object.(*ir.TcpRouteIR)
+		parentRefReporter := baseReporter.Route(tRoute.SourceObject).ParentRef(&r.ParentRef)
+		if len(tRoute.SourceObject.Spec.Rules) != 1 {
+			parentRefReporter.SetCondition(reports.RouteCondition{
+				Type:    gwv1.RouteConditionAccepted,
+				Status:  metav1.ConditionFalse,
+				Reason:  gwv1.RouteReasonUnsupportedValue,
+				Message: "TCPRoutes must have exactly one rule",
+			})
+			continue
+		}
+
+		matched, claimed := false, false
+		for _, port := range svc.Ports {
+			if port.IsHTTP() || !appliesToPort(r, port) {
+				continue
+			}
+			matched = true
+			// the same route may claim a port through several parentRefs
+			if owner, ok := routeByPort[port.Port]; !ok || owner == tRoute {
+				routeByPort[port.Port] = tRoute
+				claimed = true
+			}
+		}
+		if !matched {
+			parentRefReporter.SetCondition(noMatchingPortCondition(svc, r, "TCP"))
+			continue
+		}
+		if !claimed {
+			parentRefReporter.SetCondition(reports.RouteCondition{
+				Type:    gwv1.RouteConditionAccepted,
+				Status:  metav1.ConditionFalse,
+				Reason:  gwv1.RouteReasonUnsupportedValue,
+				Message: fmt.Sprintf("an older TCPRoute is attached to the same ports of %s; only one TCPRoute per port is supported", svc.String()),
+			})
+			continue
+		}
+
+		attachedRoutes.Insert(namespacedName(r))
+		parentRefReporter.SetCondition(reports.RouteCondition{
+			Type:   gwv1.RouteConditionAccepted,
+			Status: metav1.ConditionTrue,
+			Reason: gwv1.RouteReasonAccepted,
+		})
+		for _, backend := range tRoute.Backends {
+			if backend.Err != nil || backend.BackendObject == nil {
+				err := backend.Err
+				if err == nil {
+					err = errors.New("not found")
+				}
+				query.ProcessBackendError(err, parentRefReporter)
+			}
+		}
+	}
+
+	// keep invalid backends so that their share of the traffic fails
+	backends := make(map[int32][]ir.BackendRefIR, len(routeByPort))
+	for port, tRoute := range routeByPort {
+		backends[port] = tRoute.Backends
+	}
+	return backends
+}
+
 // buildDefaultToPortVirtualHost builds a VirtualHost with no routes/policy
 // that will simply forward traffic to the same service port we matched for
 // a per-port filter chain for a single service.
@@ -443,7 +580,7 @@ func buildDefaultToPortVirtualHost(
 		Hostname: "*",
 		Rules: []ir.HttpRouteRuleMatchIR{{
 			Backends: []ir.HttpBackend{{
-				Backend:          svc.BackendRef(port),
+				Backend:          defaultBackendRef(svc, port),
 				AttachedPolicies: ir.AttachedPolicies{},
 			}},
 			MatchIndex: 0,
@@ -6,8 +6,11 @@ import (
 	"testing"
 
 	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
 	"github.com/kgateway-dev/kgateway/v2/pkg/utils/fsutils"
 	translatortest "github.com/kgateway-dev/kgateway/v2/test/translator"
 )
@@ -37,6 +40,8 @@ var cases = []struct {
 	{"Authz Policies - GatewayClass Ref Non-Root NS", "authz-gatewayclass-ref-nonrootns", exampleGw, ""},
 	{"Authz Policies - ServiceEntry", "authz-serviceentry", exampleGw, ""},
 	{"Authz Policies - Multi-Service", "authz-multi-service", exampleGw, ""},
+	{"TCPRoute on Service", "tcproute-svc", exampleGw, ""},
+	{"Headless Service", "headless-svc", exampleGw, ""},
 	{"No listeners", "empty", exampleGw, ""},
 }
 
@@ -62,3 +67,52 @@ func TestWaypointTranslator(t *testing.T) {
 		})
 	}
 }
+
+func TestWaypointTranslatorRoutePorts(t *testing.T) {
+	gomega.RegisterTestingT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dir := fsutils.MustGetThisDir()
+	translatortest.TestTranslation(
+		t,
+		ctx,
+		[]string{filepath.Join(dir, "testdata/input", "route-ports.yaml")},
+		filepath.Join(dir, "testdata/output", "route-ports.yaml"),
+		exampleGw,
+		func(_ types.NamespacedName, reportsMap reports.ReportMap) {
+			parentRefReport := func(routes map[types.NamespacedName]*reports.RouteReport, name string) *reports.ParentRefReport {
+				t.Helper()
+				routeReport := routes[types.NamespacedName{Namespace: "infra", Name: name}]
+				gomega.Expect(routeReport).NotTo(gomega.BeNil(), name)
+				gomega.Expect(routeReport.Parents).To(gomega.HaveLen(1), name)
+				for _, r := range routeReport.Parents {
+					return r
+				}
+				return nil
+			}
+			// routes in use only have their default conditions set when building the status
+			expectApplied := func(routes map[types.NamespacedName]*reports.RouteReport, name string) {
+				t.Helper()
+				gomega.Expect(parentRefReport(routes, name).Conditions).NotTo(gomega.ContainElement(
+					gomega.HaveField("Status", metav1.ConditionFalse),
+				), name)
+			}
+			expectIgnored := func(routes map[types.NamespacedName]*reports.RouteReport, name string, message string) {
+				t.Helper()
+				gomega.Expect(parentRefReport(routes, name).Conditions).To(gomega.ContainElement(gomega.And(
+					gomega.HaveField("Type", string(gwv1.RouteConditionAccepted)),
+					gomega.HaveField("Status", metav1.ConditionFalse),
+					gomega.HaveField("Reason", string(gwv1.RouteReasonNoMatchingParent)),
+					gomega.HaveField("Message", message),
+				)), name)
+			}
+
+			expectApplied(reportsMap.HTTPRoutes, "web")
+			expectApplied(reportsMap.TCPRoutes, "db")
+			expectIgnored(reportsMap.HTTPRoutes, "missing-port", "Service(infra/app) has no port 7777")
+			expectIgnored(reportsMap.HTTPRoutes, "http-on-tcp", "port 5432 of Service(infra/app) is not a HTTP port")
+			expectIgnored(reportsMap.TCPRoutes, "tcp-on-http", "port 9090 of Service(infra/app) is not a TCP port")
+		},
+	)
+}
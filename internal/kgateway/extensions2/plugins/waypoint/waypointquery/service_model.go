@@ -24,9 +24,20 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/serviceentry"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	pluginsdkir "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
 	"github.com/kgateway-dev/kgateway/v2/pkg/utils/stringutils"
 )
 
+// OriginalDstGK is the kind of the backends waypoints use for headless Services.
+// These backends forward traffic to the original destination of the connection,
+// the individual workload address the client resolved.
+var OriginalDstGK = schema.GroupKind{
+	Group: "waypoint",
+	Kind:  "OriginalDestination",
+}
+
+const OriginalDstClusterPrefix = "waypoint_original_dst"
+
 // ErrUnsupportedServiceType should never occur due to unexpected input.
 // If we see this, there is a bug and we're converting a non-Service type into Service.
 var ErrUnsupportedServiceType = errors.New("unsupported service type")
@@ -91,6 +102,29 @@ func (s Service) BackendRef(port ServicePort) ir.BackendRefIR {
 	}
 }
 
+// OriginalDstBackendRef is the default destination of headless Services, which routes
+// to the workload address the connection was originally sent to.
+func (s Service) OriginalDstBackendRef(port ServicePort) ir.BackendRefIR {
+	backendObj := s.OriginalDstBackendObject(port)
+	return ir.BackendRefIR{
+		ClusterName:   backendObj.ClusterName(),
+		BackendObject: &backendObj,
+	}
+}
+
+func (s Service) OriginalDstBackendObject(port ServicePort) ir.BackendObjectIR {
+	backend := pluginsdkir.NewBackendObjectIR(ir.ObjectSource{
+		Group:     OriginalDstGK.Group,
+		Kind:      OriginalDstGK.Kind,
+		Namespace: s.GetNamespace(),
+		Name:      s.GetName(),
+	}, port.Port, "")
+	backend.GvPrefix = OriginalDstClusterPrefix
+	backend.CanonicalHostname = s.Hostname()
+	backend.Obj = s.Object
+	return backend
+}
+
 // Destination generated by default for a Waypoint when the
 // Service or ServiceEntry has 0 routes attached.
 func (s Service) BackendObject(port uint32) ir.BackendObjectIR {
@@ -168,10 +202,14 @@ func (s Service) Provider() provider.ID {
 var ErrNoServiceVIPs = errors.New("service has no valid VIPs")
 
 func (svc *Service) CidrRanges() ([]*v3.CidrRange, error) {
-	// TODO support headless by passing dest hostname on TLVs and
-	// using that as a filter chain matcher
-	cidrRanges := ipsToCidrRanges(svc.Addresses)
-	if len(svc.Addresses) == 0 {
+	return CidrRanges(svc.Addresses)
+}
+
+// CidrRanges maps the given addresses to CidrRanges,
+// returning ErrNoServiceVIPs if there are no addresses.
+func CidrRanges(addresses []string) ([]*v3.CidrRange, error) {
+	cidrRanges := ipsToCidrRanges(addresses)
+	if len(addresses) == 0 {
 		return nil, ErrNoServiceVIPs
 	}
 	return cidrRanges, nil
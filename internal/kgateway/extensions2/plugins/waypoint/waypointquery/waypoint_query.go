@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	"istio.io/api/label"
+	networkingv1beta1 "istio.io/api/networking/v1beta1"
 	authcr "istio.io/client-go/pkg/apis/security/v1"
+	"istio.io/istio/pkg/config/labels"
 	"istio.io/istio/pkg/config/schema/gvr"
 	"istio.io/istio/pkg/kube/kclient"
 	"istio.io/istio/pkg/kube/krt"
@@ -21,6 +23,7 @@ import (
 
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/common"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/query"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 
@@ -48,6 +51,16 @@ type WaypointQueries interface {
 	// GetHTTPRoutesForService fetches HTTPRoutes that have the given Service in parentRefs.
 	GetHTTPRoutesForService(kctx krt.HandlerContext, ctx context.Context, svc *Service) []query.RouteInfo
 
+	// GetTCPRoutesForService fetches TCPRoutes that have the given Service in parentRefs.
+	GetTCPRoutesForService(kctx krt.HandlerContext, ctx context.Context, svc *Service) []query.RouteInfo
+
+	// GetWorkloadAddresses returns the addresses of the workloads selected by the given Service.
+	// This is used for headless Services, which have no VIPs.
+	GetWorkloadAddresses(kctx krt.HandlerContext, ctx context.Context, svc *Service) []string
+
+	// WaypointedServices returns the collection of Services and ServiceEntries attached to a waypoint.
+	WaypointedServices() krt.Collection[WaypointedService]
+
 	// GetAuthorizationPoliciesForGateway returns policies targeting a specific gateway
 	GetAuthorizationPoliciesForGateway(kctx krt.HandlerContext, ctx context.Context, gateway *gwv1.Gateway, rootNamespace string) []*authcr.AuthorizationPolicy
 
@@ -80,6 +93,7 @@ func NewQueries(
 	return &waypointQueries{
 		queries:            gwQueries,
 		commonCols:         commonCols,
+		podsByNamespace:    krt.NewNamespaceIndex(commonCols.Pods),
 		waypointedServices: waypointedServices,
 		servicesByWaypoint: servicesByWaypoint,
 		waypointByService:  waypointByService,
@@ -104,6 +118,7 @@ type waypointQueries struct {
 	waypointedServices krt.Collection[WaypointedService]
 	servicesByWaypoint krt.Index[types.NamespacedName, WaypointedService]
 	waypointByService  krt.Index[string, WaypointedService]
+	podsByNamespace    krt.Index[string, krtcollections.LocalityPod]
 	authzPolicies      krt.Collection[*authcr.AuthorizationPolicy]
 	byNamespace        krt.Index[string, *authcr.AuthorizationPolicy]
 	byTargetRefKey     krt.Index[ir.ObjectSource, *authcr.AuthorizationPolicy]
@@ -118,9 +133,42 @@ func (w *waypointQueries) GetHTTPRoutesForService(
 	kctx krt.HandlerContext,
 	ctx context.Context,
 	svc *Service,
+) []query.RouteInfo {
+	return w.getRoutesForService(kctx, ctx, svc, func(route ir.Route) bool {
+		_, ok := route.(*ir.HttpRouteIR)
+		return ok
+	})
+}
+
+func (w *waypointQueries) GetTCPRoutesForService(
+	kctx krt.HandlerContext,
+	ctx context.Context,
+	svc *Service,
+) []query.RouteInfo {
+	return w.getRoutesForService(kctx, ctx, svc, func(route ir.Route) bool {
+		_, ok := route.(*ir.TcpRouteIR)
+		return ok
+	})
+}
+
+// routeAttachment identifies the attachment of a route to a Service
+// through one of its keys, possibly scoped to a single port.
+type routeAttachment struct {
+	route types.NamespacedName
+	port  gwv1.PortNumber
+}
+
+// getRoutesForService fetches the routes of the kinds accepted by the filter
+// that have the given Service in parentRefs. A RouteInfo is returned for
+// every distinct port the parentRefs of a route target.
+func (w *waypointQueries) getRoutesForService(
+	kctx krt.HandlerContext,
+	ctx context.Context,
+	svc *Service,
+	filter func(ir.Route) bool,
 ) []query.RouteInfo {
 	var out []query.RouteInfo
-	seen := sets.New[types.NamespacedName]()
+	seen := sets.New[routeAttachment]()
 	for _, key := range svc.Keys() {
 		nns := types.NamespacedName{
 			// TODO  routes index requires a namespace
@@ -129,39 +177,44 @@ func (w *waypointQueries) GetHTTPRoutesForService(
 			Name:      key.GetName(),
 		}
 		routes := w.commonCols.Routes.RoutesFor(kctx, nns, key.Group, key.Kind)
-		out = append(out, slices.MapFilter(routes, func(route ir.Route) *query.RouteInfo {
-			if seen.InsertContains(types.NamespacedName{
+		for _, route := range routes {
+			if !filter(route) {
+				continue
+			}
+			nns := types.NamespacedName{
 				Namespace: route.GetNamespace(),
 				Name:      route.GetName(),
-			}) {
-				return nil
 			}
-
-			// resolve delegation
-			pRef := findParentRef(
+			pRefs := findParentRefs(
 				key,
 				route.GetNamespace(),
 				route.GetParentRefs(),
 				key.GetGroupKind(),
 			)
-			if pRef == nil {
-				return nil
+			for _, pRef := range pRefs {
+				if seen.InsertContains(routeAttachment{route: nns, port: ptr.Deref(pRef.Port, 0)}) {
+					continue
+				}
+				// resolve delegation
+				if routeInfo := w.queries.GetRouteChain(kctx, ctx, route, nil, *pRef); routeInfo != nil {
+					out = append(out, *routeInfo)
+				}
 			}
-			return w.queries.GetRouteChain(kctx, ctx, route, nil, *pRef)
-		})...)
+		}
 	}
 
 	return out
 }
 
-// findParentRef that targets the given object
-func findParentRef(
+// findParentRefs that target the given object
+func findParentRefs(
 	key ir.ObjectSource,
 	routeNs string,
 	parentRefs []gwv1.ParentReference,
 	gk schema.GroupKind,
-) *gwv1.ParentReference {
+) []*gwv1.ParentReference {
 	// TODO peering will need to consider original and simulated GK
+	var out []*gwv1.ParentReference
 	matchingParentRefs := findParentRefsForType(parentRefs, gk.Group, gk.Kind)
 	for _, pr := range matchingParentRefs {
 		if string(pr.Name) != key.GetName() {
@@ -170,7 +223,8 @@ func findParentRef(
 
 		// global key, no namespace
 		if key.GetNamespace() == "" && pr.Namespace == nil {
-			return pr
+			out = append(out, pr)
+			continue
 		}
 
 		// default to routes's own ns if not specified on the ref
@@ -179,10 +233,45 @@ func findParentRef(
 			ns = string(*pr.Namespace)
 		}
 		if key.GetNamespace() == ns {
-			return pr
+			out = append(out, pr)
 		}
 	}
-	return nil
+	return out
+}
+
+func (w *waypointQueries) GetWorkloadAddresses(
+	kctx krt.HandlerContext,
+	ctx context.Context,
+	svc *Service,
+) []string {
+	switch o := svc.Object.(type) {
+	case *corev1.Service:
+		if len(o.Spec.Selector) == 0 {
+			return nil
+		}
+		selector := labels.Instance(o.Spec.Selector)
+		pods := krt.Fetch(kctx, w.commonCols.Pods, krt.FilterIndex(w.podsByNamespace, o.GetNamespace()))
+		var addrs []string
+		for _, pod := range pods {
+			if selector.SubsetOf(pod.AugmentedLabels) {
+				addrs = append(addrs, pod.Addresses...)
+			}
+		}
+		return addrs
+	case *networkingclient.ServiceEntry:
+		return slices.MapFilter(o.Spec.GetEndpoints(), func(we *networkingv1beta1.WorkloadEntry) *string {
+			if we.GetAddress() == "" {
+				return nil
+			}
+			return ptr.To(we.GetAddress())
+		})
+	default:
+		return nil
+	}
+}
+
+func (w *waypointQueries) WaypointedServices() krt.Collection[WaypointedService] {
+	return w.waypointedServices
 }
 
 func findParentRefsForType(refs []gwv1.ParentReference, targetGroup, targetKind string) []*gwv1.ParentReference {
@@ -24,9 +24,11 @@ type (
 
 	BackendObjectIR                   = ir.BackendObjectIR
 	GwTranslationCtx                  = ir.GwTranslationCtx
+	FilterChainContext                = ir.FilterChainContext
 	ListenerContext                   = ir.ListenerContext
 	ObjectSource                      = ir.ObjectSource
 	PolicyIR                          = ir.PolicyIR
+	PolicyIRStatusMessage             = ir.PolicyIRStatusMessage
 	PolicyWrapper                     = ir.PolicyWrapper
 	ProxyTranslationPass              = ir.ProxyTranslationPass
 	UnimplementedProxyTranslationPass = ir.UnimplementedProxyTranslationPass
@@ -48,18 +50,24 @@ type (
 	Resources         = ir.Resources
 	TcpRouteIR        = ir.TcpRouteIR
 	TlsRouteIR        = ir.TlsRouteIR
+	UdpRouteIR        = ir.UdpRouteIR
 
 	Listener = ir.Listener
 
-	AppProtocol        = ir.AppProtocol
-	FilterChainMatch   = ir.FilterChainMatch
-	GatewayIR          = ir.GatewayIR
-	HttpFilterChainIR  = ir.HttpFilterChainIR
-	ListenerIR         = ir.ListenerIR
-	Namespaced         = ir.Namespaced
-	RouteConfigContext = ir.RouteConfigContext
-	TcpIR              = ir.TcpIR
-	TlsBundle          = ir.TlsBundle
+	AppProtocol           = ir.AppProtocol
+	FilterChainMatch      = ir.FilterChainMatch
+	GatewayIR             = ir.GatewayIR
+	HttpFilterChainIR     = ir.HttpFilterChainIR
+	ListenerIR            = ir.ListenerIR
+	Namespaced            = ir.Namespaced
+	RouteConfigContext    = ir.RouteConfigContext
+	TcpIR                 = ir.TcpIR
+	TlsBundle             = ir.TlsBundle
+	TlsCertificate        = ir.TlsCertificate
+	TlsParameters         = ir.TlsParameters
+	FrontendTlsValidation = ir.FrontendTlsValidation
+	SubjectAltName        = ir.SubjectAltName
+	UdpIR                 = ir.UdpIR
 
 	CustomEnvoyFilter = ir.CustomEnvoyFilter
 	VirtualHost       = ir.VirtualHost
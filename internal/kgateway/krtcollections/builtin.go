@@ -36,6 +36,10 @@ import (
 
 const statefulSessionFilterName = "envoy.filters.http.stateful_session"
 
+// DefaultRetryOn is the set of conditions requests are retried on when retries are configured
+// without explicit retry conditions, by an HTTPRoute rule or a TrafficPolicy.
+const DefaultRetryOn = "cancelled,connect-failure,refused-stream,retriable-headers,retriable-status-codes,unavailable"
+
 type applyToRoute interface {
 	apply(outputRoute *envoy_config_route_v3.Route)
 }
@@ -149,6 +153,8 @@ func (r ruleIr) apply(outputRoute *envoy_config_route_v3.Route) error {
 	if outputRoute == nil || outputRoute.GetRoute() == nil {
 		return nil
 	}
+	// The backend request timeout of the rule is only the per-try timeout when the rule sets
+	// retry, whether or not a TrafficPolicy also sets a retry policy.
 	r.applyTimeouts(outputRoute, r.retry != nil)
 	r.applyRetry(outputRoute)
 	if r.sessionPersistence != nil {
@@ -222,10 +228,9 @@ func convertRetry(retry *gwv1.HTTPRouteRetry, timeout *gwv1.HTTPRouteTimeouts) *
 		return nil
 	}
 
-	retryPolicy := &envoy_config_route_v3.RetryPolicy{
-		NumRetries: &wrapperspb.UInt32Value{Value: 1},
-		RetryOn:    "cancelled,connect-failure,refused-stream,retriable-headers,retriable-status-codes,unavailable",
-	}
+	// Only the values set on the rule are populated here so that they can be merged into a
+	// retry policy set by a TrafficPolicy. Defaults are applied in applyRetry.
+	retryPolicy := &envoy_config_route_v3.RetryPolicy{}
 
 	if retry.Attempts != nil {
 		retryPolicy.NumRetries = &wrapperspb.UInt32Value{Value: uint32(*retry.Attempts)}
@@ -266,11 +271,36 @@ func convertRetry(retry *gwv1.HTTPRouteRetry, timeout *gwv1.HTTPRouteTimeouts) *
 	return retryPolicy
 }
 
+// applyRetry sets the retry policy of the rule on the route. If the route already has a retry
+// policy, e.g. from a TrafficPolicy, the values set on the rule take precedence over its values.
 func (r ruleIr) applyRetry(route *envoy_config_route_v3.Route) {
 	if r.retry == nil {
 		return
 	}
-	route.GetRoute().RetryPolicy = r.retry
+
+	var retryPolicy *envoy_config_route_v3.RetryPolicy
+	if existing := route.GetRoute().GetRetryPolicy(); existing != nil {
+		retryPolicy = proto.Clone(existing).(*envoy_config_route_v3.RetryPolicy)
+	} else {
+		// The number of retries is left to the Envoy default of 1 when the rule doesn't set
+		// attempts, so that a TrafficPolicy targeting the listener or Gateway can still set it.
+		retryPolicy = &envoy_config_route_v3.RetryPolicy{
+			RetryOn: DefaultRetryOn,
+		}
+	}
+	if r.retry.GetNumRetries() != nil {
+		retryPolicy.NumRetries = r.retry.GetNumRetries()
+	}
+	if len(r.retry.GetRetriableStatusCodes()) > 0 {
+		retryPolicy.RetriableStatusCodes = r.retry.GetRetriableStatusCodes()
+	}
+	if r.retry.GetRetryBackOff() != nil {
+		retryPolicy.RetryBackOff = r.retry.GetRetryBackOff()
+	}
+	if r.retry.GetPerTryTimeout() != nil {
+		retryPolicy.PerTryTimeout = r.retry.GetPerTryTimeout()
+	}
+	route.GetRoute().RetryPolicy = retryPolicy
 }
 
 func convertSessionPersistence(sessionPersistence *gwv1.SessionPersistence) *anypb.Any {
@@ -141,19 +141,21 @@ func transformK8sEndpoints(inputs EndpointsInputs,
 				logger.Warn(warn) //nolint:sloglint // ignore formatting
 			}
 		}()
-		key := types.NamespacedName{
-			Namespace: backend.Namespace,
-			Name:      backend.Name,
-		}
-		kubeSvcLogger := logger.With("kubesvc", key)
-
 		kubeBackend, ok := backend.Obj.(*corev1.Service)
 		// only care about kube backend
 		if !ok {
-			kubeSvcLogger.Debug("not kube backend")
+			logger.Debug("not kube backend", "backend", backend.ResourceName())
 			return nil
 		}
 
+		// key by the Service rather than the backend, backends derived from a Service
+		// (e.g. DestinationRule subsets) are named differently than the Service
+		key := types.NamespacedName{
+			Namespace: kubeBackend.Namespace,
+			Name:      kubeBackend.Name,
+		}
+		kubeSvcLogger := logger.With("kubesvc", key)
+
 		kubeSvcLogger.Debug("building endpoints")
 
 		kubeSvcPort, singlePortSvc := findPortForService(kubeBackend, uint32(backend.Port))
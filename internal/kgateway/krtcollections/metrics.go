@@ -14,6 +14,7 @@ const (
 	collectionSubsystem           = "collection"
 	collectionNameLabel           = "collection"
 	gatewayResourceCollectionName = "gateway_resources"
+	xdsSubsystem                  = "xds"
 )
 
 var (
@@ -62,6 +63,23 @@ var (
 		},
 		[]string{"namespace", "resource"},
 	)
+
+	rejectedVersionsTotal = metrics.NewCounter(
+		metrics.CounterOpts{
+			Subsystem: xdsSubsystem,
+			Name:      "rejected_versions_total",
+			Help:      "Total xDS responses rejected (NACKed) by the proxies of a Gateway",
+		},
+		[]string{"name", "namespace", "type_url"},
+	)
+	rejectedVersions = metrics.NewGauge(
+		metrics.GaugeOpts{
+			Subsystem: xdsSubsystem,
+			Name:      "rejected_versions",
+			Help:      "Current number of proxies of a Gateway whose last xDS response was rejected",
+		},
+		[]string{"name", "namespace", "type_url"},
+	)
 )
 
 func gwResourceMetricEventHandler[T client.Object](o krt.Event[T], resourceName string) {
@@ -212,4 +230,6 @@ func ResetMetrics() {
 	transformDuration.Reset()
 	transformsRunning.Reset()
 	collectionResources.Reset()
+	rejectedVersionsTotal.Reset()
+	rejectedVersions.Reset()
 }
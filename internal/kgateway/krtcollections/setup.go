@@ -125,6 +125,11 @@ func InitCollections(
 		gwResourceMetricEventHandler(o, "TLSRoute")
 	})
 
+	udpRoutes := krt.WrapClient(kclient.NewDelayedInformer[*gwv1a2.UDPRoute](istioClient, gvr.UDPRoute, kubetypes.StandardInformer, filter), krtopts.ToOptions("UDPRoute")...)
+	metrics.RegisterEvents(udpRoutes, func(o krt.Event[*gwv1a2.UDPRoute]) {
+		gwResourceMetricEventHandler(o, "UDPRoute")
+	})
+
 	grpcRoutes := krt.WrapClient(kclient.NewFiltered[*gwv1.GRPCRoute](istioClient, filter), krtopts.ToOptions("GRPCRoute")...)
 	metrics.RegisterEvents(grpcRoutes, func(o krt.Event[*gwv1.GRPCRoute]) {
 		gwResourceMetricEventHandler(o, "GRPCRoute")
@@ -150,7 +155,7 @@ func InitCollections(
 	endpointIRs := initEndpoints(plugins, krtopts)
 
 	gateways := NewGatewayIndex(krtopts, controllerName, policies, kubeRawGateways, kubeRawListenerSets, gatewayClasses, namespaces)
-	routes := NewRoutesIndex(krtopts, httpRoutes, grpcRoutes, tcproutes, tlsRoutes, policies, backendIndex, refgrants, globalSettings)
+	routes := NewRoutesIndex(krtopts, httpRoutes, grpcRoutes, tcproutes, tlsRoutes, udpRoutes, policies, backendIndex, refgrants, globalSettings)
 	return gateways, routes, backendIndex, endpointIRs
 }
 
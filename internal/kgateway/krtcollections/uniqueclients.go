@@ -39,10 +39,17 @@ func newConnectedClient(uniqueClientName string) ConnectedClient {
 // we grab it's pod name/namesspace from the requests node->id.
 // We then fetch that pod to get its labels, create a UniqlyConnectedClient and it them to the collection.
 
+// streamKey identifies an xDS stream. State-of-the-world and delta streams are numbered
+// independently by the xDS server, so the ids alone are not unique.
+type streamKey struct {
+	id    int64
+	delta bool
+}
+
 type callbacksCollection struct {
 	logger           *slog.Logger
 	augmentedPods    krt.Collection[LocalityPod]
-	clients          map[int64]ConnectedClient
+	clients          map[streamKey]ConnectedClient
 	uniqClientsCount map[string]uint64
 	uniqClients      map[string]ir.UniqlyConnectedClient
 	stateLock        sync.RWMutex
@@ -52,6 +59,7 @@ type callbacksCollection struct {
 
 type callbacks struct {
 	collection        atomic.Pointer[callbacksCollection]
+	nacks             *XdsNacks
 	extraXDSCallbacks xdsserver.Callbacks
 }
 
@@ -60,14 +68,19 @@ type UniquelyConnectedClientsBulider func(ctx context.Context, krtOpts krtutil.K
 
 // THIS IS THE SET OF THINGS WE RUN TRANSLATION FOR
 // add returned callbacks to the xds server.
+// If nacks is not nil, the responses rejected by the clients are recorded in it.
 
-func NewUniquelyConnectedClients(extraXDSCallbacks xdsserver.Callbacks) (xdsserver.Callbacks, UniquelyConnectedClientsBulider) {
-	cb := &callbacks{extraXDSCallbacks: extraXDSCallbacks}
+func NewUniquelyConnectedClients(extraXDSCallbacks xdsserver.Callbacks, nacks *XdsNacks) (xdsserver.Callbacks, UniquelyConnectedClientsBulider) {
+	cb := &callbacks{extraXDSCallbacks: extraXDSCallbacks, nacks: nacks}
 
 	envoycb := xdsserver.CallbackFuncs{
-		StreamClosedFunc:  cb.OnStreamClosed,
-		StreamRequestFunc: cb.OnStreamRequest,
-		FetchRequestFunc:  cb.OnFetchRequest,
+		StreamClosedFunc:        cb.OnStreamClosed,
+		StreamRequestFunc:       cb.OnStreamRequest,
+		StreamResponseFunc:      cb.OnStreamResponse,
+		DeltaStreamClosedFunc:   cb.OnDeltaStreamClosed,
+		StreamDeltaRequestFunc:  cb.OnStreamDeltaRequest,
+		StreamDeltaResponseFunc: cb.OnStreamDeltaResponse,
+		FetchRequestFunc:        cb.OnFetchRequest,
 	}
 	return envoycb, buildCollection(cb)
 }
@@ -78,7 +91,7 @@ func buildCollection(callbacks *callbacks) UniquelyConnectedClientsBulider {
 		col := &callbacksCollection{
 			logger:           logger,
 			augmentedPods:    augmentedPods,
-			clients:          make(map[int64]ConnectedClient),
+			clients:          make(map[streamKey]ConnectedClient),
 			uniqClientsCount: make(map[string]uint64),
 			uniqClients:      make(map[string]ir.UniqlyConnectedClient),
 			trigger:          trigger,
@@ -102,26 +115,41 @@ func (x *callbacks) OnStreamClosed(sid int64, node *envoy_config_core_v3.Node) {
 		x.extraXDSCallbacks.OnStreamClosed(sid, node)
 	}
 
+	x.nacks.streamClosed(streamKey{id: sid})
+	c := x.collection.Load()
+	if c == nil {
+		return
+	}
+	c.streamClosed(streamKey{id: sid})
+}
+
+// OnDeltaStreamClosed is called immediately prior to closing a delta xDS stream with a stream ID.
+func (x *callbacks) OnDeltaStreamClosed(sid int64, node *envoy_config_core_v3.Node) {
+	if x.extraXDSCallbacks != nil {
+		x.extraXDSCallbacks.OnDeltaStreamClosed(sid, node)
+	}
+
+	x.nacks.streamClosed(streamKey{id: sid, delta: true})
 	c := x.collection.Load()
 	if c == nil {
 		return
 	}
-	c.streamClosed(sid)
+	c.streamClosed(streamKey{id: sid, delta: true})
 }
 
-func (x *callbacksCollection) streamClosed(sid int64) {
-	ucc := x.del(sid)
+func (x *callbacksCollection) streamClosed(key streamKey) {
+	ucc := x.del(key)
 	if ucc != nil {
 		x.trigger.TriggerRecomputation()
 	}
 }
 
-func (x *callbacksCollection) del(sid int64) *ir.UniqlyConnectedClient {
+func (x *callbacksCollection) del(key streamKey) *ir.UniqlyConnectedClient {
 	x.stateLock.Lock()
 	defer x.stateLock.Unlock()
 
-	c, ok := x.clients[sid]
-	delete(x.clients, sid)
+	c, ok := x.clients[key]
+	delete(x.clients, key)
 	if ok {
 		resourceName := c.uniqueClientName
 		current := x.uniqClientsCount[resourceName]
@@ -137,22 +165,26 @@ func (x *callbacksCollection) del(sid int64) *ir.UniqlyConnectedClient {
 }
 
 func roleFromRequest(r *envoy_service_discovery_v3.DiscoveryRequest) string {
-	return r.GetNode().GetMetadata().GetFields()[xds.RoleKey].GetStringValue()
+	return roleFromNode(r.GetNode())
+}
+
+func roleFromNode(node *envoy_config_core_v3.Node) string {
+	return node.GetMetadata().GetFields()[xds.RoleKey].GetStringValue()
 }
 
-func (x *callbacksCollection) add(sid int64, r *envoy_service_discovery_v3.DiscoveryRequest) (string, bool, error) {
+func (x *callbacksCollection) add(key streamKey, node *envoy_config_core_v3.Node) (string, bool, error) {
 	var pod *LocalityPod
 	// see if user wants to use pod locality info
 	usePod := x.augmentedPods != nil
-	if usePod && r.GetNode() != nil {
-		podRef := getRef(r.GetNode())
+	if usePod && node != nil {
+		podRef := getRef(node)
 		k := krt.Named{Name: podRef.Name, Namespace: podRef.Namespace}.ResourceName()
 		pod = x.augmentedPods.GetKey(k)
 	}
 	addedNew := false
 	x.stateLock.Lock()
 	defer x.stateLock.Unlock()
-	c, ok := x.clients[sid]
+	c, ok := x.clients[key]
 	if !ok {
 		var locality ir.PodLocality
 		var ns string
@@ -160,19 +192,19 @@ func (x *callbacksCollection) add(sid int64, r *envoy_service_discovery_v3.Disco
 		if usePod {
 			if pod == nil {
 				// we need to use the pod locality info, so it's an error if we can't get the pod
-				return "", false, fmt.Errorf("pod not found for node %v", r.GetNode())
+				return "", false, fmt.Errorf("pod not found for node %v", node)
 			} else {
 				locality = pod.Locality
 				ns = pod.Namespace
 				labels = pod.AugmentedLabels
 			}
 		}
-		role := roleFromRequest(r)
+		role := roleFromNode(node)
 		x.logger.Debug("adding xds client", "locality", locality, "ns", ns, "labels", labels, "role", role)
 		// TODO: modify request to include the label that are relevant for the client?
 		ucc := ir.NewUniqlyConnectedClient(role, ns, labels, locality)
 		c = newConnectedClient(ucc.ResourceName())
-		x.clients[sid] = c
+		x.clients[key] = c
 		currentUnique := x.uniqClientsCount[ucc.ResourceName()]
 		x.uniqClientsCount[ucc.ResourceName()] = currentUnique + 1
 		if currentUnique == 0 {
@@ -192,6 +224,9 @@ func (x *callbacks) OnStreamRequest(sid int64, r *envoy_service_discovery_v3.Dis
 		}
 	}
 
+	// record the request before the role of the node is augmented with the unique client
+	x.nacks.streamRequest(streamKey{id: sid}, r.GetNode(), r.GetTypeUrl(), r.GetResponseNonce(), r.GetErrorDetail())
+
 	role := roleFromRequest(r)
 	// as gloo-edge and kgateway share a control plane, check that this collection only handles kgateway clients
 	// TODO remove this check if it's no longer needed
@@ -202,17 +237,67 @@ func (x *callbacks) OnStreamRequest(sid int64, r *envoy_service_discovery_v3.Dis
 	if c == nil {
 		return errors.New("kgateway not initialized")
 	}
-	return c.newStream(sid, r)
+	return c.newStream(streamKey{id: sid}, r.GetNode())
+}
+
+// OnStreamResponse is called immediately prior to sending a response on a stream.
+func (x *callbacks) OnStreamResponse(
+	ctx context.Context,
+	sid int64,
+	req *envoy_service_discovery_v3.DiscoveryRequest,
+	resp *envoy_service_discovery_v3.DiscoveryResponse,
+) {
+	if x.extraXDSCallbacks != nil {
+		x.extraXDSCallbacks.OnStreamResponse(ctx, sid, req, resp)
+	}
+
+	x.nacks.streamResponse(streamKey{id: sid}, resp.GetTypeUrl(), resp.GetNonce(), resp.GetVersionInfo())
+}
+
+// OnStreamDeltaRequest is called once a request is received on a delta stream.
+// Returning an error will end processing and close the stream. OnDeltaStreamClosed will still be called.
+func (x *callbacks) OnStreamDeltaRequest(sid int64, r *envoy_service_discovery_v3.DeltaDiscoveryRequest) error {
+	if x.extraXDSCallbacks != nil {
+		if err := x.extraXDSCallbacks.OnStreamDeltaRequest(sid, r); err != nil {
+			return err
+		}
+	}
+
+	x.nacks.streamRequest(streamKey{id: sid, delta: true}, r.GetNode(), r.GetTypeUrl(), r.GetResponseNonce(), r.GetErrorDetail())
+
+	// only the first request of a delta stream is guaranteed to carry the node
+	role := roleFromNode(r.GetNode())
+	if !xds.IsKubeGatewayCacheKey(role) {
+		return nil
+	}
+	c := x.collection.Load()
+	if c == nil {
+		return errors.New("kgateway not initialized")
+	}
+	return c.newStream(streamKey{id: sid, delta: true}, r.GetNode())
+}
+
+// OnStreamDeltaResponse is called immediately prior to sending a response on a delta stream.
+func (x *callbacks) OnStreamDeltaResponse(
+	sid int64,
+	req *envoy_service_discovery_v3.DeltaDiscoveryRequest,
+	resp *envoy_service_discovery_v3.DeltaDiscoveryResponse,
+) {
+	if x.extraXDSCallbacks != nil {
+		x.extraXDSCallbacks.OnStreamDeltaResponse(sid, req, resp)
+	}
+
+	x.nacks.streamResponse(streamKey{id: sid, delta: true}, resp.GetTypeUrl(), resp.GetNonce(), resp.GetSystemVersionInfo())
 }
 
-func (x *callbacksCollection) newStream(sid int64, r *envoy_service_discovery_v3.DiscoveryRequest) error {
-	ucc, isNew, err := x.add(sid, r)
+func (x *callbacksCollection) newStream(key streamKey, node *envoy_config_core_v3.Node) error {
+	ucc, isNew, err := x.add(key, node)
 	if err != nil {
 		x.logger.Debug("error processing xds client", "error", err)
 		return err
 	}
 	if ucc != "" {
-		nodeMd := r.GetNode().GetMetadata()
+		nodeMd := node.GetMetadata()
 		if nodeMd == nil {
 			nodeMd = &structpb.Struct{}
 		}
@@ -225,7 +310,7 @@ func (x *callbacksCollection) newStream(sid int64, r *envoy_service_discovery_v3
 		// with how the snapshot is inserted to the cache for the proxy - it needs to be done with
 		// the unique client resource name as well.
 		nodeMd.GetFields()[xds.RoleKey] = structpb.NewStringValue(ucc)
-		r.GetNode().Metadata = nodeMd
+		node.Metadata = nodeMd
 		if isNew {
 			x.trigger.TriggerRecomputation()
 		}
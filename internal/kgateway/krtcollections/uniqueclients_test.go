@@ -185,7 +185,7 @@ func TestUniqueClients(t *testing.T) {
 				pods.WaitUntilSynced(context.Background().Done())
 			}
 
-			cb, uccBuilder := NewUniquelyConnectedClients(nil)
+			cb, uccBuilder := NewUniquelyConnectedClients(nil, nil)
 			ucc := uccBuilder(context.Background(), krtutil.KrtOptions{}, pods)
 			ucc.WaitUntilSynced(context.Background().Done())
 
@@ -239,3 +239,40 @@ func TestUniqueClients(t *testing.T) {
 		})
 	}
 }
+
+func TestUniqueClientsDeltaStreams(t *testing.T) {
+	g := NewWithT(t)
+
+	cb, uccBuilder := NewUniquelyConnectedClients(nil, nil)
+	ucc := uccBuilder(context.Background(), krtutil.KrtOptions{}, nil)
+	ucc.WaitUntilSynced(context.Background().Done())
+
+	role := wellknown.GatewayApiProxyValue + "~best-proxy-role"
+	newNode := func() *corev3.Node {
+		return &corev3.Node{
+			Id: "podname.ns",
+			Metadata: &structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					xds.RoleKey: structpb.NewStringValue(role),
+				},
+			},
+		}
+	}
+
+	// state-of-the-world and delta streams are numbered independently, so both use id 1
+	g.Expect(cb.OnStreamRequest(1, &envoy_service_discovery_v3.DiscoveryRequest{Node: newNode()})).To(Succeed())
+	deltaReq := &envoy_service_discovery_v3.DeltaDiscoveryRequest{Node: newNode()}
+	g.Expect(cb.OnStreamDeltaRequest(1, deltaReq)).To(Succeed())
+	g.Expect(deltaReq.GetNode().GetMetadata().GetFields()[xds.RoleKey].GetStringValue()).To(Equal(role))
+
+	// subsequent delta requests don't carry the node
+	g.Expect(cb.OnStreamDeltaRequest(1, &envoy_service_discovery_v3.DeltaDiscoveryRequest{})).To(Succeed())
+
+	g.Eventually(ucc.List, "1s").Should(HaveLen(1))
+
+	cb.OnStreamClosed(1, nil)
+	g.Consistently(ucc.List, "100ms").Should(HaveLen(1))
+
+	cb.OnDeltaStreamClosed(1, nil)
+	g.Eventually(ucc.List, "5s").Should(BeEmpty())
+}
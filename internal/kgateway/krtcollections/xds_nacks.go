@@ -0,0 +1,266 @@
+package krtcollections
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils/krtutil"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/xds"
+	"github.com/kgateway-dev/kgateway/v2/pkg/metrics"
+)
+
+// XdsNack is an xDS response rejected by a proxy.
+type XdsNack struct {
+	// Gateway is the Gateway whose configuration the proxy rejected.
+	Gateway types.NamespacedName `json:"gateway"`
+	// NodeID is the node id of the proxy, i.e. <pod name>.<pod namespace>.
+	NodeID string `json:"nodeId"`
+	// TypeUrl is the type url of the rejected resources.
+	TypeUrl string `json:"typeUrl"`
+	// Version is the version of the rejected response.
+	Version string `json:"version"`
+	// Error is the reason given by the proxy.
+	Error string `json:"error"`
+	// Time is when the proxy rejected the response.
+	Time time.Time `json:"time"`
+}
+
+func (n XdsNack) ResourceName() string {
+	return n.NodeID + xds.KeyDelimiter + n.TypeUrl
+}
+
+func (n XdsNack) Equals(in XdsNack) bool {
+	return n.Gateway == in.Gateway &&
+		n.NodeID == in.NodeID &&
+		n.TypeUrl == in.TypeUrl &&
+		n.Version == in.Version &&
+		n.Error == in.Error &&
+		n.Time.Equal(in.Time)
+}
+
+// maxInFlightResponses bounds the responses tracked per type url of a stream, in case a proxy
+// doesn't answer every response.
+const maxInFlightResponses = 32
+
+// sentResponse is a response sent on a stream for a type url.
+type sentResponse struct {
+	nonce   string
+	version string
+}
+
+type nackStream struct {
+	nodeID  string
+	gateway types.NamespacedName
+	// responses are the responses sent per type url that the proxy didn't ACK or NACK yet. Delta
+	// streams may have several responses of a type url in flight.
+	responses map[string][]sentResponse
+	// nacks are the rejected responses, per type url, that were not followed by an ACK yet.
+	nacks map[string]XdsNack
+	last  *XdsNack
+}
+
+// XdsNacks tracks the xDS responses that the connected proxies rejected. A proxy rejects (NACKs)
+// a response by sending a request with the nonce of the response and an error detail, and accepts
+// (ACKs) it by sending a request with the nonce and no error detail.
+type XdsNacks struct {
+	lock    sync.Mutex
+	streams map[streamKey]*nackStream
+	now     func() time.Time
+
+	trigger *krt.RecomputeTrigger
+}
+
+func NewXdsNacks() *XdsNacks {
+	return &XdsNacks{
+		streams: make(map[streamKey]*nackStream),
+		now:     time.Now,
+		trigger: krt.NewRecomputeTrigger(true),
+	}
+}
+
+// Collection returns the rejected responses that were not followed by an ACK, i.e. the
+// configuration that is currently not applied by proxies.
+func (n *XdsNacks) Collection(krtOpts krtutil.KrtOptions) krt.Collection[XdsNack] {
+	return krt.NewManyFromNothing(
+		func(ctx krt.HandlerContext) []XdsNack {
+			n.trigger.MarkDependant(ctx)
+			return n.activeNacks()
+		},
+		krtOpts.ToOptions("XdsNacks")...,
+	)
+}
+
+// LastNacks returns the last response rejected by each connected proxy, keyed by node id, even if
+// the proxy accepted a later response.
+func (n *XdsNacks) LastNacks() map[string]XdsNack {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	out := map[string]XdsNack{}
+	for _, s := range n.streams {
+		if s.last == nil {
+			continue
+		}
+		if prev, ok := out[s.nodeID]; !ok || prev.Time.Before(s.last.Time) {
+			out[s.nodeID] = *s.last
+		}
+	}
+	return out
+}
+
+func (n *XdsNacks) activeNacks() []XdsNack {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	var out []XdsNack
+	for _, s := range n.streams {
+		for _, nack := range s.nacks {
+			out = append(out, nack)
+		}
+	}
+	// a proxy may have more than one stream open while it reconnects, keep its latest NACK
+	slices.SortFunc(out, func(a, b XdsNack) int {
+		if c := strings.Compare(a.ResourceName(), b.ResourceName()); c != 0 {
+			return c
+		}
+		return b.Time.Compare(a.Time)
+	})
+	return slices.CompactFunc(out, func(a, b XdsNack) bool {
+		return a.ResourceName() == b.ResourceName()
+	})
+}
+
+// streamRequest is called for every request received on a stream. The node is only required on the
+// first request of a stream. Requests of streams whose node isn't a kgateway proxy are ignored.
+func (n *XdsNacks) streamRequest(
+	key streamKey,
+	node *envoy_config_core_v3.Node,
+	typeUrl, nonce string,
+	errorDetail *status.Status,
+) {
+	if n == nil {
+		return
+	}
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	s := n.streams[key]
+	if s == nil {
+		gateway, ok := gatewayFromRole(roleFromNode(node))
+		if !ok {
+			return
+		}
+		s = &nackStream{
+			nodeID:    node.GetId(),
+			gateway:   gateway,
+			responses: map[string][]sentResponse{},
+			nacks:     map[string]XdsNack{},
+		}
+		n.streams[key] = s
+	}
+
+	// the initial request of a type url has no nonce
+	if nonce == "" {
+		return
+	}
+	responses := s.responses[typeUrl]
+	i := slices.IndexFunc(responses, func(r sentResponse) bool {
+		return r.nonce == nonce
+	})
+	if i < 0 {
+		return
+	}
+	resp := responses[i]
+	// the proxy answers the responses in order, the older ones were answered already
+	s.responses[typeUrl] = responses[i+1:]
+
+	labels := rejectedVersionsMetricLabels(s.gateway, typeUrl)
+	_, wasRejected := s.nacks[typeUrl]
+	if errorDetail == nil {
+		if wasRejected {
+			delete(s.nacks, typeUrl)
+			rejectedVersions.Sub(1, labels...)
+			n.trigger.TriggerRecomputation()
+		}
+		return
+	}
+
+	nack := XdsNack{
+		Gateway: s.gateway,
+		NodeID:  s.nodeID,
+		TypeUrl: typeUrl,
+		Version: resp.version,
+		Error:   errorDetail.GetMessage(),
+		Time:    n.now(),
+	}
+	s.nacks[typeUrl] = nack
+	s.last = &nack
+	rejectedVersionsTotal.Inc(labels...)
+	if !wasRejected {
+		rejectedVersions.Add(1, labels...)
+	}
+	n.trigger.TriggerRecomputation()
+}
+
+// streamResponse is called for every response sent on a stream.
+func (n *XdsNacks) streamResponse(key streamKey, typeUrl, nonce, version string) {
+	if n == nil {
+		return
+	}
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	s := n.streams[key]
+	if s == nil {
+		return
+	}
+	responses := append(s.responses[typeUrl], sentResponse{nonce: nonce, version: version})
+	if len(responses) > maxInFlightResponses {
+		responses = responses[len(responses)-maxInFlightResponses:]
+	}
+	s.responses[typeUrl] = responses
+}
+
+func (n *XdsNacks) streamClosed(key streamKey) {
+	if n == nil {
+		return
+	}
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	s := n.streams[key]
+	if s == nil {
+		return
+	}
+	delete(n.streams, key)
+	for typeUrl := range s.nacks {
+		rejectedVersions.Sub(1, rejectedVersionsMetricLabels(s.gateway, typeUrl)...)
+	}
+	if len(s.nacks) > 0 {
+		n.trigger.TriggerRecomputation()
+	}
+}
+
+// gatewayFromRole returns the Gateway of a kgateway proxy role, <owner>~<namespace>~<name>. The
+// role of unique clients has more segments appended.
+func gatewayFromRole(role string) (types.NamespacedName, bool) {
+	if !xds.IsKubeGatewayCacheKey(role) {
+		return types.NamespacedName{}, false
+	}
+	parts := strings.Split(role, xds.KeyDelimiter)
+	if len(parts) < 3 {
+		return types.NamespacedName{}, false
+	}
+	return types.NamespacedName{Namespace: parts[1], Name: parts[2]}, true
+}
+
+func rejectedVersionsMetricLabels(gateway types.NamespacedName, typeUrl string) []metrics.Label {
+	return []metrics.Label{
+		{Name: "name", Value: gateway.Name},
+		{Name: "namespace", Value: gateway.Namespace},
+		{Name: "type_url", Value: typeUrl},
+	}
+}
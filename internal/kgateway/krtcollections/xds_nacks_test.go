@@ -0,0 +1,113 @@
+package krtcollections_test
+
+import (
+	"context"
+	"testing"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/apimachinery/pkg/types"
+
+	. "github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils/krtutil"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/xds"
+	"github.com/kgateway-dev/kgateway/v2/pkg/metrics"
+	"github.com/kgateway-dev/kgateway/v2/pkg/metrics/metricstest"
+)
+
+func TestXdsNacks(t *testing.T) {
+	setupTest()
+	g := NewWithT(t)
+
+	nacks := NewXdsNacks()
+	cb, uccBuilder := NewUniquelyConnectedClients(nil, nacks)
+	uccBuilder(context.Background(), krtutil.KrtOptions{}, nil)
+	col := nacks.Collection(krtutil.KrtOptions{})
+	col.WaitUntilSynced(context.Background().Done())
+
+	const typeURL = "type.googleapis.com/envoy.config.listener.v3.Listener"
+	node := &corev3.Node{
+		Id: "gw-pod.default",
+		Metadata: &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				xds.RoleKey: structpb.NewStringValue(xds.OwnerNamespaceNameID(wellknown.GatewayApiProxyValue, "default", "gw")),
+			},
+		},
+	}
+	respond := func(nonce, version string) {
+		cb.OnStreamDeltaResponse(1, nil, &envoy_service_discovery_v3.DeltaDiscoveryResponse{
+			TypeUrl:           typeURL,
+			Nonce:             nonce,
+			SystemVersionInfo: version,
+		})
+	}
+	request := func(nonce string, errorDetail *status.Status) {
+		g.Expect(cb.OnStreamDeltaRequest(1, &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			TypeUrl:       typeURL,
+			ResponseNonce: nonce,
+			ErrorDetail:   errorDetail,
+		})).To(Succeed())
+	}
+	// only the first request of the delta stream carries the node
+	g.Expect(cb.OnStreamDeltaRequest(1, &envoy_service_discovery_v3.DeltaDiscoveryRequest{Node: node, TypeUrl: typeURL})).To(Succeed())
+
+	respond("1", "v1")
+	request("1", nil)
+	respond("2", "v2")
+	request("2", &status.Status{Message: "duplicate listener"})
+
+	g.Eventually(col.List, "1s").Should(HaveLen(1))
+	nack := col.List()[0]
+	g.Expect(nack.Gateway).To(Equal(types.NamespacedName{Namespace: "default", Name: "gw"}))
+	g.Expect(nack.NodeID).To(Equal("gw-pod.default"))
+	g.Expect(nack.TypeUrl).To(Equal(typeURL))
+	g.Expect(nack.Version).To(Equal("v2"))
+	g.Expect(nack.Error).To(Equal("duplicate listener"))
+	g.Expect(nacks.LastNacks()).To(HaveKeyWithValue("gw-pod.default", nack))
+
+	labels := []metrics.Label{
+		{Name: "name", Value: "gw"},
+		{Name: "namespace", Value: "default"},
+		{Name: "type_url", Value: typeURL},
+	}
+	gathered := metricstest.MustGatherMetrics(t)
+	gathered.AssertMetric("kgateway_xds_rejected_versions_total", &metricstest.ExpectedMetric{Labels: labels, Value: 1})
+	gathered.AssertMetric("kgateway_xds_rejected_versions", &metricstest.ExpectedMetric{Labels: labels, Value: 1})
+
+	// an ACK of a later version clears the NACK, while the last NACK of the proxy is kept
+	respond("3", "v3")
+	request("3", nil)
+	g.Eventually(col.List, "1s").Should(BeEmpty())
+	g.Expect(nacks.LastNacks()).To(HaveKey("gw-pod.default"))
+
+	gathered = metricstest.MustGatherMetrics(t)
+	gathered.AssertMetric("kgateway_xds_rejected_versions_total", &metricstest.ExpectedMetric{Labels: labels, Value: 1})
+	gathered.AssertMetric("kgateway_xds_rejected_versions", &metricstest.ExpectedMetric{Labels: labels, Value: 0})
+
+	// a NACK of a response sent before the latest one is still reported
+	respond("4", "v4")
+	respond("5", "v5")
+	request("4", &status.Status{Message: "invalid listener"})
+	g.Eventually(col.List, "1s").Should(HaveLen(1))
+	nack = col.List()[0]
+	g.Expect(nack.Version).To(Equal("v4"))
+	g.Expect(nack.Error).To(Equal("invalid listener"))
+	request("5", nil)
+	g.Eventually(col.List, "1s").Should(BeEmpty())
+
+	// closing the stream forgets the proxy
+	respond("6", "v6")
+	request("6", &status.Status{Message: "duplicate listener"})
+	g.Eventually(col.List, "1s").Should(HaveLen(1))
+	cb.OnDeltaStreamClosed(1, nil)
+	g.Eventually(col.List, "1s").Should(BeEmpty())
+	g.Expect(nacks.LastNacks()).To(BeEmpty())
+
+	gathered = metricstest.MustGatherMetrics(t)
+	gathered.AssertMetric("kgateway_xds_rejected_versions_total", &metricstest.ExpectedMetric{Labels: labels, Value: 3})
+	gathered.AssertMetric("kgateway_xds_rejected_versions", &metricstest.ExpectedMetric{Labels: labels, Value: 0})
+}
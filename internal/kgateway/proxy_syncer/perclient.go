@@ -3,10 +3,12 @@ package proxy_syncer
 import (
 	"fmt"
 	"maps"
+	"strconv"
 	"strings"
 
 	envoycachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
 	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	envoyresource "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
 	"istio.io/istio/pkg/kube/controllers"
 	"istio.io/istio/pkg/kube/krt"
 
@@ -19,6 +21,7 @@ import (
 
 type clustersWithErrors struct {
 	clusters            envoycache.Resources
+	versions            map[string]string
 	erroredClusters     []string
 	erroredClustersHash uint64
 	clustersHash        uint64
@@ -27,6 +30,7 @@ type clustersWithErrors struct {
 
 type endpointsWithUccName struct {
 	endpoints    envoycache.Resources
+	versions     map[string]string
 	resourceName string
 }
 
@@ -69,12 +73,14 @@ func snapshotPerClient(
 		}
 
 		clustersProto := make([]envoycachetypes.ResourceWithTTL, 0, len(clustersForUcc))
+		versions := make(map[string]string, len(clustersForUcc))
 		var clustersHash uint64
 		var erroredClustersHash uint64
 		var erroredClusters []string
 		for _, c := range clustersForUcc {
 			if c.Error == nil {
 				clustersProto = append(clustersProto, envoycachetypes.ResourceWithTTL{Resource: c.Cluster})
+				versions[envoycache.GetResourceName(c.Cluster)] = strconv.FormatUint(c.ClusterVersion, 10)
 				clustersHash ^= c.ClusterVersion
 			} else {
 				erroredClusters = append(erroredClusters, c.Name)
@@ -91,6 +97,7 @@ func snapshotPerClient(
 
 		return &clustersWithErrors{
 			clusters:            clusterResources,
+			versions:            versions,
 			erroredClusters:     erroredClusters,
 			clustersHash:        clustersHash,
 			erroredClustersHash: erroredClustersHash,
@@ -101,15 +108,18 @@ func snapshotPerClient(
 	endpointResources := krt.NewCollection(uccCol, func(kctx krt.HandlerContext, ucc ir.UniqlyConnectedClient) *endpointsWithUccName {
 		endpointsForUcc := endpoints.FetchEndpointsForClient(kctx, ucc)
 		endpointsProto := make([]envoycachetypes.ResourceWithTTL, 0, len(endpointsForUcc))
+		versions := make(map[string]string, len(endpointsForUcc))
 		var endpointsHash uint64
 		for _, ep := range endpointsForUcc {
 			endpointsProto = append(endpointsProto, envoycachetypes.ResourceWithTTL{Resource: ep.Endpoints})
+			versions[envoycache.GetResourceName(ep.Endpoints)] = strconv.FormatUint(ep.EndpointsHash, 10)
 			endpointsHash ^= ep.EndpointsHash
 		}
 
 		endpointResources := envoycache.NewResourcesWithTTL(fmt.Sprintf("%d", endpointsHash), endpointsProto)
 		return &endpointsWithUccName{
 			endpoints:    endpointResources,
+			versions:     versions,
 			resourceName: ucc.ResourceName(),
 		}
 	}, krtopts.ToOptions("EndpointResources")...)
@@ -144,13 +154,17 @@ func snapshotPerClient(
 
 		logger.Debug("found perclient clusters", "client", ucc.ResourceName(), "clusters", len(clustersForUcc.clusters.Items))
 		clusterResources := clustersForUcc.clusters
+		clusterVersions := clustersForUcc.versions
 
 		snap := XdsSnapWrapper{}
 		if len(listenerRouteSnapshot.Clusters) > 0 {
 			clustersProto := make(map[string]envoycachetypes.ResourceWithTTL, len(listenerRouteSnapshot.Clusters)+len(clustersForUcc.clusters.Items))
 			maps.Copy(clustersProto, clustersForUcc.clusters.Items)
+			clusterVersions = maps.Clone(clustersForUcc.versions)
 			for _, item := range listenerRouteSnapshot.Clusters {
-				clustersProto[envoycache.GetResourceName(item.Resource)] = item
+				name := envoycache.GetResourceName(item.Resource)
+				clustersProto[name] = item
+				clusterVersions[name] = resourceVersion(item.Resource)
 			}
 			clusterResources.Version = fmt.Sprintf("%d", clustersForUcc.clustersHash^listenerRouteSnapshot.ClustersHash)
 			clusterResources.Items = clustersProto
@@ -163,6 +177,17 @@ func snapshotPerClient(
 		snapshot.Resources[envoycachetypes.Endpoint] = clientEndpointResources.endpoints
 		snapshot.Resources[envoycachetypes.Route] = listenerRouteSnapshot.Routes
 		snapshot.Resources[envoycachetypes.Listener] = listenerRouteSnapshot.Listeners
+		snapshot.Resources[envoycachetypes.Secret] = listenerRouteSnapshot.Secrets
+		// Delta xDS compares the version of every resource to find the ones that changed.
+		// Reuse the hashes computed for the per-client clusters and endpoints, so the cache
+		// doesn't need to marshal and hash thousands of them again on each update.
+		snapshot.VersionMap = map[string]map[string]string{
+			envoyresource.ClusterType:  clusterVersions,
+			envoyresource.EndpointType: clientEndpointResources.versions,
+			envoyresource.RouteType:    resourceVersions(listenerRouteSnapshot.Routes),
+			envoyresource.ListenerType: resourceVersions(listenerRouteSnapshot.Listeners),
+			envoyresource.SecretType:   resourceVersions(listenerRouteSnapshot.Secrets),
+		}
 		// envoycache.NewResources(version, resource)
 		snap.snap = snapshot
 		logger.Debug("snapshots", "proxy_key", snap.proxyKey,
@@ -242,3 +267,16 @@ func snapshotPerClient(
 
 	return xdsSnapshotsForUcc
 }
+
+// resourceVersions returns the versions of the resources, keyed by resource name, for the delta xDS version map.
+func resourceVersions(resources envoycache.Resources) map[string]string {
+	versions := make(map[string]string, len(resources.Items))
+	for name, item := range resources.Items {
+		versions[name] = resourceVersion(item.Resource)
+	}
+	return versions
+}
+
+func resourceVersion(resource envoycachetypes.Resource) string {
+	return strconv.FormatUint(utils.HashProto(resource), 10)
+}
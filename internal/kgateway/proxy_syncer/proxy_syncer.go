@@ -62,6 +62,7 @@ type ProxySyncer struct {
 	proxyTranslator ProxyTranslator
 
 	uniqueClients krt.Collection[ir.UniqlyConnectedClient]
+	xdsNacks      *krtcollections.XdsNacks
 
 	statusReport            krt.Singleton[report]
 	backendPolicyReport     krt.Singleton[report]
@@ -91,6 +92,9 @@ type GatewayXdsResources struct {
 
 	// Listeners are items in the LDS response payload.
 	Listeners envoycache.Resources
+
+	// Secrets are items in the SDS response payload.
+	Secrets envoycache.Resources
 }
 
 func (r GatewayXdsResources) ResourceName() string {
@@ -99,7 +103,8 @@ func (r GatewayXdsResources) ResourceName() string {
 
 func (r GatewayXdsResources) Equals(in GatewayXdsResources) bool {
 	return r.NamespacedName == in.NamespacedName && report{r.reports}.Equals(report{in.reports}) && r.ClustersHash == in.ClustersHash &&
-		r.Routes.Version == in.Routes.Version && r.Listeners.Version == in.Listeners.Version
+		r.Routes.Version == in.Routes.Version && r.Listeners.Version == in.Listeners.Version &&
+		r.Secrets.Version == in.Secrets.Version
 }
 
 func sliceToResourcesHash[T proto.Message](slice []T) ([]envoycachetypes.ResourceWithTTL, uint64) {
@@ -132,6 +137,7 @@ func toResources(gw ir.Gateway, xdsSnap irtranslator.TranslationResult, r report
 		Clusters:     c,
 		Routes:       sliceToResources(xdsSnap.Routes),
 		Listeners:    sliceToResources(xdsSnap.Listeners),
+		Secrets:      sliceToResources(xdsSnap.Secrets),
 	}
 }
 
@@ -146,6 +152,7 @@ func NewProxySyncer(
 	mergedPlugins plug.Plugin,
 	commonCols *common.CommonCollections,
 	xdsCache envoycache.SnapshotCache,
+	xdsNacks *krtcollections.XdsNacks,
 	agentGatewayClassName string,
 ) *ProxySyncer {
 	return &ProxySyncer{
@@ -156,6 +163,7 @@ func NewProxySyncer(
 		istioClient:           client,
 		proxyTranslator:       NewProxyTranslator(xdsCache),
 		uniqueClients:         uniqueClients,
+		xdsNacks:              xdsNacks,
 		translator:            translator.NewCombinedTranslator(ctx, mergedPlugins, commonCols),
 		plugins:               mergedPlugins,
 		routeStatusMetrics:    NewStatusSyncMetricsRecorder("RouteStatusSyncer"),
@@ -202,6 +210,9 @@ func (r report) Equals(in report) bool {
 	if !maps.Equal(r.reportMap.TLSRoutes, in.reportMap.TLSRoutes) {
 		return false
 	}
+	if !maps.Equal(r.reportMap.UDPRoutes, in.reportMap.UDPRoutes) {
+		return false
+	}
 	if !maps.Equal(r.reportMap.Policies, in.reportMap.Policies) {
 		return false
 	}
@@ -262,11 +273,20 @@ func (s *ProxySyncer) Init(ctx context.Context, krtopts krtutil.KrtOptions) {
 		return &report{merged}
 	}, krtopts.ToOptions("BackendsPolicyReport")...)
 
+	var xdsNacks krt.Collection[krtcollections.XdsNack]
+	if s.xdsNacks != nil {
+		xdsNacks = s.xdsNacks.Collection(krtopts)
+	}
+
 	// as proxies are created, they also contain a reportMap containing status for the Gateway and associated xRoutes (really parentRefs)
 	// here we will merge reports that are per-Proxy to a singleton Report used to persist to k8s on a timer
 	s.statusReport = krt.NewSingleton(func(kctx krt.HandlerContext) *report {
 		proxies := krt.Fetch(kctx, s.mostXdsSnapshots)
 		merged := mergeProxyReports(proxies)
+		if xdsNacks != nil {
+			// the Gateways report the rejection until their proxies ACK a later version
+			setXdsNackConditions(merged, krt.Fetch(kctx, xdsNacks))
+		}
 		return &report{merged}
 	})
 
@@ -329,6 +349,18 @@ func mergeProxyReports(
 			maps.Copy(merged.TLSRoutes[rnn].Parents, rr.Parents)
 		}
 
+		for rnn, rr := range p.reports.UDPRoutes {
+			// if we haven't encountered this route, just copy it over completely
+			old := merged.UDPRoutes[rnn]
+			if old == nil {
+				merged.UDPRoutes[rnn] = rr
+				continue
+			}
+			// else, this route has already been seen for a proxy, merge this proxy's parents
+			// into the merged report
+			maps.Copy(merged.UDPRoutes[rnn].Parents, rr.Parents)
+		}
+
 		for rnn, rr := range p.reports.GRPCRoutes {
 			// if we haven't encountered this route, just copy it over completely
 			old := merged.GRPCRoutes[rnn]
@@ -515,6 +547,12 @@ func (s *ProxySyncer) syncRouteStatus(ctx context.Context, logger *slog.Logger,
 				return nil
 			}
 			r.Status.RouteStatus = *status
+		case *gwv1a2.UDPRoute:
+			status = rm.BuildRouteStatus(ctx, r, s.controllerName)
+			if status == nil || isRouteStatusEqual(&r.Status.RouteStatus, status) {
+				return nil
+			}
+			r.Status.RouteStatus = *status
 		case *gwv1.GRPCRoute:
 			status = rm.BuildRouteStatus(ctx, r, s.controllerName)
 			if status == nil || isRouteStatusEqual(&r.Status.RouteStatus, status) {
@@ -591,6 +629,24 @@ func (s *ProxySyncer) syncRouteStatus(ctx context.Context, logger *slog.Logger,
 		}
 	}
 
+	// Sync UDPRoute statuses
+	s.routeStatusMetrics.ResetResources("UDPRoute")
+
+	for rnn := range rm.UDPRoutes {
+		s.routeStatusMetrics.IncResources(StatusSyncResourcesMetricLabels{
+			Namespace: rnn.Namespace,
+			Name:      rnn.Name,
+			Resource:  "UDPRoute",
+		})
+
+		err := syncStatusWithRetry(wellknown.UDPRouteKind, rnn, func() client.Object { return new(gwv1a2.UDPRoute) }, func(route client.Object) error {
+			return buildAndUpdateStatus(route, wellknown.UDPRouteKind)
+		})
+		if err != nil {
+			logger.Error("all attempts failed at updating UDPRoute status", "error", err, "route", rnn)
+		}
+	}
+
 	// Sync GRPCRoute statuses
 	s.routeStatusMetrics.ResetResources("GRPCRoute")
 
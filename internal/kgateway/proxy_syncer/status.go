@@ -1,12 +1,16 @@
 package proxy_syncer
 
 import (
+	"fmt"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
 	reportssdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
 	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
 )
@@ -61,7 +65,7 @@ func generatePolicyReport[T ObjWithAttachedPolicies](in []T) reports.ReportMap {
 						Type:    gwv1alpha2.PolicyConditionAccepted,
 						Status:  metav1.ConditionTrue,
 						Reason:  gwv1alpha2.PolicyReasonAccepted,
-						Message: reportssdk.PolicyAcceptedAndAttachedMsg,
+						Message: polAtt.AcceptedMessage(reportssdk.PolicyAcceptedAndAttachedMsg),
 					})
 				}
 			}
@@ -70,3 +74,43 @@ func generatePolicyReport[T ObjWithAttachedPolicies](in []T) reports.ReportMap {
 
 	return merged
 }
+
+const (
+	// XdsConfigAcceptedConditionType is set on Gateways to report whether their proxies accepted
+	// the latest xDS configuration sent to them, or rejected it with a NACK.
+	XdsConfigAcceptedConditionType = "XdsConfigAccepted"
+	XdsConfigAcceptedReason        = "Accepted"
+	XdsConfigRejectedReason        = "Rejected"
+)
+
+// setXdsNackConditions sets the XdsConfigAccepted condition of the Gateways, to False for the
+// Gateways whose configuration was rejected by one of their proxies. The nacks are sorted by
+// resource name, so the first NACK of a Gateway is reported.
+func setXdsNackConditions(rm reports.ReportMap, nacks []krtcollections.XdsNack) {
+	nackByGateway := make(map[types.NamespacedName]krtcollections.XdsNack, len(nacks))
+	for _, nack := range nacks {
+		if _, ok := nackByGateway[nack.Gateway]; !ok {
+			nackByGateway[nack.Gateway] = nack
+		}
+	}
+	for gw, gwReport := range rm.Gateways {
+		condition := reportssdk.GatewayCondition{
+			Type:   XdsConfigAcceptedConditionType,
+			Status: metav1.ConditionTrue,
+			Reason: XdsConfigAcceptedReason,
+		}
+		if nack, ok := nackByGateway[gw]; ok {
+			condition = reportssdk.GatewayCondition{
+				Type:   XdsConfigAcceptedConditionType,
+				Status: metav1.ConditionFalse,
+				Reason: XdsConfigRejectedReason,
+				Message: fmt.Sprintf("proxy %s rejected %s version %s: %s",
+					nack.NodeID, nack.TypeUrl, nack.Version, nack.Error),
+			}
+		}
+		// the report is shared with the per-Gateway reports, so don't modify it
+		gwReport = gwReport.Clone()
+		gwReport.SetCondition(condition)
+		rm.Gateways[gw] = gwReport
+	}
+}
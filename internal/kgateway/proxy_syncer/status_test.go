@@ -4,9 +4,11 @@ import (
 	"errors"
 	"testing"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	"github.com/google/go-cmp/cmp"
@@ -14,6 +16,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 	pluginsdkreporter "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
 	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
@@ -206,3 +209,76 @@ func TestPolicyStatus(t *testing.T) {
 	)
 	a.Empty(diff)
 }
+
+func TestSetXdsNackConditions(t *testing.T) {
+	a := assert.New(t)
+
+	rm := reports.NewReportMap()
+	reporter := reports.NewReporter(&rm)
+	gw := &gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: "default"}}
+	invalidGw := &gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "invalid", Namespace: "default"}}
+	ackedGw := &gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "acked", Namespace: "default"}}
+	reporter.Gateway(gw)
+	reporter.Gateway(invalidGw).SetCondition(pluginsdkreporter.GatewayCondition{
+		Type:    gwv1.GatewayConditionProgrammed,
+		Status:  metav1.ConditionFalse,
+		Reason:  gwv1.GatewayReasonInvalid,
+		Message: "translation error",
+	})
+	reporter.Gateway(ackedGw)
+	gwReport := rm.Gateway(gw)
+
+	const typeURL = "type.googleapis.com/envoy.config.listener.v3.Listener"
+	setXdsNackConditions(rm, []krtcollections.XdsNack{
+		{
+			Gateway: types.NamespacedName{Name: "gw", Namespace: "default"},
+			NodeID:  "gw-pod.default",
+			TypeUrl: typeURL,
+			Version: "v2",
+			Error:   "duplicate listener",
+		},
+		{
+			Gateway: types.NamespacedName{Name: "gw", Namespace: "default"},
+			NodeID:  "gw-pod.default",
+			TypeUrl: "type.googleapis.com/envoy.config.route.v3.RouteConfiguration",
+			Version: "v2",
+			Error:   "invalid route",
+		},
+		{
+			Gateway: types.NamespacedName{Name: "invalid", Namespace: "default"},
+			NodeID:  "invalid-pod.default",
+			TypeUrl: typeURL,
+			Version: "v1",
+			Error:   "duplicate listener",
+		},
+		{
+			Gateway: types.NamespacedName{Name: "deleted", Namespace: "default"},
+			NodeID:  "deleted-pod.default",
+			TypeUrl: typeURL,
+		},
+	})
+
+	cond := meta.FindStatusCondition(rm.Gateway(gw).GetConditions(), XdsConfigAcceptedConditionType)
+	a.NotNil(cond)
+	a.Equal(metav1.ConditionFalse, cond.Status)
+	a.Equal(XdsConfigRejectedReason, cond.Reason)
+	a.Equal("proxy gw-pod.default rejected "+typeURL+" version v2: duplicate listener", cond.Message)
+	// the NACK does not change whether the Gateway is programmed
+	a.Nil(meta.FindStatusCondition(rm.Gateway(gw).GetConditions(), string(gwv1.GatewayConditionProgrammed)))
+	// the original report is left untouched
+	a.Empty(gwReport.GetConditions())
+
+	cond = meta.FindStatusCondition(rm.Gateway(invalidGw).GetConditions(), XdsConfigAcceptedConditionType)
+	a.NotNil(cond)
+	a.Equal(metav1.ConditionFalse, cond.Status)
+	cond = meta.FindStatusCondition(rm.Gateway(invalidGw).GetConditions(), string(gwv1.GatewayConditionProgrammed))
+	a.NotNil(cond)
+	a.Equal("translation error", cond.Message)
+
+	cond = meta.FindStatusCondition(rm.Gateway(ackedGw).GetConditions(), XdsConfigAcceptedConditionType)
+	a.NotNil(cond)
+	a.Equal(metav1.ConditionTrue, cond.Status)
+	a.Equal(XdsConfigAcceptedReason, cond.Reason)
+
+	a.Len(rm.Gateways, 3)
+}
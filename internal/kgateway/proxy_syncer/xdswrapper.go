@@ -83,6 +83,7 @@ func (p XdsSnapWrapper) MarshalJSON() (out []byte, err error) {
 	addToSnap(snapJson, "Clusters", snap.Resources[envoycachetypes.Cluster].Items)
 	addToSnap(snapJson, "Routes", snap.Resources[envoycachetypes.Route].Items)
 	addToSnap(snapJson, "Endpoints", snap.Resources[envoycachetypes.Endpoint].Items)
+	addToSnap(snapJson, "Secrets", snap.Resources[envoycachetypes.Secret].Items)
 
 	return json.Marshal(struct {
 		Snap     any
@@ -106,13 +107,16 @@ func addToSnap(snapJson map[string]map[string]any, k string, resources map[strin
 }
 
 func redact(snap *envoycache.Snapshot) {
-	// clusters and listener might have secrets
+	// clusters, listeners and secrets might have secrets
 	for _, l := range snap.Resources[envoycachetypes.Listener].Items {
 		redactProto(l.Resource)
 	}
 	for _, l := range snap.Resources[envoycachetypes.Cluster].Items {
 		redactProto(l.Resource)
 	}
+	for _, l := range snap.Resources[envoycachetypes.Secret].Items {
+		redactProto(l.Resource)
+	}
 }
 
 func redactProto(m proto.Message) {
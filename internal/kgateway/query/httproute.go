@@ -127,6 +127,7 @@ func (r *gatewayQueries) GetRouteChain(
 	case *ir.TcpRouteIR:
 		// TODO (danehans): Should TCPRoute delegation support be added in the future?
 	case *ir.TlsRouteIR:
+	case *ir.UdpRouteIR:
 	default:
 		return nil
 	}
@@ -157,7 +158,7 @@ func (r *gatewayQueries) allowedRoutes(resource client.Object, l *gwv1.Listener)
 	case gwv1.TCPProtocolType:
 		allowedKinds = []metav1.GroupKind{{Kind: wellknown.TCPRouteKind, Group: gwv1a2.GroupName}}
 	case gwv1.UDPProtocolType:
-		allowedKinds = []metav1.GroupKind{{}}
+		allowedKinds = []metav1.GroupKind{{Kind: wellknown.UDPRouteKind, Group: gwv1a2.GroupName}}
 	default:
 		// allow custom protocols to work
 		allowedKinds = []metav1.GroupKind{{Kind: wellknown.HTTPRouteKind, Group: gwv1.GroupName}}
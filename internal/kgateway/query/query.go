@@ -6,9 +6,11 @@ import (
 	"strings"
 
 	"istio.io/istio/pkg/kube/krt"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	apiv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
@@ -99,6 +101,9 @@ func (f FromObject) Namespace() string {
 
 type GatewayQueries interface {
 	GetSecretForRef(kctx krt.HandlerContext, ctx context.Context, fromGk schema.GroupKind, fromns string, secretRef gwv1.SecretObjectReference) (*ir.Secret, error)
+	// GetConfigMapForRef fetches the ConfigMap referenced by an object, checking for ReferenceGrants
+	// when the ConfigMap is in another namespace.
+	GetConfigMapForRef(kctx krt.HandlerContext, ctx context.Context, fromGk schema.GroupKind, fromns string, ref gwv1.ObjectReference) (*corev1.ConfigMap, error)
 
 	// GetRoutesForGateway finds the top level xRoutes attached to the provided Gateway
 	GetRoutesForGateway(kctx krt.HandlerContext, ctx context.Context, gw *ir.Gateway) (*RoutesForGwResult, error)
@@ -190,6 +195,7 @@ func parentRefMatchListener(ref *gwv1.ParentReference, l *gwv1.Listener) bool {
 //   - HTTPRoute
 //   - TCPRoute
 //   - TLSRoute
+//   - UDPRoute
 //   - GRPCRoute
 func getParentRefsForResource(resource client.Object, obj ir.Route) []gwv1.ParentReference {
 	var ret []gwv1.ParentReference
@@ -327,6 +333,27 @@ func (r *gatewayQueries) GetSecretForRef(kctx krt.HandlerContext, ctx context.Co
 	return r.collections.Secrets.GetSecret(kctx, f, secretRef)
 }
 
+func (r *gatewayQueries) GetConfigMapForRef(kctx krt.HandlerContext, ctx context.Context, fromGk schema.GroupKind, fromns string, ref gwv1.ObjectReference) (*corev1.ConfigMap, error) {
+	ns := fromns
+	if ref.Namespace != nil {
+		ns = string(*ref.Namespace)
+	}
+	to := ir.ObjectSource{
+		Group:     string(ref.Group),
+		Kind:      string(ref.Kind),
+		Namespace: ns,
+		Name:      string(ref.Name),
+	}
+	if !r.collections.RefGrants.ReferenceAllowed(kctx, fromGk, fromns, to) {
+		return nil, krtcollections.ErrMissingReferenceGrant
+	}
+	cm := krt.FetchOne(kctx, r.collections.ConfigMaps, krt.FilterObjectName(types.NamespacedName{Namespace: to.Namespace, Name: to.Name}))
+	if cm == nil {
+		return nil, &krtcollections.NotFoundError{NotFoundObj: to}
+	}
+	return *cm, nil
+}
+
 func ReferenceAllowed(ctx context.Context, fromgk metav1.GroupKind, fromns string, togk metav1.GroupKind, toname string, grantsInToNs []apiv1beta1.ReferenceGrant) bool {
 	for _, refGrant := range grantsInToNs {
 		for _, from := range refGrant.Spec.From {
@@ -2,6 +2,7 @@ package setup
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"log/slog"
 	"math"
@@ -12,6 +13,7 @@ import (
 	envoy_service_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/service/endpoint/v3"
 	envoy_service_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/service/listener/v3"
 	envoy_service_route_v3 "github.com/envoyproxy/go-control-plane/envoy/service/route/v3"
+	envoy_service_secret_v3 "github.com/envoyproxy/go-control-plane/envoy/service/secret/v3"
 	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
 	envoylog "github.com/envoyproxy/go-control-plane/pkg/log"
 	xdsserver "github.com/envoyproxy/go-control-plane/pkg/server/v3"
@@ -19,6 +21,7 @@ import (
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/xds"
@@ -56,16 +59,40 @@ func (s *slogAdapterForEnvoy) Errorf(format string, args ...interface{}) {
 	}
 }
 
+type controlPlaneOptions struct {
+	tlsConfig     *tls.Config
+	authenticator xds.Authenticator
+}
+
+// ControlPlaneOption configures the security of the xDS server.
+type ControlPlaneOption func(*controlPlaneOptions)
+
+// WithXdsTLS serves xDS over TLS with the given config.
+func WithXdsTLS(tlsConfig *tls.Config) ControlPlaneOption {
+	return func(o *controlPlaneOptions) {
+		o.tlsConfig = tlsConfig
+	}
+}
+
+// WithXdsAuthenticator requires proxies to authenticate with the given authenticator,
+// and only serves them the config of the Gateway their identity belongs to.
+func WithXdsAuthenticator(authn xds.Authenticator) ControlPlaneOption {
+	return func(o *controlPlaneOptions) {
+		o.authenticator = authn
+	}
+}
+
 func NewControlPlane(
 	ctx context.Context,
 	bindAddr net.Addr,
 	callbacks xdsserver.Callbacks,
+	opts ...ControlPlaneOption,
 ) (envoycache.SnapshotCache, error) {
 	lis, err := net.Listen(bindAddr.Network(), bindAddr.String())
 	if err != nil {
 		return nil, err
 	}
-	snapshotCache, grpcServer := NewControlPlaneWithListener(ctx, lis, callbacks)
+	snapshotCache, grpcServer := NewControlPlaneWithListener(ctx, lis, callbacks, opts...)
 	go func() {
 		<-ctx.Done()
 		grpcServer.GracefulStop()
@@ -73,36 +100,55 @@ func NewControlPlane(
 	return snapshotCache, err
 }
 
+// NewControlPlaneWithListener serves the per-client snapshots of the cache over both
+// state-of-the-world and delta (incremental) ADS. Each proxy picks the variant with the
+// api_type of the ADS config in its bootstrap.
 func NewControlPlaneWithListener(ctx context.Context,
 	lis net.Listener,
 	callbacks xdsserver.Callbacks,
+	opts ...ControlPlaneOption,
 ) (envoycache.SnapshotCache, *grpc.Server) {
 	baseLogger := slog.Default().With("component", "envoy-controlplane")
 	envoyLoggerAdapter := &slogAdapterForEnvoy{logger: baseLogger}
 
+	options := &controlPlaneOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		//				grpc_ctxtags.StreamServerInterceptor(),
+		grpc_zap.StreamServerInterceptor(zap.NewNop()),
+		func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			baseLogger.Debug("gRPC call", "method", info.FullMethod)
+			return handler(srv, ss)
+		},
+	}
 	serverOpts := []grpc.ServerOption{
 		grpc.MaxRecvMsgSize(math.MaxInt32),
-		grpc.StreamInterceptor(
-			grpc_middleware.ChainStreamServer(
-				//				grpc_ctxtags.StreamServerInterceptor(),
-				grpc_zap.StreamServerInterceptor(zap.NewNop()),
-				func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-					baseLogger.Debug("gRPC call", "method", info.FullMethod)
-					return handler(srv, ss)
-				},
-			)),
 	}
+	if options.tlsConfig != nil {
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(options.tlsConfig)))
+	}
+	xdsCallbacks := xds.NewMetricsCallbacks(callbacks)
+	if options.authenticator != nil {
+		streamInterceptors = append(streamInterceptors, xds.StreamAuthInterceptor(options.authenticator))
+		serverOpts = append(serverOpts, grpc.UnaryInterceptor(xds.UnaryAuthInterceptor(options.authenticator)))
+		xdsCallbacks = xds.NewAuthCallbacks(xdsCallbacks)
+	}
+	serverOpts = append(serverOpts, grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(streamInterceptors...)))
 	grpcServer := grpc.NewServer(serverOpts...)
 
 	snapshotCache := envoycache.NewSnapshotCache(true, xds.NewNodeRoleHasher(), envoyLoggerAdapter)
 
-	xdsServer := xdsserver.NewServer(ctx, snapshotCache, callbacks)
+	xdsServer := xdsserver.NewServer(ctx, snapshotCache, xdsCallbacks)
 	reflection.Register(grpcServer)
 
 	envoy_service_endpoint_v3.RegisterEndpointDiscoveryServiceServer(grpcServer, xdsServer)
 	envoy_service_cluster_v3.RegisterClusterDiscoveryServiceServer(grpcServer, xdsServer)
 	envoy_service_route_v3.RegisterRouteDiscoveryServiceServer(grpcServer, xdsServer)
 	envoy_service_listener_v3.RegisterListenerDiscoveryServiceServer(grpcServer, xdsServer)
+	envoy_service_secret_v3.RegisterSecretDiscoveryServiceServer(grpcServer, xdsServer)
 	envoy_service_discovery_v3.RegisterAggregatedDiscoveryServiceServer(grpcServer, xdsServer)
 
 	go grpcServer.Serve(lis)
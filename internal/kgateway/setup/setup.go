@@ -2,6 +2,8 @@ package setup
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net"
 
@@ -11,6 +13,8 @@ import (
 	istiokube "istio.io/istio/pkg/kube"
 	"istio.io/istio/pkg/kube/krt"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -21,6 +25,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/common"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/xds"
 	"github.com/kgateway-dev/kgateway/v2/pkg/deployer"
 	"github.com/kgateway-dev/kgateway/v2/pkg/logging"
 	"github.com/kgateway-dev/kgateway/v2/pkg/metrics"
@@ -148,14 +153,22 @@ func StartKgatewayWithXDSCallbacks(ctx context.Context,
 	setupLogging(st.LogLevel)
 	slog.Info("global settings loaded", "settings", *st)
 
-	uniqueClientCallbacks, uccBuilder := krtcollections.NewUniquelyConnectedClients(extraXDSCallbacks)
-	cache, err := startControlPlane(ctx, st.XdsServicePort, uniqueClientCallbacks)
+	restConfig := ctrl.GetConfigOrDie()
+
+	controlPlaneOpts, err := xdsSecurityOptions(ctx, st, restConfig)
+	if err != nil {
+		return err
+	}
+	xdsNacks := krtcollections.NewXdsNacks()
+	uniqueClientCallbacks, uccBuilder := krtcollections.NewUniquelyConnectedClients(extraXDSCallbacks, xdsNacks)
+	cache, err := startControlPlane(ctx, st.XdsServicePort, uniqueClientCallbacks, controlPlaneOpts...)
 	if err != nil {
 		return err
 	}
 
 	setupOpts := &controller.SetupOpts{
 		Cache:                  cache,
+		XdsNacks:               xdsNacks,
 		KrtDebugger:            new(krt.DebugHandler),
 		GlobalSettings:         st,
 		PprofBindAddress:       "127.0.0.1:9099",
@@ -163,7 +176,6 @@ func StartKgatewayWithXDSCallbacks(ctx context.Context,
 		MetricsBindAddress:     ":9092",
 	}
 
-	restConfig := ctrl.GetConfigOrDie()
 	return StartKgatewayWithConfig(
 		ctx,
 		gatewayControllerName,
@@ -183,8 +195,54 @@ func startControlPlane(
 	ctx context.Context,
 	port uint32,
 	callbacks xdsserver.Callbacks,
+	opts ...ControlPlaneOption,
 ) (envoycache.SnapshotCache, error) {
-	return NewControlPlane(ctx, &net.TCPAddr{IP: net.IPv4zero, Port: int(port)}, callbacks)
+	return NewControlPlane(ctx, &net.TCPAddr{IP: net.IPv4zero, Port: int(port)}, callbacks, opts...)
+}
+
+// xdsSecurityOptions returns the TLS and authentication options of the xDS server from the settings.
+func xdsSecurityOptions(ctx context.Context, st *settings.Settings, restConfig *rest.Config) ([]ControlPlaneOption, error) {
+	var opts []ControlPlaneOption
+	// proxies only send their token over TLS, and are only identified by their address
+	// once their client certificate is verified
+	if st.XdsAuth && st.XdsTlsCertDir == "" {
+		return nil, errors.New("xds auth requires the xds server to use TLS")
+	}
+	if st.XdsTlsClientCertSecretName != "" && st.XdsTlsCertDir == "" {
+		return nil, errors.New("xds client certificates require the xds server to use TLS")
+	}
+	if st.XdsTlsCertDir != "" {
+		tlsConfig, err := xds.NewServerTLSConfig(st.XdsTlsCertDir, st.XdsTlsClientCertSecretName != "")
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithXdsTLS(tlsConfig))
+	}
+	if !st.XdsAuth && st.XdsTlsClientCertSecretName == "" {
+		return opts, nil
+	}
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	factory := informers.NewSharedInformerFactory(client, 0)
+	var authn xds.Authenticator
+	if st.XdsAuth {
+		authn = xds.NewTokenReviewAuthenticator(client, factory)
+	} else {
+		// client certificates are shared by the proxies of a namespace, so proxies are
+		// identified by their pod to only serve them the config of their Gateway
+		if authn, err = xds.NewPeerAddressAuthenticator(factory); err != nil {
+			return nil, err
+		}
+	}
+	factory.Start(ctx.Done())
+	for typ, synced := range factory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			return nil, fmt.Errorf("failed to sync the %v informer of the xds authenticator", typ)
+		}
+	}
+	return append(opts, WithXdsAuthenticator(authn)), nil
 }
 
 func StartKgatewayWithConfig(
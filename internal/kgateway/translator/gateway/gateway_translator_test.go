@@ -9,9 +9,11 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/httplistenerpolicy"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
 	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
@@ -97,6 +99,127 @@ var _ = DescribeTable("Basic GatewayTranslator Tests",
 				Name:      "http",
 			},
 		}),
+	Entry(
+		"https gateway with listener certificates served over SDS",
+		translatorTestCase{
+			inputFile:  "https-routing",
+			outputFile: "https-routing-sds-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		},
+		func(s *settings.Settings) {
+			s.DownstreamTlsSds = true
+		},
+	),
+	Entry(
+		"https gateway with multiple certificates and tls parameters",
+		translatorTestCase{
+			inputFile:  "tls-options/multiple-certs.yaml",
+			outputFile: "tls-options/multiple-certs-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		}),
+	Entry(
+		"https gateway with multiple certificates served over SDS",
+		translatorTestCase{
+			inputFile:  "tls-options/multiple-certs.yaml",
+			outputFile: "tls-options/multiple-certs-sds-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		},
+		func(s *settings.Settings) {
+			s.DownstreamTlsSds = true
+		},
+	),
+	Entry(
+		"https gateway with an unsupported tls option",
+		translatorTestCase{
+			inputFile:  "tls-options/unsupported-option.yaml",
+			outputFile: "tls-options/unsupported-option-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+			assertReports: func(gwNN types.NamespacedName, reportsMap reports.ReportMap) {
+				gw := gwv1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      gwNN.Name,
+						Namespace: gwNN.Namespace,
+					},
+					Spec: gwv1.GatewaySpec{
+						Listeners: []gwv1.Listener{{Name: "https"}},
+					},
+				}
+				gwStatus := reportsMap.BuildGWStatus(context.Background(), gw)
+				Expect(gwStatus).NotTo(BeNil())
+				Expect(gwStatus.Listeners).To(HaveLen(1))
+				programmed := meta.FindStatusCondition(gwStatus.Listeners[0].Conditions, string(gwv1.ListenerConditionProgrammed))
+				Expect(programmed).NotTo(BeNil())
+				Expect(programmed.Status).To(Equal(metav1.ConditionTrue))
+				accepted := meta.FindStatusCondition(gwStatus.Listeners[0].Conditions, string(gwv1.ListenerConditionAccepted))
+				Expect(accepted).NotTo(BeNil())
+				Expect(accepted.Status).To(Equal(metav1.ConditionTrue))
+				Expect(accepted.Message).To(Equal("Ignored unsupported TLS options: kgateway.dev/min-tls-verison"))
+				resolvedRefs := meta.FindStatusCondition(gwStatus.Listeners[0].Conditions, string(gwv1.ListenerConditionResolvedRefs))
+				Expect(resolvedRefs).NotTo(BeNil())
+				Expect(resolvedRefs.Status).To(Equal(metav1.ConditionTrue))
+			},
+		}),
+	Entry(
+		"https gateway requiring client certificates",
+		translatorTestCase{
+			inputFile:  "frontend-tls/require.yaml",
+			outputFile: "frontend-tls/require-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		}),
+	Entry(
+		"https gateway optionally requesting client certificates",
+		translatorTestCase{
+			inputFile:  "frontend-tls/optional.yaml",
+			outputFile: "frontend-tls/optional-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		}),
+	Entry(
+		"https gateway with a missing client CA certificate",
+		translatorTestCase{
+			inputFile:  "frontend-tls/missing-ca.yaml",
+			outputFile: "frontend-tls/missing-ca-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+			assertReports: func(gwNN types.NamespacedName, reportsMap reports.ReportMap) {
+				gw := gwv1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      gwNN.Name,
+						Namespace: gwNN.Namespace,
+					},
+					Spec: gwv1.GatewaySpec{
+						Listeners: []gwv1.Listener{{Name: "https"}},
+					},
+				}
+				gwStatus := reportsMap.BuildGWStatus(context.Background(), gw)
+				Expect(gwStatus).NotTo(BeNil())
+				Expect(gwStatus.Listeners).To(HaveLen(1))
+				resolvedRefs := meta.FindStatusCondition(gwStatus.Listeners[0].Conditions, string(gwv1.ListenerConditionResolvedRefs))
+				Expect(resolvedRefs).NotTo(BeNil())
+				Expect(resolvedRefs.Status).To(Equal(metav1.ConditionFalse))
+				Expect(resolvedRefs.Reason).To(Equal(string(gwv1.ListenerReasonInvalidCertificateRef)))
+				Expect(resolvedRefs.Message).To(ContainSubstring("missing-ca"))
+			},
+		}),
 	Entry(
 		"http gateway with multiple routing rules and HeaderModifier filter",
 		translatorTestCase{
@@ -261,6 +384,26 @@ var _ = DescribeTable("Basic GatewayTranslator Tests",
 				Name:      "example-gateway",
 			},
 		}),
+	Entry(
+		"TrafficPolicy with HTTP and GRPC ExtAuth services",
+		translatorTestCase{
+			inputFile:  "traffic-policy/extauth-http.yaml",
+			outputFile: "traffic-policy/extauth-http.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "infra",
+				Name:      "example-gateway",
+			},
+		}),
+	Entry(
+		"TrafficPolicy with global rate limits attached to gateway and route",
+		translatorTestCase{
+			inputFile:  "traffic-policy/global-ratelimit.yaml",
+			outputFile: "traffic-policy/global-ratelimit.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "infra",
+				Name:      "example-gateway",
+			},
+		}),
 	Entry(
 		"TrafficPolicy with buffer attached to gateway",
 		translatorTestCase{
@@ -281,6 +424,63 @@ var _ = DescribeTable("Basic GatewayTranslator Tests",
 				Name:      "example-gateway",
 			},
 		}),
+	Entry(
+		"TrafficPolicy with jwt attached to route",
+		translatorTestCase{
+			inputFile:  "traffic-policy/jwt.yaml",
+			outputFile: "traffic-policy/jwt.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		}),
+	Entry(
+		"TrafficPolicy with authorization attached to route",
+		translatorTestCase{
+			inputFile:  "traffic-policy/rbac.yaml",
+			outputFile: "traffic-policy/rbac.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		}),
+	Entry(
+		"TrafficPolicy with fault injection attached to route",
+		translatorTestCase{
+			inputFile:  "traffic-policy/fault.yaml",
+			outputFile: "traffic-policy/fault.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		}),
+	Entry(
+		"TrafficPolicy with timeouts and retry",
+		translatorTestCase{
+			inputFile:  "traffic-policy/timeout-retry.yaml",
+			outputFile: "traffic-policy/timeout-retry.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+			assertReports: func(gwNN types.NamespacedName, reportsMap reports.ReportMap) {
+				// the message names the fields of each policy skipped on the route with rule values
+				for name, skipped := range map[string]string{
+					"route-policy":   "timeouts.request, retry.perTryTimeout, retry.attempts",
+					"gateway-policy": "timeouts.request, retry.attempts",
+				} {
+					policy := reports.PolicyKey{Group: "gateway.kgateway.dev", Kind: "TrafficPolicy", Namespace: "default", Name: name}
+					status := reportsMap.BuildPolicyStatus(context.Background(), policy, wellknown.DefaultGatewayControllerName, gwv1alpha2.PolicyStatus{})
+					Expect(status).NotTo(BeNil())
+					Expect(status.Ancestors).To(HaveLen(1))
+					accepted := meta.FindStatusCondition(status.Ancestors[0].Conditions, string(gwv1alpha2.PolicyConditionAccepted))
+					Expect(accepted).NotTo(BeNil())
+					Expect(accepted.Status).To(Equal(metav1.ConditionTrue))
+					Expect(accepted.Message).To(Equal(reporter.PolicyAcceptedMsg + "; " + skipped +
+						" skipped on HTTPRoute default/route-with-rule-values as its rules take precedence"))
+				}
+			},
+		}),
 	Entry(
 		"tcp gateway with basic routing",
 		translatorTestCase{
@@ -366,6 +566,90 @@ var _ = DescribeTable("Basic GatewayTranslator Tests",
 				Name:      "example-tcp-gateway",
 			},
 		}),
+	Entry(
+		"udp gateway sharing a port with a tcp listener",
+		translatorTestCase{
+			inputFile:  "udp-routing/basic.yaml",
+			outputFile: "udp-routing/basic-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+			assertReports: func(gwNN types.NamespacedName, reportsMap reports.ReportMap) {
+				route := &gwv1alpha2.UDPRoute{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "example-udp-route",
+						Namespace: "default",
+					},
+					Spec: gwv1alpha2.UDPRouteSpec{
+						CommonRouteSpec: gwv1.CommonRouteSpec{
+							ParentRefs: []gwv1.ParentReference{{
+								Name:        "example-gateway",
+								SectionName: ptr.To(gwv1.SectionName("dns-udp")),
+							}},
+						},
+					},
+				}
+				routeStatus := reportsMap.BuildRouteStatus(context.Background(), route, wellknown.DefaultGatewayClassName)
+				Expect(routeStatus).NotTo(BeNil())
+				Expect(routeStatus.Parents).To(HaveLen(1))
+				accepted := meta.FindStatusCondition(routeStatus.Parents[0].Conditions, string(gwv1.RouteConditionAccepted))
+				Expect(accepted).NotTo(BeNil())
+				Expect(accepted.Status).To(Equal(metav1.ConditionTrue))
+				resolvedRefs := meta.FindStatusCondition(routeStatus.Parents[0].Conditions, string(gwv1.RouteConditionResolvedRefs))
+				Expect(resolvedRefs).NotTo(BeNil())
+				Expect(resolvedRefs.Status).To(Equal(metav1.ConditionTrue))
+			},
+		}),
+	Entry(
+		"udp gateway with weighted backend services",
+		translatorTestCase{
+			inputFile:  "udp-routing/multi-backend.yaml",
+			outputFile: "udp-routing/multi-backend-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-udp-gateway",
+			},
+		}),
+	Entry(
+		"udp gateway with several routes on the same listener",
+		translatorTestCase{
+			inputFile:  "udp-routing/conflict.yaml",
+			outputFile: "udp-routing/conflict-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-udp-gateway",
+			},
+			assertReports: func(gwNN types.NamespacedName, reportsMap reports.ReportMap) {
+				accepted := func(name string) *metav1.Condition {
+					route := &gwv1alpha2.UDPRoute{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      name,
+							Namespace: "default",
+						},
+						Spec: gwv1alpha2.UDPRouteSpec{
+							CommonRouteSpec: gwv1.CommonRouteSpec{
+								ParentRefs: []gwv1.ParentReference{{Name: "example-udp-gateway"}},
+							},
+						},
+					}
+					routeStatus := reportsMap.BuildRouteStatus(context.Background(), route, wellknown.DefaultGatewayClassName)
+					Expect(routeStatus).NotTo(BeNil())
+					Expect(routeStatus.Parents).To(HaveLen(1))
+					return meta.FindStatusCondition(routeStatus.Parents[0].Conditions, string(gwv1.RouteConditionAccepted))
+				}
+
+				// the oldest route with a single rule wins
+				Expect(accepted("older-udp-route").Status).To(Equal(metav1.ConditionTrue))
+				newer := accepted("newer-udp-route")
+				Expect(newer.Status).To(Equal(metav1.ConditionFalse))
+				Expect(newer.Reason).To(Equal(string(gwv1.RouteReasonUnsupportedValue)))
+				Expect(newer.Message).To(ContainSubstring("UDPRoute default/older-udp-route was attached to the listener first"))
+				multiRule := accepted("multi-rule-udp-route")
+				Expect(multiRule.Status).To(Equal(metav1.ConditionFalse))
+				Expect(multiRule.Message).To(Equal("UDPRoute must have exactly one rule, but it has 2"))
+			},
+		}),
 	Entry(
 		"tls gateway with basic routing",
 		translatorTestCase{
@@ -711,6 +995,43 @@ var _ = DescribeTable("Basic GatewayTranslator Tests",
 				Name:      "example-gateway",
 			},
 		}),
+	Entry("ListenerPolicy with PROXY protocol and connection limits", translatorTestCase{
+		inputFile:  "listener-policy/listener-policy.yaml",
+		outputFile: "listener-policy/listener-policy.yaml",
+		gwNN: types.NamespacedName{
+			Namespace: "default",
+			Name:      "example-gateway",
+		},
+		assertReports: func(gwNN types.NamespacedName, reportsMap reports.ReportMap) {
+			for _, name := range []string{"gateway-limits", "behind-nlb"} {
+				policy := reports.PolicyKey{Group: "gateway.kgateway.dev", Kind: "ListenerPolicy", Namespace: "default", Name: name}
+				status := reportsMap.BuildPolicyStatus(context.Background(), policy, wellknown.DefaultGatewayControllerName, gwv1alpha2.PolicyStatus{})
+				Expect(status).NotTo(BeNil())
+				Expect(status.Ancestors).To(HaveLen(1))
+				accepted := meta.FindStatusCondition(status.Ancestors[0].Conditions, string(gwv1alpha2.PolicyConditionAccepted))
+				Expect(accepted).NotTo(BeNil())
+				Expect(accepted.Status).To(Equal(metav1.ConditionTrue))
+			}
+		},
+	}),
+	Entry("ListenerPolicy targeting one of the listeners sharing a port", translatorTestCase{
+		inputFile:  "listener-policy/shared-port.yaml",
+		outputFile: "listener-policy/shared-port.yaml",
+		gwNN: types.NamespacedName{
+			Namespace: "default",
+			Name:      "example-gateway",
+		},
+		assertReports: func(gwNN types.NamespacedName, reportsMap reports.ReportMap) {
+			policy := reports.PolicyKey{Group: "gateway.kgateway.dev", Kind: "ListenerPolicy", Namespace: "default", Name: "behind-nlb"}
+			status := reportsMap.BuildPolicyStatus(context.Background(), policy, wellknown.DefaultGatewayControllerName, gwv1alpha2.PolicyStatus{})
+			Expect(status).NotTo(BeNil())
+			Expect(status.Ancestors).To(HaveLen(1))
+			accepted := meta.FindStatusCondition(status.Ancestors[0].Conditions, string(gwv1alpha2.PolicyConditionAccepted))
+			Expect(accepted).NotTo(BeNil())
+			Expect(accepted.Status).To(Equal(metav1.ConditionFalse))
+			Expect(accepted.Reason).To(Equal(string(gwv1alpha2.PolicyReasonConflicted)))
+		},
+	}),
 	Entry("HTTPListenerPolicy with upgrades", translatorTestCase{
 		inputFile:  "https-listener-pol/upgrades.yaml",
 		outputFile: "https-listener-pol/upgrades.yaml",
@@ -719,6 +1040,42 @@ var _ = DescribeTable("Basic GatewayTranslator Tests",
 			Name:      "example-gateway",
 		},
 	}),
+	Entry("HTTPListenerPolicy with compression", translatorTestCase{
+		inputFile:  "https-listener-pol/compression.yaml",
+		outputFile: "https-listener-pol/compression.yaml",
+		gwNN: types.NamespacedName{
+			Namespace: "default",
+			Name:      "example-gateway",
+		},
+	}),
+	Entry("HTTPListenerPolicy with HCM settings", translatorTestCase{
+		inputFile:  "https-listener-pol/hcm-settings.yaml",
+		outputFile: "https-listener-pol/hcm-settings.yaml",
+		gwNN: types.NamespacedName{
+			Namespace: "default",
+			Name:      "example-gateway",
+		},
+		assertReports: func(gwNN types.NamespacedName, reportsMap reports.ReportMap) {
+			conflicted := func(name string) *metav1.Condition {
+				policy := reports.PolicyKey{Group: "gateway.kgateway.dev", Kind: "HTTPListenerPolicy", Namespace: "default", Name: name}
+				status := reportsMap.BuildPolicyStatus(context.Background(), policy, wellknown.DefaultGatewayControllerName, gwv1alpha2.PolicyStatus{})
+				Expect(status).NotTo(BeNil())
+				Expect(status.Ancestors).To(HaveLen(1))
+				return meta.FindStatusCondition(status.Ancestors[0].Conditions, string(httplistenerpolicy.PolicyConditionConflicted))
+			}
+
+			defaults := conflicted("hcm-defaults")
+			Expect(defaults).NotTo(BeNil())
+			Expect(defaults.Status).To(Equal(metav1.ConditionTrue))
+			Expect(defaults.Reason).To(Equal(string(httplistenerpolicy.PolicyReasonFieldConflict)))
+			Expect(defaults.Message).To(Equal("idleTimeout is overridden by default/hcm-overrides"))
+
+			overrides := conflicted("hcm-overrides")
+			Expect(overrides).NotTo(BeNil())
+			Expect(overrides.Status).To(Equal(metav1.ConditionFalse))
+			Expect(overrides.Reason).To(Equal(string(httplistenerpolicy.PolicyReasonNoConflicts)))
+		},
+	}),
 	Entry("Service with appProtocol=kubernetes.io/h2c", translatorTestCase{
 		inputFile:  "backend-protocol/svc-h2c.yaml",
 		outputFile: "backend-protocol/svc-h2c.yaml",
@@ -815,6 +1172,22 @@ var _ = DescribeTable("Basic GatewayTranslator Tests",
 			Name:      "example-gateway",
 		},
 	}),
+	Entry("Backend Config Policy with timeouts and proxy protocol", translatorTestCase{
+		inputFile:  "backendconfigpolicy/proxy-protocol.yaml",
+		outputFile: "backendconfigpolicy/proxy-protocol.yaml",
+		gwNN: types.NamespacedName{
+			Namespace: "default",
+			Name:      "example-gateway",
+		},
+	}),
+	Entry("Backend Config Policy with circuit breakers and outlier detection", translatorTestCase{
+		inputFile:  "backendconfigpolicy/outlier-detection.yaml",
+		outputFile: "backendconfigpolicy/outlier-detection.yaml",
+		gwNN: types.NamespacedName{
+			Namespace: "default",
+			Name:      "example-gateway",
+		},
+	}),
 	Entry(
 		"TrafficPolicy with explicit generation",
 		translatorTestCase{
@@ -3,6 +3,8 @@ package irtranslator
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"sort"
 
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
@@ -11,6 +13,7 @@ import (
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
 	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoymatcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
@@ -34,8 +37,13 @@ type filterChainTranslator struct {
 	listener        ir.ListenerIR
 	gateway         ir.GatewayIR
 	routeConfigName string
+	// downstreamTlsSds references the listener certificates by SDS name instead of inlining them
+	downstreamTlsSds bool
 
 	PluginPass TranslationPassPlugins
+
+	// httpFilters are the http filters of the last HttpConnectionManager computed
+	httpFilters []*envoyhttp.HttpFilter
 }
 
 func computeListenerAddress(bindAddress string, port uint32, reporter reports.GatewayReporter) *envoy_config_core_v3.Address {
@@ -87,7 +95,7 @@ func (h *filterChainTranslator) initFilterChain(ctx context.Context, fcc ir.Filt
 	fc := &envoy_config_listener_v3.FilterChain{
 		Name:             fcc.FilterChainName,
 		FilterChainMatch: info.toMatch(),
-		TransportSocket:  info.toTransportSocket(),
+		TransportSocket:  info.toTransportSocket(h.downstreamTlsSds),
 	}
 
 	return fc
@@ -110,16 +118,18 @@ func (h *filterChainTranslator) computeHttpFilters(ctx context.Context, l ir.Htt
 
 func (n *filterChainTranslator) computeNetworkFiltersForHttp(ctx context.Context, l ir.HttpFilterChainIR, reporter reports.ListenerReporter) ([]*envoy_config_listener_v3.Filter, error) {
 	hcm := hcmNetworkFilterTranslator{
-		routeConfigName: n.routeConfigName,
-		PluginPass:      n.PluginPass,
-		reporter:        reporter,
-		gateway:         n.gateway, // corresponds to Gateway API listener
+		routeConfigName:   n.routeConfigName,
+		PluginPass:        n.PluginPass,
+		reporter:          reporter,
+		gateway:           n.gateway, // corresponds to Gateway API listener
+		policyAncestorRef: n.listener.PolicyAncestorRef,
 	}
 	networkFilters := sortNetworkFilters(n.computeCustomFilters(ctx, l.CustomNetworkFilters, reporter))
 	networkFilter, err := hcm.computeNetworkFilters(ctx, l)
 	if err != nil {
 		return nil, err
 	}
+	n.httpFilters = hcm.httpFilters
 	networkFilters = append(networkFilters, networkFilter)
 	return networkFilters, nil
 }
@@ -188,19 +198,30 @@ type hcmNetworkFilterTranslator struct {
 	reporter        reports.ListenerReporter
 	listener        ir.HttpFilterChainIR // policies attached to listener
 	gateway         ir.GatewayIR         // policies attached to gateway
+	// the ancestor the status of the policies is reported for
+	policyAncestorRef gwv1.ParentReference
+
+	// httpFilters are the http filters of the computed HttpConnectionManager
+	httpFilters []*envoyhttp.HttpFilter
 }
 
 func (h *hcmNetworkFilterTranslator) computeNetworkFilters(ctx context.Context, l ir.HttpFilterChainIR) (*envoy_config_listener_v3.Filter, error) {
 	// 1. Initialize the HttpConnectionManager (HCM)
 	httpConnectionManager := h.initializeHCM()
-
-	// 2. Apply HttpFilters
-	var err error
-	httpConnectionManager.HttpFilters = h.computeHttpFilters(ctx, l)
+	// forward the identity of the validated client certificates to the upstreams
+	if l.TLS != nil && l.TLS.FrontendValidation != nil {
+		httpConnectionManager.ForwardClientCertDetails = envoyhttp.HttpConnectionManager_SANITIZE_SET
+		httpConnectionManager.SetCurrentClientCertDetails = &envoyhttp.HttpConnectionManager_SetCurrentClientCertDetails{
+			Subject: wrapperspb.Bool(true),
+			Uri:     true,
+			Dns:     true,
+		}
+	}
 
 	pass := h.PluginPass
 
-	// 3. Allow any HCM plugins to make their changes, with respect to any changes the core plugin made
+	// 2. Allow any HCM plugins to make their changes. This runs before the http filters are computed
+	// so that plugins can add http filters based on the policies attached to the filter chain.
 	var attachedPolicies ir.AttachedPolicies
 	attachedPolicies.Append(h.gateway.AttachedHttpPolicies, l.AttachedPolicies)
 	for _, gk := range attachedPolicies.ApplyOrderedGroupKinds() {
@@ -212,7 +233,11 @@ func (h *hcmNetworkFilterTranslator) computeNetworkFilters(ctx context.Context,
 		}
 		for _, pol := range pols {
 			pctx := &ir.HcmContext{
-				Policy: pol.PolicyIr,
+				Policy:            pol.PolicyIr,
+				FilterChainName:   l.FilterChainName,
+				PolicyRef:         pol.PolicyRef,
+				Generation:        pol.Generation,
+				PolicyAncestorRef: h.policyAncestorRef,
 			}
 			if err := pass.ApplyHCM(ctx, pctx, httpConnectionManager); err != nil {
 				h.reporter.SetCondition(reports.ListenerCondition{
@@ -225,6 +250,10 @@ func (h *hcmNetworkFilterTranslator) computeNetworkFilters(ctx context.Context,
 		}
 	}
 
+	// 3. Apply HttpFilters
+	httpConnectionManager.HttpFilters = h.computeHttpFilters(ctx, l)
+	h.httpFilters = httpConnectionManager.GetHttpFilters()
+
 	// TODO: should we enable websockets by default?
 
 	// 4. Generate the typedConfig for the HCM
@@ -251,12 +280,7 @@ func (h *hcmNetworkFilterTranslator) initializeHCM() *envoyhttp.HttpConnectionMa
 		UseRemoteAddress: wrapperspb.Bool(true),
 		RouteSpecifier: &envoyhttp.HttpConnectionManager_Rds{
 			Rds: &envoyhttp.Rds{
-				ConfigSource: &envoy_config_core_v3.ConfigSource{
-					ResourceApiVersion: envoy_config_core_v3.ApiVersion_V3,
-					ConfigSourceSpecifier: &envoy_config_core_v3.ConfigSource_Ads{
-						Ads: &envoy_config_core_v3.AggregatedConfigSource{},
-					},
-				},
+				ConfigSource:    adsConfigSource(),
 				RouteConfigName: h.routeConfigName,
 			},
 		},
@@ -444,7 +468,7 @@ func (info *FilterChainInfo) toMatch() *envoy_config_listener_v3.FilterChainMatc
 	}
 }
 
-func (info *FilterChainInfo) toTransportSocket() *envoy_config_core_v3.TransportSocket {
+func (info *FilterChainInfo) toTransportSocket(sds bool) *envoy_config_core_v3.TransportSocket {
 	if info == nil {
 		return nil
 	}
@@ -454,22 +478,28 @@ func (info *FilterChainInfo) toTransportSocket() *envoy_config_core_v3.Transport
 	}
 
 	common := &envoyauth.CommonTlsContext{
-		// default params
-		TlsParams:     &envoyauth.TlsParameters{},
+		TlsParams:     tlsParameters(ssl.Params),
 		AlpnProtocols: ssl.AlpnProtocols,
 	}
 
-	common.TlsCertificates = []*envoyauth.TlsCertificate{
-		{
-			CertificateChain: bytesDataSource(ssl.CertChain),
-			PrivateKey:       bytesDataSource(ssl.PrivateKey),
-		},
+	for _, cert := range ssl.Certificates {
+		if sds && cert.SdsSecretName != "" {
+			common.TlsCertificateSdsSecretConfigs = append(common.GetTlsCertificateSdsSecretConfigs(), &envoyauth.SdsSecretConfig{
+				Name:      cert.SdsSecretName,
+				SdsConfig: adsConfigSource(),
+			})
+		} else {
+			common.TlsCertificates = append(common.GetTlsCertificates(), tlsCertificate(cert))
+		}
 	}
 
-	//	var requireClientCert *wrappers.BoolValue
-	//	if common.GetValidationContextType() != nil {
-	//		requireClientCert = &wrappers.BoolValue{Value: !dc.GetOneWayTls().GetValue()}
-	//	}
+	var requireClientCert *wrapperspb.BoolValue
+	if fv := ssl.FrontendValidation; fv != nil {
+		common.ValidationContextType = &envoyauth.CommonTlsContext_ValidationContext{
+			ValidationContext: frontendValidationContext(fv),
+		}
+		requireClientCert = wrapperspb.Bool(!fv.Optional)
+	}
 
 	// default alpn for downstreams.
 	//	if len(common.GetAlpnProtocols()) == 0 {
@@ -479,7 +509,8 @@ func (info *FilterChainInfo) toTransportSocket() *envoy_config_core_v3.Transport
 	//	}
 
 	out := &envoyauth.DownstreamTlsContext{
-		CommonTlsContext: common,
+		CommonTlsContext:         common,
+		RequireClientCertificate: requireClientCert,
 	}
 	typedConfig, _ := utils.MessageToAny(out)
 
@@ -489,6 +520,108 @@ func (info *FilterChainInfo) toTransportSocket() *envoy_config_core_v3.Transport
 	}
 }
 
+func tlsCertificate(cert ir.TlsCertificate) *envoyauth.TlsCertificate {
+	return &envoyauth.TlsCertificate{
+		CertificateChain: bytesDataSource(cert.CertChain),
+		PrivateKey:       bytesDataSource(cert.PrivateKey),
+	}
+}
+
+var tlsProtocolVersions = map[string]envoyauth.TlsParameters_TlsProtocol{
+	"1.0": envoyauth.TlsParameters_TLSv1_0,
+	"1.1": envoyauth.TlsParameters_TLSv1_1,
+	"1.2": envoyauth.TlsParameters_TLSv1_2,
+	"1.3": envoyauth.TlsParameters_TLSv1_3,
+}
+
+// tlsParameters returns the TLS parameters of a listener. The versions of the parameters are validated
+// when the listener is translated to the IR; TLS_AUTO is used for the unset ones.
+func tlsParameters(params *ir.TlsParameters) *envoyauth.TlsParameters {
+	if params == nil {
+		// default params
+		return &envoyauth.TlsParameters{}
+	}
+	return &envoyauth.TlsParameters{
+		TlsMinimumProtocolVersion: tlsProtocolVersions[params.MinVersion],
+		TlsMaximumProtocolVersion: tlsProtocolVersions[params.MaxVersion],
+		CipherSuites:              params.CipherSuites,
+		EcdhCurves:                params.EcdhCurves,
+	}
+}
+
+// frontendValidationContext validates the certificates of the clients against the trusted CAs, and
+// optionally against a set of SANs and certificate hashes.
+func frontendValidationContext(fv *ir.FrontendTlsValidation) *envoyauth.CertificateValidationContext {
+	out := &envoyauth.CertificateValidationContext{
+		TrustedCa:             bytesDataSource(fv.CACertificates),
+		VerifyCertificateHash: fv.CertificateHashes,
+	}
+	if len(fv.Crl) > 0 {
+		out.Crl = bytesDataSource(fv.Crl)
+	}
+	for _, san := range fv.SubjectAltNames {
+		out.MatchTypedSubjectAltNames = append(out.GetMatchTypedSubjectAltNames(), &envoyauth.SubjectAltNameMatcher{
+			SanType: subjectAltNameTypes[san.Type],
+			Matcher: &envoymatcher.StringMatcher{
+				MatchPattern: &envoymatcher.StringMatcher_Exact{Exact: san.Value},
+			},
+		})
+	}
+	return out
+}
+
+var subjectAltNameTypes = map[string]envoyauth.SubjectAltNameMatcher_SanType{
+	"dns":   envoyauth.SubjectAltNameMatcher_DNS,
+	"uri":   envoyauth.SubjectAltNameMatcher_URI,
+	"email": envoyauth.SubjectAltNameMatcher_EMAIL,
+	"ip":    envoyauth.SubjectAltNameMatcher_IP_ADDRESS,
+}
+
+// tlsSecrets returns the SDS secrets of the certificates of the listeners of the gateway,
+// sorted by name.
+func tlsSecrets(gw ir.GatewayIR) []*envoyauth.Secret {
+	secrets := map[string]*envoyauth.Secret{}
+	add := func(ssl *ir.TlsBundle) {
+		if ssl == nil {
+			return
+		}
+		for _, cert := range ssl.Certificates {
+			if cert.SdsSecretName == "" {
+				continue
+			}
+			secrets[cert.SdsSecretName] = &envoyauth.Secret{
+				Name: cert.SdsSecretName,
+				Type: &envoyauth.Secret_TlsCertificate{
+					TlsCertificate: tlsCertificate(cert),
+				},
+			}
+		}
+	}
+	for _, l := range gw.Listeners {
+		for _, fc := range l.HttpFilterChain {
+			add(fc.TLS)
+		}
+		for _, fc := range l.TcpFilterChain {
+			add(fc.TLS)
+		}
+	}
+	var out []*envoyauth.Secret
+	for _, name := range slices.Sorted(maps.Keys(secrets)) {
+		out = append(out, secrets[name])
+	}
+	return out
+}
+
+// adsConfigSource fetches resources from the xDS server on the ADS stream of the proxy.
+func adsConfigSource() *envoy_config_core_v3.ConfigSource {
+	return &envoy_config_core_v3.ConfigSource{
+		ResourceApiVersion: envoy_config_core_v3.ApiVersion_V3,
+		ConfigSourceSpecifier: &envoy_config_core_v3.ConfigSource_Ads{
+			Ads: &envoy_config_core_v3.AggregatedConfigSource{},
+		},
+	}
+}
+
 func bytesDataSource(s []byte) *envoy_config_core_v3.DataSource {
 	return &envoy_config_core_v3.DataSource{
 		Specifier: &envoy_config_core_v3.DataSource_InlineBytes{
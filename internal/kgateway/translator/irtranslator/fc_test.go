@@ -2,9 +2,13 @@ package irtranslator_test
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyhcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 
 	extensionsplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
@@ -15,6 +19,7 @@ import (
 	"istio.io/istio/pkg/ptr"
 	"istio.io/istio/pkg/slices"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -116,3 +121,231 @@ func TestFilterChains(t *testing.T) {
 		}
 	}
 }
+
+var hcmFiltersGK = schema.GroupKind{
+	Group: "test.kgateway.dev",
+	Kind:  "HcmFilterForTest",
+}
+
+type hcmFiltersPolicy struct{}
+
+func (hcmFiltersPolicy) CreationTime() time.Time { return time.Time{} }
+func (hcmFiltersPolicy) Equals(in any) bool      { _, ok := in.(hcmFiltersPolicy); return ok }
+
+// hcmFilters implements a test translation pass that adds an http filter to the filter chains
+// its policy is applied to in ApplyHCM, and toggles filters on the routes.
+type hcmFilters struct {
+	ir.UnimplementedProxyTranslationPass
+	inChain map[string]bool
+}
+
+func (h *hcmFilters) ApplyHCM(ctx context.Context, pCtx *ir.HcmContext, out *envoyhcm.HttpConnectionManager) error {
+	h.inChain[pCtx.FilterChainName] = true
+	out.ServerName = testPluginFilterName
+	return nil
+}
+
+func (h *hcmFilters) ApplyRouteConfigPlugin(ctx context.Context, pCtx *ir.RouteConfigContext, out *routev3.RouteConfiguration) {
+	pCtx.TypedFilterConfig.AddTypedConfig(testPluginFilterName, &routev3.FilterConfig{Disabled: true})
+	pCtx.TypedFilterConfig.AddTypedConfig(testMissingFilterName, &routev3.FilterConfig{Disabled: true})
+	pCtx.TypedFilterConfig.AddTypedConfig(testMissingFilterConfigName, &routev3.Route{})
+}
+
+func (h *hcmFilters) HttpFilters(ctx context.Context, fcc ir.FilterChainCommon) ([]plugins.StagedHttpFilter, error) {
+	if !h.inChain[fcc.FilterChainName] {
+		return nil, nil
+	}
+	return []plugins.StagedHttpFilter{
+		plugins.MustNewStagedFilter(testPluginFilterName, &routev3.Route{}, plugins.BeforeStage(plugins.AuthZStage)),
+	}, nil
+}
+
+const (
+	testMissingFilterName       = "missing-filter"
+	testMissingFilterConfigName = "missing-filter-config"
+)
+
+func TestHcmFilters(t *testing.T) {
+	ctx := context.Background()
+	translator := irtranslator.Translator{}
+
+	gateway := ir.GatewayIR{
+		SourceObject: &ir.Gateway{Obj: &gwv1.Gateway{}},
+		AttachedHttpPolicies: ir.AttachedPolicies{
+			Policies: map[schema.GroupKind][]ir.PolicyAtt{
+				hcmFiltersGK: {{GroupKind: hcmFiltersGK, PolicyIr: hcmFiltersPolicy{}}},
+			},
+		},
+	}
+	listener := ir.ListenerIR{
+		HttpFilterChain: []ir.HttpFilterChainIR{{
+			FilterChainCommon: ir.FilterChainCommon{
+				FilterChainName: "httpchain",
+			},
+		}},
+	}
+
+	reportMap := reports.NewReportMap()
+	reporter := reports.NewReporter(&reportMap)
+
+	envoyListener, routes := translator.ComputeListener(
+		ctx,
+		irtranslator.TranslationPassPlugins{
+			hcmFiltersGK: &irtranslator.TranslationPass{ProxyTranslationPass: &hcmFilters{inChain: map[string]bool{}}},
+		},
+		gateway,
+		listener,
+		reporter,
+	)
+
+	// the filters added based on the policies applied in ApplyHCM are in the chain,
+	// along with the changes made to the HttpConnectionManager
+	filters := envoyListener.GetFilterChains()[0].GetFilters()
+	hcm := &envoyhcm.HttpConnectionManager{}
+	if err := filters[len(filters)-1].GetTypedConfig().UnmarshalTo(hcm); err != nil {
+		t.Fatal(err)
+	}
+	if hcm.GetServerName() != testPluginFilterName {
+		t.Errorf("got server name %q, wanted %q", hcm.GetServerName(), testPluginFilterName)
+	}
+	if slices.FindFunc(hcm.GetHttpFilters(), func(f *envoyhcm.HttpFilter) bool {
+		return f.GetName() == testPluginFilterName
+	}) == nil {
+		t.Errorf("http filters %v missing expected filter %q", hcm.GetHttpFilters(), testPluginFilterName)
+	}
+
+	// only the toggles of the filters in the chain are kept
+	if len(routes) != 1 {
+		t.Fatal("got", len(routes), "route configurations, but wanted 1")
+	}
+	typedPerFilterConfig := routes[0].GetTypedPerFilterConfig()
+	for _, name := range []string{testPluginFilterName, testMissingFilterConfigName} {
+		if _, ok := typedPerFilterConfig[name]; !ok {
+			t.Errorf("route configuration missing expected per filter config %q", name)
+		}
+	}
+	if _, ok := typedPerFilterConfig[testMissingFilterName]; ok {
+		t.Errorf("route configuration has per filter config %q of a filter not in the chain", testMissingFilterName)
+	}
+}
+
+var routeDefaultsGK = schema.GroupKind{
+	Group: "test.kgateway.dev",
+	Kind:  "RouteDefaultsForTest",
+}
+
+const testRouteDefaultHeader = "x-route-default"
+
+type routeDefaultsPolicy struct{}
+
+func (routeDefaultsPolicy) CreationTime() time.Time { return time.Time{} }
+func (routeDefaultsPolicy) Equals(in any) bool      { _, ok := in.(routeDefaultsPolicy); return ok }
+
+// StatusMessage names the routes the policy is applied to.
+func (routeDefaultsPolicy) StatusMessage(routes []*ir.HttpRouteIR) string {
+	names := slices.Map(routes, func(r *ir.HttpRouteIR) string { return r.GetSourceObject().GetName() })
+	return "applied to " + strings.Join(names, ",")
+}
+
+// routeDefaults implements a test translation pass that sets a direct response on the routes
+// its policy is attached to, and a default on all the routes of the route configuration.
+type routeDefaults struct {
+	ir.UnimplementedProxyTranslationPass
+}
+
+func (routeDefaults) ApplyForRoute(ctx context.Context, pCtx *ir.RouteContext, out *routev3.Route) error {
+	out.Action = &routev3.Route_DirectResponse{DirectResponse: &routev3.DirectResponseAction{Status: 200}}
+	return nil
+}
+
+func (routeDefaults) ApplyRouteConfigPlugin(ctx context.Context, pCtx *ir.RouteConfigContext, out *routev3.RouteConfiguration) {
+	for _, vhost := range out.GetVirtualHosts() {
+		for _, route := range vhost.GetRoutes() {
+			route.RequestHeadersToRemove = append(route.GetRequestHeadersToRemove(), testRouteDefaultHeader)
+		}
+	}
+}
+
+func TestRouteConfigPluginsApplyToRoutes(t *testing.T) {
+	ctx := context.Background()
+	translator := irtranslator.Translator{}
+
+	gatewayPolicyRef := &ir.AttachedPolicyRef{Group: routeDefaultsGK.Group, Kind: routeDefaultsGK.Kind, Namespace: "default", Name: "gateway-policy"}
+	routePolicyRef := &ir.AttachedPolicyRef{Group: routeDefaultsGK.Group, Kind: routeDefaultsGK.Kind, Namespace: "default", Name: "route-policy"}
+	gateway := ir.GatewayIR{
+		SourceObject: &ir.Gateway{Obj: &gwv1.Gateway{}},
+		AttachedHttpPolicies: ir.AttachedPolicies{
+			Policies: map[schema.GroupKind][]ir.PolicyAtt{
+				routeDefaultsGK: {{GroupKind: routeDefaultsGK, PolicyIr: routeDefaultsPolicy{}, PolicyRef: gatewayPolicyRef}},
+			},
+		},
+	}
+	rule := func(name string) ir.HttpRouteRuleMatchIR {
+		return ir.HttpRouteRuleMatchIR{
+			Parent: &ir.HttpRouteIR{
+				SourceObject: &gwv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name}},
+			},
+			AttachedPolicies: ir.AttachedPolicies{
+				Policies: map[schema.GroupKind][]ir.PolicyAtt{
+					routeDefaultsGK: {{GroupKind: routeDefaultsGK, PolicyIr: routeDefaultsPolicy{}, PolicyRef: routePolicyRef}},
+				},
+			},
+			Match: gwv1.HTTPRouteMatch{Path: &gwv1.HTTPPathMatch{Type: ptr.Of(gwv1.PathMatchPathPrefix), Value: ptr.Of("/" + name)}},
+		}
+	}
+	listener := ir.ListenerIR{
+		HttpFilterChain: []ir.HttpFilterChainIR{{
+			FilterChainCommon: ir.FilterChainCommon{
+				FilterChainName: "httpchain",
+			},
+			Vhosts: []*ir.VirtualHost{{
+				Name:     "vhost",
+				Hostname: "example.com",
+				Rules:    []ir.HttpRouteRuleMatchIR{rule("a"), rule("b")},
+			}},
+		}},
+	}
+
+	reportMap := reports.NewReportMap()
+	reporter := reports.NewReporter(&reportMap)
+
+	_, routes := translator.ComputeListener(
+		ctx,
+		irtranslator.TranslationPassPlugins{
+			routeDefaultsGK: &irtranslator.TranslationPass{ProxyTranslationPass: routeDefaults{}},
+		},
+		gateway,
+		listener,
+		reporter,
+	)
+
+	// the route configuration plugins run after the routes are translated
+	if len(routes) != 1 || len(routes[0].GetVirtualHosts()) != 1 {
+		t.Fatal("got route configurations", routes, "but wanted a single virtual host")
+	}
+	envoyRoutes := routes[0].GetVirtualHosts()[0].GetRoutes()
+	if len(envoyRoutes) != 2 {
+		t.Fatal("got", len(envoyRoutes), "routes, but wanted 2")
+	}
+	for _, route := range envoyRoutes {
+		if route.GetDirectResponse().GetStatus() != 200 {
+			t.Errorf("route %s has action %v, wanted the direct response of the route policy", route.GetName(), route.GetAction())
+		}
+		if !slices.Contains(route.GetRequestHeadersToRemove(), testRouteDefaultHeader) {
+			t.Errorf("route %s missing the default of the route configuration policy", route.GetName())
+		}
+	}
+
+	// the status of the policies names all the routes they are applied to
+	for _, ref := range []*ir.AttachedPolicyRef{gatewayPolicyRef, routePolicyRef} {
+		report := reportMap.Policies[reports.PolicyKey{Group: ref.Group, Kind: ref.Kind, Namespace: ref.Namespace, Name: ref.Name}]
+		if report == nil || len(report.Ancestors) != 1 {
+			t.Fatal("got report", report, "for policy", ref.Name, "but wanted a single ancestor")
+		}
+		for _, ancestor := range report.Ancestors {
+			if len(ancestor.Conditions) != 1 || !strings.HasSuffix(ancestor.Conditions[0].Message, "applied to a,b") {
+				t.Errorf("got conditions %v for policy %s, wanted the accepted condition naming both routes", ancestor.Conditions, ref.Name)
+			}
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"golang.org/x/net/context"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"istio.io/istio/pkg/slices"
@@ -30,7 +31,9 @@ var logger = logging.New("translator/ir")
 type Translator struct {
 	ContributedPolicies  map[schema.GroupKind]extensionsplug.PolicyPlugin
 	RouteReplacementMode settings.RouteReplacementMode
-	metrics              metrics.TranslatorMetricsRecorder
+	// DownstreamTlsSds serves the listener certificates as SDS secrets instead of inlining them.
+	DownstreamTlsSds bool
+	metrics          metrics.TranslatorMetricsRecorder
 }
 
 type TranslationPassPlugins map[schema.GroupKind]*TranslationPass
@@ -39,6 +42,8 @@ type TranslationResult struct {
 	Routes        []*envoy_config_route_v3.RouteConfiguration
 	Listeners     []*envoy_config_listener_v3.Listener
 	ExtraClusters []*envoy_config_cluster_v3.Cluster
+	// Secrets are the SDS secrets referenced by the listeners.
+	Secrets []*envoyauth.Secret
 }
 
 // Translate IR to gateway. IR is self contained, so no need for krt context
@@ -66,6 +71,10 @@ func (t *Translator) Translate(gw ir.GatewayIR, reporter reports.Reporter) Trans
 		}
 	}
 
+	if t.DownstreamTlsSds {
+		res.Secrets = tlsSecrets(gw)
+	}
+
 	return res
 }
 
@@ -89,6 +98,9 @@ func (t *Translator) ComputeListener(
 ) (*envoy_config_listener_v3.Listener, []*envoy_config_route_v3.RouteConfiguration) {
 	hasTls := false
 	gwreporter := reporter.Gateway(gw.SourceObject.Obj)
+	if lis.Udp != nil {
+		return computeUdpListener(lis, gwreporter), nil
+	}
 	var routes []*envoy_config_route_v3.RouteConfiguration
 	ret := &envoy_config_listener_v3.Listener{
 		Name:    lis.Name,
@@ -101,10 +113,11 @@ func (t *Translator) ComputeListener(
 
 	for _, hfc := range lis.HttpFilterChain {
 		fct := filterChainTranslator{
-			listener:        lis,
-			gateway:         gw,
-			routeConfigName: hfc.FilterChainName,
-			PluginPass:      pass,
+			listener:         lis,
+			gateway:          gw,
+			routeConfigName:  hfc.FilterChainName,
+			downstreamTlsSds: t.DownstreamTlsSds,
+			PluginPass:       pass,
 		}
 
 		// compute routes
@@ -145,6 +158,8 @@ func (t *Translator) ComputeListener(
 		rl := getReporterForFilterChain(gw, reporter, hfc.FilterChainName)
 		fc := fct.initFilterChain(ctx, hfc.FilterChainCommon, rl)
 		fc.Filters = fct.computeHttpFilters(ctx, hfc, rl)
+		removeTogglesOfMissingFilters(rc, fct.httpFilters)
+		t.runFilterChainPlugins(ctx, pass, gw, lis, hfc.AttachedNetworkPolicies, fc)
 		ret.FilterChains = append(ret.GetFilterChains(), fc)
 		if len(hfc.Matcher.SniDomains) > 0 {
 			hasTls = true
@@ -152,15 +167,17 @@ func (t *Translator) ComputeListener(
 	}
 
 	fct := filterChainTranslator{
-		listener:   lis,
-		gateway:    gw,
-		PluginPass: pass,
+		listener:         lis,
+		gateway:          gw,
+		downstreamTlsSds: t.DownstreamTlsSds,
+		PluginPass:       pass,
 	}
 
 	for _, tfc := range lis.TcpFilterChain {
 		rl := getReporterForFilterChain(gw, reporter, tfc.FilterChainName)
 		fc := fct.initFilterChain(ctx, tfc.FilterChainCommon, rl)
 		fc.Filters = fct.computeTcpFilters(ctx, tfc, rl)
+		t.runFilterChainPlugins(ctx, pass, gw, lis, tfc.AttachedNetworkPolicies, fc)
 		ret.FilterChains = append(ret.GetFilterChains(), fc)
 		if len(tfc.Matcher.SniDomains) > 0 {
 			hasTls = true
@@ -196,6 +213,11 @@ func (t *Translator) runListenerPlugins(ctx context.Context, pass TranslationPas
 					Namespace: ptr.To(gwv1.Namespace(gw.SourceObject.GetNamespace())),
 					Name:      gwv1.ObjectName(gw.SourceObject.GetName()),
 				},
+				PolicyRef:  pol.PolicyRef,
+				Generation: pol.Generation,
+			}
+			if pol.PolicyRef != nil {
+				pctx.PartiallyAttached = l.PartiallyAttachedPolicies[pol.PolicyRef.ID()]
 			}
 			pass.ApplyListenerPlugin(ctx, pctx, out)
 			// TODO: check return value, if error returned, log error and report condition
@@ -203,6 +225,32 @@ func (t *Translator) runListenerPlugins(ctx context.Context, pass TranslationPas
 	}
 }
 
+func (t *Translator) runFilterChainPlugins(
+	ctx context.Context,
+	pass TranslationPassPlugins,
+	gw ir.GatewayIR,
+	l ir.ListenerIR,
+	fcPolicies ir.AttachedPolicies,
+	out *envoy_config_listener_v3.FilterChain,
+) {
+	var attachedPolicies ir.AttachedPolicies
+	// same order as the listener plugins: the most specific policies first
+	attachedPolicies.Append(fcPolicies, gw.AttachedHttpPolicies)
+	for _, gk := range attachedPolicies.ApplyOrderedGroupKinds() {
+		pass := pass[gk]
+		if pass == nil {
+			continue
+		}
+		for _, pol := range attachedPolicies.Policies[gk] {
+			pass.ApplyFilterChainPlugin(ctx, &ir.FilterChainContext{
+				Policy:            pol.PolicyIr,
+				FilterChainName:   out.GetName(),
+				PolicyAncestorRef: l.PolicyAncestorRef,
+			}, out)
+		}
+	}
+}
+
 func (t *Translator) newPass(reporter reports.Reporter) TranslationPassPlugins {
 	ret := TranslationPassPlugins{}
 	for k, v := range t.ContributedPolicies {
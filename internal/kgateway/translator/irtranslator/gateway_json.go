@@ -6,6 +6,7 @@ import (
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 )
@@ -45,6 +46,14 @@ func (tr *TranslationResult) MarshalJSON() ([]byte, error) {
 		result["ExtraClusters"] = clusters
 	}
 
+	if len(tr.Secrets) > 0 {
+		secrets, err := marshalProtoMessages(tr.Secrets, m)
+		if err != nil {
+			return nil, err
+		}
+		result["Secrets"] = secrets
+	}
+
 	// Marshal the result map to JSON
 	return json.Marshal(result)
 }
@@ -122,5 +131,20 @@ func (tr *TranslationResult) UnmarshalJSON(data []byte) error {
 		}
 	}
 
+	if secretsData, ok := result["Secrets"]; ok {
+		var secrets []json.RawMessage
+		if err := json.Unmarshal(secretsData, &secrets); err != nil {
+			return err
+		}
+		tr.Secrets = make([]*envoyauth.Secret, len(secrets))
+		for i, secretData := range secrets {
+			secret := &envoyauth.Secret{}
+			if err := m.Unmarshal(secretData, secret); err != nil {
+				return err
+			}
+			tr.Secrets[i] = secret
+		}
+	}
+
 	return nil
 }
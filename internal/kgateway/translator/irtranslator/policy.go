@@ -9,9 +9,13 @@ import (
 	reports "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
 )
 
+// reportPolicyAcceptanceStatus sets the Accepted condition of the policies for the ancestor.
+// routes are the HTTPRoutes the policies are applied to, used to add details on how they
+// are applied to the message of the condition.
 func reportPolicyAcceptanceStatus(
 	reporter reports.Reporter,
 	ancestorRef gwv1.ParentReference,
+	routes []*ir.HttpRouteIR,
 	policies ...ir.PolicyAtt,
 ) {
 	for _, policy := range policies {
@@ -44,8 +48,24 @@ func reportPolicyAcceptanceStatus(
 			Type:               gwv1alpha2.PolicyConditionAccepted,
 			Status:             metav1.ConditionTrue,
 			Reason:             gwv1alpha2.PolicyReasonAccepted,
-			Message:            reports.PolicyAcceptedMsg,
+			Message:            policy.AcceptedMessage(reports.PolicyAcceptedMsg, routes...),
 			ObservedGeneration: policy.Generation,
 		})
 	}
 }
+
+// routesOfVirtualHosts returns the HTTPRoutes the rules of the virtual hosts come from.
+func routesOfVirtualHosts(vhosts ...*ir.VirtualHost) []*ir.HttpRouteIR {
+	var routes []*ir.HttpRouteIR
+	seen := make(map[*ir.HttpRouteIR]bool)
+	for _, vhost := range vhosts {
+		for _, rule := range vhost.Rules {
+			if rule.Parent == nil || seen[rule.Parent] {
+				continue
+			}
+			seen[rule.Parent] = true
+			routes = append(routes, rule.Parent)
+		}
+	}
+	return routes
+}
@@ -11,8 +11,10 @@ import (
 
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -38,6 +40,10 @@ type httpRouteConfigurationTranslator struct {
 	PluginPass               TranslationPassPlugins
 	logger                   *slog.Logger
 	routeReplacementMode     settings.RouteReplacementMode
+
+	// policyRoutes tracks the HTTPRoutes each route level policy has been applied to, so that
+	// its status accounts for all of them and not only for the last one.
+	policyRoutes map[ir.AttachedPolicyRef][]*ir.HttpRouteIR
 }
 
 const WebSocketUpgradeType = "websocket"
@@ -52,6 +58,10 @@ func (h *httpRouteConfigurationTranslator) ComputeRouteConfiguration(ctx context
 	}
 	typedPerFilterConfigRoute := ir.TypedFilterConfigMap(map[string]proto.Message{})
 
+	// compute the virtual hosts first so that the route config plugins can
+	// apply defaults to routes that don't override them.
+	cfg.VirtualHosts = h.computeVirtualHosts(ctx, vhosts)
+
 	for _, gk := range attachedPolicies.ApplyOrderedGroupKinds() {
 		pols := attachedPolicies.Policies[gk]
 		pass := h.PluginPass[gk]
@@ -59,7 +69,7 @@ func (h *httpRouteConfigurationTranslator) ComputeRouteConfiguration(ctx context
 			// TODO: user error - they attached a non http policy
 			continue
 		}
-		reportPolicyAcceptanceStatus(h.reporter, h.listener.PolicyAncestorRef, pols...)
+		reportPolicyAcceptanceStatus(h.reporter, h.listener.PolicyAncestorRef, routesOfVirtualHosts(vhosts...), pols...)
 		for _, pol := range mergePolicies(pass, pols) {
 			pass.ApplyRouteConfigPlugin(ctx, &ir.RouteConfigContext{
 				FilterChainName:   h.fc.FilterChainName,
@@ -69,7 +79,6 @@ func (h *httpRouteConfigurationTranslator) ComputeRouteConfiguration(ctx context
 		}
 	}
 
-	cfg.VirtualHosts = h.computeVirtualHosts(ctx, vhosts)
 	cfg.TypedPerFilterConfig = typedPerFilterConfigRoute.ToAnyMap()
 
 	// Gateway API spec requires that port values in HTTP Host headers be ignored when performing a match
@@ -242,7 +251,7 @@ func (h *httpRouteConfigurationTranslator) runVhostPlugins(
 			// TODO: user error - they attached a non http policy
 			continue
 		}
-		reportPolicyAcceptanceStatus(h.reporter, h.listener.PolicyAncestorRef, pols...)
+		reportPolicyAcceptanceStatus(h.reporter, h.listener.PolicyAncestorRef, routesOfVirtualHosts(virtualHost), pols...)
 		for _, pol := range mergePolicies(pass, pols) {
 			pctx := &ir.VirtualHostContext{
 				Policy:            pol.PolicyIr,
@@ -300,7 +309,9 @@ func (h *httpRouteConfigurationTranslator) runRoutePlugins(
 			// TODO: should never happen, log error and report condition
 			continue
 		}
-		reportPolicyAcceptanceStatus(h.reporter, h.listener.PolicyAncestorRef, pols...)
+		for _, pol := range pols {
+			reportPolicyAcceptanceStatus(h.reporter, h.listener.PolicyAncestorRef, h.routesOfPolicy(pol, in.Parent), pol)
+		}
 		pctx := &ir.RouteContext{
 			FilterChainName:   h.fc.FilterChainName,
 			In:                in,
@@ -330,6 +341,23 @@ func (h *httpRouteConfigurationTranslator) runRoutePlugins(
 	return err
 }
 
+// routesOfPolicy records that the policy is applied to the route and returns all the routes
+// it has been applied to so far.
+func (h *httpRouteConfigurationTranslator) routesOfPolicy(pol ir.PolicyAtt, route *ir.HttpRouteIR) []*ir.HttpRouteIR {
+	if pol.PolicyRef == nil {
+		return nil
+	}
+	if h.policyRoutes == nil {
+		h.policyRoutes = make(map[ir.AttachedPolicyRef][]*ir.HttpRouteIR)
+	}
+	routes := h.policyRoutes[*pol.PolicyRef]
+	if route != nil && !slices.Contains(routes, route) {
+		routes = append(routes, route)
+		h.policyRoutes[*pol.PolicyRef] = routes
+	}
+	return routes
+}
+
 func mergePolicies(pass *TranslationPass, policies []ir.PolicyAtt) []ir.PolicyAtt {
 	if pass.MergePolicies != nil {
 		merged := [1]ir.PolicyAtt{pass.MergePolicies(policies)}
@@ -348,7 +376,7 @@ func (h *httpRouteConfigurationTranslator) runBackendPolicies(ctx context.Contex
 			// TODO: should never happen, log error and report condition
 			continue
 		}
-		reportPolicyAcceptanceStatus(h.reporter, h.listener.PolicyAncestorRef, pols...)
+		reportPolicyAcceptanceStatus(h.reporter, h.listener.PolicyAncestorRef, nil, pols...)
 		for _, pol := range mergePolicies(pass, pols) {
 			// Policy on extension ref
 			err := pass.ApplyForRouteBackend(ctx, pol.PolicyIr, pCtx)
@@ -686,3 +714,37 @@ func envoyQueryMatcher(in []gwv1.HTTPQueryParamMatch) []*envoy_config_route_v3.Q
 	}
 	return out
 }
+
+var filterConfigTypeURL = "type.googleapis.com/" + string((&envoy_config_route_v3.FilterConfig{}).ProtoReflect().Descriptor().FullName())
+
+// removeTogglesOfMissingFilters removes the FilterConfig entries, that only enable or disable a filter,
+// of the filters that are not in the filter chain of the route configuration. Plugins may set them
+// for filters added by other plugins, e.g. TrafficPolicies toggle the compression filters that
+// HTTPListenerPolicies add.
+func removeTogglesOfMissingFilters(rc *envoy_config_route_v3.RouteConfiguration, httpFilters []*envoyhttp.HttpFilter) {
+	if rc == nil || len(httpFilters) == 0 {
+		return
+	}
+	inChain := make(map[string]bool, len(httpFilters))
+	for _, f := range httpFilters {
+		inChain[f.GetName()] = true
+	}
+	removeToggles := func(typedPerFilterConfig map[string]*anypb.Any) {
+		for name, config := range typedPerFilterConfig {
+			if !inChain[name] && config.GetTypeUrl() == filterConfigTypeURL {
+				delete(typedPerFilterConfig, name)
+			}
+		}
+	}
+
+	removeToggles(rc.GetTypedPerFilterConfig())
+	for _, vhost := range rc.GetVirtualHosts() {
+		removeToggles(vhost.GetTypedPerFilterConfig())
+		for _, route := range vhost.GetRoutes() {
+			removeToggles(route.GetTypedPerFilterConfig())
+			for _, cluster := range route.GetRoute().GetWeightedClusters().GetClusters() {
+				removeToggles(cluster.GetTypedPerFilterConfig())
+			}
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package irtranslator
+
+import (
+	xdscorev3 "github.com/cncf/xds/go/xds/core/v3"
+	xdsmatcherv3 "github.com/cncf/xds/go/xds/type/matcher/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	udpproxyv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/udp/udp_proxy/v3"
+	networkinputsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/common_inputs/network/v3"
+	runtimefractionv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/input_matchers/runtime_fraction/v3"
+	envoytypev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+	reports "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+)
+
+const (
+	udpProxyListenerFilterName = "envoy.filters.udp_listener.udp_proxy"
+
+	sourcePortInputName        = "envoy.matching.inputs.source_port"
+	runtimeFractionMatcherName = "envoy.matching.input_matchers.runtime_fraction"
+	udpRouteActionName         = "route"
+
+	udpWeightDenominator = 1_000_000
+)
+
+// computeUdpListener translates a UDP listener. UDP listeners have no filter chains; the udp_proxy
+// listener filter forwards the datagrams of each session to one of the route backends.
+func computeUdpListener(lis ir.ListenerIR, reporter reports.GatewayReporter) *envoy_config_listener_v3.Listener {
+	address := computeListenerAddress(lis.BindAddress, lis.BindPort, reporter)
+	address.GetSocketAddress().Protocol = envoy_config_core_v3.SocketAddress_UDP
+
+	udpProxy, _ := utils.MessageToAny(&udpproxyv3.UdpProxyConfig{
+		StatPrefix: lis.Udp.Name,
+		RouteSpecifier: &udpproxyv3.UdpProxyConfig_Matcher{
+			Matcher: udpRouteMatcher(lis.Udp.BackendRefs),
+		},
+	})
+	return &envoy_config_listener_v3.Listener{
+		Name:              lis.Name,
+		Address:           address,
+		UdpListenerConfig: &envoy_config_listener_v3.UdpListenerConfig{},
+		ListenerFilters: []*envoy_config_listener_v3.ListenerFilter{{
+			Name: udpProxyListenerFilterName,
+			ConfigType: &envoy_config_listener_v3.ListenerFilter_TypedConfig{
+				TypedConfig: udpProxy,
+			},
+		}},
+	}
+}
+
+// udpRouteMatcher builds the matcher selecting the cluster of a UDP session. The udp_proxy route
+// action only takes a single cluster, so the weights are applied by hashing the source port of the
+// session into cumulative fractions, one per backend, with the last backend as the fallback.
+func udpRouteMatcher(backends []ir.BackendRefIR) *xdsmatcherv3.Matcher {
+	weights := make([]uint64, len(backends))
+	var total uint64
+	for i, backend := range backends {
+		w := uint64(backend.Weight)
+		if w == 0 {
+			w = 1
+		}
+		weights[i] = w
+		total += w
+	}
+
+	last := len(backends) - 1
+	matcher := &xdsmatcherv3.Matcher{
+		OnNoMatch: udpRouteAction(backends[last].ClusterName),
+	}
+	if last == 0 {
+		return matcher
+	}
+
+	var (
+		matchers   []*xdsmatcherv3.Matcher_MatcherList_FieldMatcher
+		cumulative uint64
+	)
+	for i, backend := range backends[:last] {
+		cumulative += weights[i]
+		matchers = append(matchers, &xdsmatcherv3.Matcher_MatcherList_FieldMatcher{
+			Predicate: &xdsmatcherv3.Matcher_MatcherList_Predicate{
+				MatchType: &xdsmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate_{
+					SinglePredicate: &xdsmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate{
+						Input: typedExtensionConfig(sourcePortInputName, &networkinputsv3.SourcePortInput{}),
+						Matcher: &xdsmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate_CustomMatch{
+							CustomMatch: typedExtensionConfig(runtimeFractionMatcherName, &runtimefractionv3.RuntimeFraction{
+								RuntimeFraction: &envoy_config_core_v3.RuntimeFractionalPercent{
+									DefaultValue: &envoytypev3.FractionalPercent{
+										Numerator:   uint32(cumulative * udpWeightDenominator / total),
+										Denominator: envoytypev3.FractionalPercent_MILLION,
+									},
+								},
+							}),
+						},
+					},
+				},
+			},
+			OnMatch: udpRouteAction(backend.ClusterName),
+		})
+	}
+	matcher.MatcherType = &xdsmatcherv3.Matcher_MatcherList_{
+		MatcherList: &xdsmatcherv3.Matcher_MatcherList{
+			Matchers: matchers,
+		},
+	}
+	return matcher
+}
+
+func udpRouteAction(cluster string) *xdsmatcherv3.Matcher_OnMatch {
+	return &xdsmatcherv3.Matcher_OnMatch{
+		OnMatch: &xdsmatcherv3.Matcher_OnMatch_Action{
+			Action: typedExtensionConfig(udpRouteActionName, &udpproxyv3.Route{Cluster: cluster}),
+		},
+	}
+}
+
+func typedExtensionConfig(name string, msg proto.Message) *xdscorev3.TypedExtensionConfig {
+	typedConfig, _ := utils.MessageToAny(msg)
+	return &xdscorev3.TypedExtensionConfig{
+		Name:        name,
+		TypedConfig: typedConfig,
+	}
+}
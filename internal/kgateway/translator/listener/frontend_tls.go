@@ -0,0 +1,178 @@
+package listener
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"istio.io/istio/pkg/kube/krt"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/annotations"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/query"
+)
+
+// caCrlKey is the key of the optional certificate revocation list next to the ca.crt of a CA certificate ref.
+// If one CA certificate ref of a listener has a CRL, all of them must have one.
+const caCrlKey = "ca.crl"
+
+// errInvalidTlsOption is returned when the value of a TLS option of a listener is invalid.
+var errInvalidTlsOption = errors.New("invalid tls option")
+
+var gatewayGK = schema.GroupKind{
+	Group: gwv1.GroupName,
+	Kind:  "Gateway",
+}
+
+// translateFrontendValidation resolves the CA certificates trusted to issue client certificates, and
+// parses the TLS options refining the validation of client certificates.
+func translateFrontendValidation(
+	kctx krt.HandlerContext,
+	ctx context.Context,
+	parentNamespace string,
+	tls *gwv1.GatewayTLSConfig,
+	queries query.GatewayQueries,
+) (*ir.FrontendTlsValidation, error) {
+	if tls.FrontendValidation == nil {
+		return nil, nil
+	}
+
+	out := &ir.FrontendTlsValidation{}
+	var withCrl, withoutCrl []string
+	for _, ref := range tls.FrontendValidation.CACertificateRefs {
+		ca, crl, err := getCACertificate(kctx, ctx, parentNamespace, ref, queries)
+		if err != nil {
+			return nil, err
+		}
+		out.CACertificates = appendPem(out.CACertificates, ca)
+		if len(crl) > 0 {
+			out.Crl = appendPem(out.Crl, crl)
+			withCrl = append(withCrl, string(ref.Name))
+		} else {
+			withoutCrl = append(withoutCrl, string(ref.Name))
+		}
+	}
+	if len(out.CACertificates) == 0 {
+		return nil, fmt.Errorf("frontendValidation has no CA certificate refs")
+	}
+	if err := validateCrls(withCrl, withoutCrl); err != nil {
+		return nil, err
+	}
+
+	var err error
+	switch mode := string(tls.Options[annotations.ClientCertificateMode]); mode {
+	case "", annotations.ClientCertificateModeRequire:
+	case annotations.ClientCertificateModeOptional:
+		out.Optional = true
+	default:
+		return nil, fmt.Errorf("%w %s: unknown mode %q", errInvalidTlsOption, annotations.ClientCertificateMode, mode)
+	}
+	if out.SubjectAltNames, err = parseSubjectAltNames(string(tls.Options[annotations.VerifySubjectAltNames])); err != nil {
+		return nil, err
+	}
+	if out.CertificateHashes, err = parseCertificateHashes(string(tls.Options[annotations.VerifyCertificateHash])); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// validateCrls checks that either all or none of the CA certificate refs have a CRL. Once a CRL
+// is set, Envoy checks the revocation of the client certificates against it and rejects the
+// certificates issued by a CA it has no CRL for.
+func validateCrls(withCrl, withoutCrl []string) error {
+	if len(withCrl) > 0 && len(withoutCrl) > 0 {
+		return fmt.Errorf("CA certificate refs %s have a %s but %s do not: either all or none of the CA certificate refs must have one",
+			strings.Join(withCrl, ", "), caCrlKey, strings.Join(withoutCrl, ", "))
+	}
+	return nil
+}
+
+// getCACertificate returns the CA certificate and the optional CRL of a ConfigMap or Secret.
+func getCACertificate(
+	kctx krt.HandlerContext,
+	ctx context.Context,
+	parentNamespace string,
+	ref gwv1.ObjectReference,
+	queries query.GatewayQueries,
+) ([]byte, []byte, error) {
+	var data map[string][]byte
+	switch {
+	case ref.Group == "" && ref.Kind == "ConfigMap":
+		cm, err := queries.GetConfigMapForRef(kctx, ctx, gatewayGK, parentNamespace, ref)
+		if err != nil {
+			return nil, nil, err
+		}
+		data = map[string][]byte{}
+		for k, v := range cm.Data {
+			data[k] = []byte(v)
+		}
+	case ref.Group == "" && ref.Kind == "Secret":
+		secret, err := queries.GetSecretForRef(kctx, ctx, gatewayGK, parentNamespace, gwv1.SecretObjectReference{
+			Group:     &ref.Group,
+			Kind:      &ref.Kind,
+			Name:      ref.Name,
+			Namespace: ref.Namespace,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		data = secret.Data
+	default:
+		return nil, nil, fmt.Errorf("unsupported CA certificate ref kind %s/%s", ref.Group, ref.Kind)
+	}
+
+	ca := data[corev1.ServiceAccountRootCAKey]
+	if !x509.NewCertPool().AppendCertsFromPEM(ca) {
+		return nil, nil, fmt.Errorf("%s %s has no valid PEM certificate in key %s", ref.Kind, ref.Name, corev1.ServiceAccountRootCAKey)
+	}
+	return ca, data[caCrlKey], nil
+}
+
+func appendPem(bundle, pem []byte) []byte {
+	if len(pem) == 0 {
+		return bundle
+	}
+	if len(bundle) > 0 && bundle[len(bundle)-1] != '\n' {
+		bundle = append(bundle, '\n')
+	}
+	return append(bundle, pem...)
+}
+
+// parseSubjectAltNames parses a comma separated list of type-prefixed Subject Alternative Names.
+func parseSubjectAltNames(value string) ([]ir.SubjectAltName, error) {
+	var out []ir.SubjectAltName
+	for _, san := range splitOption(value) {
+		sanType, sanValue, ok := strings.Cut(san, ":")
+		if !ok || sanValue == "" {
+			return nil, fmt.Errorf("%w %s: %q is not of the form type:value", errInvalidTlsOption, annotations.VerifySubjectAltNames, san)
+		}
+		sanType = strings.ToLower(sanType)
+		switch sanType {
+		case "dns", "uri", "email", "ip":
+		default:
+			return nil, fmt.Errorf("%w %s: unknown type %q", errInvalidTlsOption, annotations.VerifySubjectAltNames, sanType)
+		}
+		out = append(out, ir.SubjectAltName{Type: sanType, Value: sanValue})
+	}
+	return out, nil
+}
+
+// parseCertificateHashes parses a comma separated list of hex-encoded SHA-256 hashes. The bytes of
+// the hashes may be separated by colons.
+func parseCertificateHashes(value string) ([]string, error) {
+	var out []string
+	for _, hash := range splitOption(value) {
+		b, err := hex.DecodeString(strings.ReplaceAll(hash, ":", ""))
+		if err != nil || len(b) != 32 {
+			return nil, fmt.Errorf("%w %s: %q is not a SHA-256 hash", errInvalidTlsOption, annotations.VerifyCertificateHash, hash)
+		}
+		out = append(out, hash)
+	}
+	return out, nil
+}
@@ -0,0 +1,79 @@
+package listener
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+func TestParseSubjectAltNames(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    []ir.SubjectAltName
+		wantErr bool
+	}{
+		{
+			name:  "empty",
+			value: "",
+		},
+		{
+			name:  "all types",
+			value: "dns:client.example.com, URI:spiffe://cluster.local/ns/default/sa/client,email:client@example.com,ip:10.0.0.1",
+			want: []ir.SubjectAltName{
+				{Type: "dns", Value: "client.example.com"},
+				{Type: "uri", Value: "spiffe://cluster.local/ns/default/sa/client"},
+				{Type: "email", Value: "client@example.com"},
+				{Type: "ip", Value: "10.0.0.1"},
+			},
+		},
+		{
+			name:    "missing type",
+			value:   "client.example.com",
+			wantErr: true,
+		},
+		{
+			name:    "unknown type",
+			value:   "rfc822:client@example.com",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got, err := parseSubjectAltNames(tt.value)
+			if tt.wantErr {
+				g.Expect(err).To(MatchError(errInvalidTlsOption))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestParseCertificateHashes(t *testing.T) {
+	const hash = "df6ff72fe9116521268f6f2dd4966f51df479883fe7037b39f75916ac3049d1a"
+	const colonHash = "DF:6F:F7:2F:E9:11:65:21:26:8F:6F:2D:D4:96:6F:51:DF:47:98:83:FE:70:37:B3:9F:75:91:6A:C3:04:9D:1A"
+
+	g := NewWithT(t)
+	got, err := parseCertificateHashes(hash + ", " + colonHash)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got).To(Equal([]string{hash, colonHash}))
+
+	_, err = parseCertificateHashes("df6ff72f")
+	g.Expect(err).To(MatchError(errInvalidTlsOption))
+
+	_, err = parseCertificateHashes("not-a-hash")
+	g.Expect(err).To(MatchError(errInvalidTlsOption))
+}
+
+func TestValidateCrls(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(validateCrls(nil, []string{"ca-1", "ca-2"})).To(Succeed())
+	g.Expect(validateCrls([]string{"ca-1", "ca-2"}, nil)).To(Succeed())
+	g.Expect(validateCrls([]string{"ca-1"}, []string{"ca-2", "ca-3"})).To(MatchError(
+		"CA certificate refs ca-1 have a ca.crl but ca-2, ca-3 do not: either all or none of the CA certificate refs must have one"))
+}
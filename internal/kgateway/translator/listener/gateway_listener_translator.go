@@ -15,6 +15,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 
+	"github.com/kgateway-dev/kgateway/v2/api/annotations"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/query"
@@ -22,6 +23,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/routeutils"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/sslutils"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+	sdsserver "github.com/kgateway-dev/kgateway/v2/internal/sds/pkg/server"
 	"github.com/kgateway-dev/kgateway/v2/pkg/logging"
 	reports "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
 )
@@ -105,6 +107,8 @@ func (ml *MergedListeners) AppendListener(
 		ml.AppendTcpListener(listener, routes, reporter)
 	case gwv1.TLSProtocolType:
 		ml.AppendTlsListener(listener, routes, reporter)
+	case gwv1.UDPProtocolType:
+		ml.AppendUdpListener(listener, routes, reporter)
 	default:
 		return fmt.Errorf("unsupported protocol: %v", listener.Protocol)
 	}
@@ -135,7 +139,7 @@ func (ml *MergedListeners) appendHttpListener(
 	finalPort := getListenerPortNumber(listener)
 
 	for _, lis := range ml.Listeners {
-		if lis.port == finalPort {
+		if lis.port == finalPort && lis.udpProxy == nil {
 			if lis.httpFilterChain != nil {
 				lis.httpFilterChain.parents = append(lis.httpFilterChain.parents, parent)
 			} else {
@@ -180,7 +184,7 @@ func (ml *MergedListeners) appendHttpsListener(
 
 	listenerName := GenerateListenerName(listener)
 	for _, lis := range ml.Listeners {
-		if lis.port == finalPort {
+		if lis.port == finalPort && lis.udpProxy == nil {
 			lis.httpsFilterChains = append(lis.httpsFilterChains, mfc)
 			return
 		}
@@ -205,6 +209,7 @@ func (ml *MergedListeners) AppendTcpListener(
 	parent := tcpFilterChainParent{
 		gatewayListenerName: query.GenerateRouteKey(listener.Parent, string(listener.Name)),
 		routesWithHosts:     routeInfos,
+		attachedPolicies:    listener.AttachedPolicies,
 	}
 
 	fc := tcpFilterChain{
@@ -214,7 +219,7 @@ func (ml *MergedListeners) AppendTcpListener(
 	finalPort := getListenerPortNumber(listener)
 
 	for _, lis := range ml.Listeners {
-		if lis.port == finalPort {
+		if lis.port == finalPort && lis.udpProxy == nil {
 			lis.TcpFilterChains = append(lis.TcpFilterChains, fc)
 			return
 		}
@@ -241,6 +246,7 @@ func (ml *MergedListeners) AppendTlsListener(
 	parent := tcpFilterChainParent{
 		gatewayListenerName: query.GenerateRouteKey(listener.Parent, string(listener.Name)),
 		routesWithHosts:     routeInfos,
+		attachedPolicies:    listener.AttachedPolicies,
 	}
 
 	fc := tcpFilterChain{
@@ -253,7 +259,7 @@ func (ml *MergedListeners) AppendTlsListener(
 	finalPort := getListenerPortNumber(listener)
 
 	for _, lis := range ml.Listeners {
-		if lis.port == finalPort {
+		if lis.port == finalPort && lis.udpProxy == nil {
 			lis.TcpFilterChains = append(lis.TcpFilterChains, fc)
 			return
 		}
@@ -271,6 +277,25 @@ func (ml *MergedListeners) AppendTlsListener(
 	})
 }
 
+// AppendUdpListener adds a UDP listener. UDP listeners bind their own socket, so they are never
+// merged with the TCP based listeners sharing their port.
+func (ml *MergedListeners) AppendUdpListener(
+	listener ir.Listener,
+	routeInfos []*query.RouteInfo,
+	reporter reports.ListenerReporter,
+) {
+	ml.Listeners = append(ml.Listeners, &MergedListener{
+		name:             GenerateListenerName(listener) + "~udp",
+		gatewayNamespace: ml.GatewayNamespace,
+		port:             getListenerPortNumber(listener),
+		udpProxy:         &udpProxy{routes: routeInfos},
+		listenerReporter: reporter,
+		listener:         listener,
+		gateway:          ml.parentGw,
+		settings:         ml.settings,
+	})
+}
+
 func (ml *MergedListeners) translateListeners(
 	kctx krt.HandlerContext,
 	ctx context.Context,
@@ -280,6 +305,10 @@ func (ml *MergedListeners) translateListeners(
 	var listeners []ir.ListenerIR
 	for _, mergedListener := range ml.Listeners {
 		listener := mergedListener.TranslateListener(kctx, ctx, queries, reporter)
+		if mergedListener.udpProxy != nil && listener.Udp == nil {
+			// a UDP listener without an accepted route has nothing to proxy to
+			continue
+		}
 
 		// run listener plugins
 		//		panic("TODO: handle listener policy attachment")
@@ -305,6 +334,7 @@ type MergedListener struct {
 	httpFilterChain   *httpFilterChain
 	httpsFilterChains []httpsFilterChain
 	TcpFilterChains   []tcpFilterChain
+	udpProxy          *udpProxy
 	listenerReporter  reports.ListenerReporter
 	listener          ir.Listener
 	gateway           ir.Gateway
@@ -386,6 +416,11 @@ func (ml *MergedListener) TranslateListener(
 		}
 	}
 
+	var udp *ir.UdpIR
+	if ml.udpProxy != nil {
+		udp = ml.udpProxy.translateUdpProxy(ml.name, reporter)
+	}
+
 	// Get bind address based on ListenerBindIpv6 setting
 	bindAddress := "0.0.0.0"
 	if ml.settings.ListenerBindIpv6 {
@@ -394,14 +429,101 @@ func (ml *MergedListener) TranslateListener(
 
 	// Create and return the listener with all filter chains and TCP listeners
 	return ir.ListenerIR{
-		Name:              ml.name,
-		BindAddress:       bindAddress,
-		BindPort:          uint32(ml.port),
-		AttachedPolicies:  ir.AttachedPolicies{}, // TODO: find policies attached to listener and attach them <- this might not be possilbe due to listener merging. also a gw listener ~= envoy filter chain; and i don't believe we need policies there
-		HttpFilterChain:   httpFilterChains,
-		TcpFilterChain:    matchedTcpListeners,
-		PolicyAncestorRef: ml.listener.PolicyAncestorRef,
+		Name:                      ml.name,
+		BindAddress:               bindAddress,
+		BindPort:                  uint32(ml.port),
+		AttachedPolicies:          ml.attachedPolicies(),
+		PartiallyAttachedPolicies: ml.partiallyAttachedPolicies(),
+		HttpFilterChain:           httpFilterChains,
+		TcpFilterChain:            matchedTcpListeners,
+		Udp:                       udp,
+		PolicyAncestorRef:         ml.listener.PolicyAncestorRef,
+	}
+}
+
+// attachedPolicies returns the policies attached to the gateway listeners merged into the listener.
+// They apply to the whole envoy listener, e.g. its listener filters.
+func (ml *MergedListener) attachedPolicies() ir.AttachedPolicies {
+	return mergeAttachedPolicies(ml.gatewayListenerPolicies()...)
+}
+
+// gatewayListenerPolicies returns the policies attached to each gateway listener merged into the listener.
+func (ml *MergedListener) gatewayListenerPolicies() []ir.AttachedPolicies {
+	var policies []ir.AttachedPolicies
+	if ml.httpFilterChain != nil {
+		for _, parent := range ml.httpFilterChain.parents {
+			policies = append(policies, parent.attachedPolicies)
+		}
+	}
+	for _, fc := range ml.httpsFilterChains {
+		policies = append(policies, fc.attachedPolicies)
 	}
+	for _, fc := range ml.TcpFilterChains {
+		policies = append(policies, fc.parents.attachedPolicies)
+	}
+	return policies
+}
+
+// partiallyAttachedPolicies returns the IDs of the policies that are not attached to every gateway
+// listener merged into the listener.
+func (ml *MergedListener) partiallyAttachedPolicies() map[string]bool {
+	policies := ml.gatewayListenerPolicies()
+	counts := map[string]int{}
+	for _, p := range policies {
+		seen := map[string]bool{}
+		for _, atts := range p.Policies {
+			for _, att := range atts {
+				if att.PolicyRef == nil || seen[att.PolicyRef.ID()] {
+					continue
+				}
+				seen[att.PolicyRef.ID()] = true
+				counts[att.PolicyRef.ID()]++
+			}
+		}
+	}
+	var partial map[string]bool
+	for id, count := range counts {
+		if count < len(policies) {
+			if partial == nil {
+				partial = map[string]bool{}
+			}
+			partial[id] = true
+		}
+	}
+	return partial
+}
+
+// mergeAttachedPolicies merges the policies attached to several gateway listeners, keeping a
+// single attachment of the policies that target several of them. The policies of each GroupKind
+// are ordered by creation time, as when they are attached to a single target.
+func mergeAttachedPolicies(policies ...ir.AttachedPolicies) ir.AttachedPolicies {
+	var out ir.AttachedPolicies
+	seen := map[ir.AttachedPolicyRef]bool{}
+	for _, p := range policies {
+		for gk, atts := range p.Policies {
+			for _, att := range atts {
+				if att.PolicyRef != nil {
+					// the section name differs for each listener the policy targets
+					key := *att.PolicyRef
+					key.SectionName = ""
+					if seen[key] {
+						continue
+					}
+					seen[key] = true
+				}
+				if out.Policies == nil {
+					out.Policies = make(map[schema.GroupKind][]ir.PolicyAtt)
+				}
+				out.Policies[gk] = append(out.Policies[gk], att)
+			}
+		}
+	}
+	for _, atts := range out.Policies {
+		slices.SortStableFunc(atts, func(a, b ir.PolicyAtt) int {
+			return a.PolicyIr.CreationTime().Compare(b.PolicyIr.CreationTime())
+		})
+	}
+	return out
 }
 
 // tcpFilterChain each one represents a Gateway listener that has been merged into a single Gloo Listener
@@ -416,6 +538,7 @@ type tcpFilterChain struct {
 type tcpFilterChainParent struct {
 	gatewayListenerName string
 	routesWithHosts     []*query.RouteInfo
+	attachedPolicies    ir.AttachedPolicies
 }
 
 func (tc *tcpFilterChain) translateTcpFilterChain(listener ir.Listener, parentName string, reporter reports.Reporter) *ir.TcpIR {
@@ -496,7 +619,8 @@ func (tc *tcpFilterChain) translateTcpFilterChain(listener ir.Listener, parentNa
 			FilterChainCommon: ir.FilterChainCommon{
 				FilterChainName: tcpHostName,
 			},
-			BackendRefs: backends,
+			BackendRefs:             backends,
+			AttachedNetworkPolicies: parent.attachedPolicies,
 		}
 	case *ir.TlsRouteIR:
 		tRoute := r.Object.(*ir.TlsRouteIR)
@@ -562,19 +686,112 @@ func (tc *tcpFilterChain) translateTcpFilterChain(listener ir.Listener, parentNa
 				FilterChainName: tcpHostName,
 				Matcher:         matcher,
 			},
-			BackendRefs: backends,
+			BackendRefs:             backends,
+			AttachedNetworkPolicies: parent.attachedPolicies,
 		}
 	default:
 		return nil
 	}
 }
 
+// udpProxy represents the Gateway listener of a UDP listener. It is never merged with other
+// Gateway listeners, as envoy can only run a single udp_proxy filter per listener.
+type udpProxy struct {
+	routes []*query.RouteInfo
+}
+
+func (u *udpProxy) translateUdpProxy(parentName string, reporter reports.Reporter) *ir.UdpIR {
+	routes := make([]*query.RouteInfo, 0, len(u.routes))
+	for _, r := range u.routes {
+		uRoute, ok := r.Object.(*ir.UdpRouteIR)
+		if !ok {
+			continue
+		}
+		if rules := len(uRoute.SourceObject.Spec.Rules); rules != 1 {
+			condition := reports.RouteCondition{
+				Type:    gwv1.RouteConditionAccepted,
+				Status:  metav1.ConditionFalse,
+				Reason:  gwv1.RouteReasonUnsupportedValue,
+				Message: fmt.Sprintf("UDPRoute must have exactly one rule, but it has %d", rules),
+			}
+			for _, parentRef := range uRoute.ParentRefs {
+				reporter.Route(uRoute.SourceObject).ParentRef(&parentRef).SetCondition(condition)
+			}
+			continue
+		}
+		routes = append(routes, r)
+	}
+	if len(routes) == 0 {
+		return nil
+	}
+
+	// Only one route per listener is supported, the oldest one wins
+	slices.SortStableFunc(routes, func(a, b *query.RouteInfo) int {
+		ta := a.Object.GetSourceObject().GetCreationTimestamp()
+		tb := b.Object.GetSourceObject().GetCreationTimestamp()
+		if c := ta.Compare(tb.Time); c != 0 {
+			return c
+		}
+		return strings.Compare(
+			a.Object.GetSourceObject().GetNamespace()+"/"+a.Object.GetSourceObject().GetName(),
+			b.Object.GetSourceObject().GetNamespace()+"/"+b.Object.GetSourceObject().GetName(),
+		)
+	})
+	uRoute := routes[0].Object.(*ir.UdpRouteIR)
+	for _, r := range routes[1:] {
+		reporter.Route(r.Object.GetSourceObject()).ParentRef(&r.ParentRef).SetCondition(reports.RouteCondition{
+			Type:   gwv1.RouteConditionAccepted,
+			Status: metav1.ConditionFalse,
+			Reason: gwv1.RouteReasonUnsupportedValue,
+			Message: fmt.Sprintf("UDPRoute %s/%s was attached to the listener first; only one UDPRoute per listener is supported",
+				uRoute.Namespace, uRoute.Name),
+		})
+	}
+
+	parentRefReporter := reporter.Route(uRoute.SourceObject).ParentRef(&routes[0].ParentRef)
+	parentRefReporter.SetCondition(reports.RouteCondition{
+		Type:   gwv1.RouteConditionAccepted,
+		Status: metav1.ConditionTrue,
+		Reason: gwv1.RouteReasonAccepted,
+	})
+
+	var backends []ir.BackendRefIR
+	for _, backend := range uRoute.Backends {
+		if backend.Err != nil || backend.BackendObject == nil {
+			err := backend.Err
+			if err == nil {
+				err = errors.New("not found")
+			}
+			query.ProcessBackendError(err, parentRefReporter)
+		}
+		// keep backends with errors so that their share of the sessions fails, as with tcp routes
+		backends = append(backends, backend)
+	}
+	if len(backends) == 0 {
+		return nil
+	}
+
+	return &ir.UdpIR{
+		Name:        fmt.Sprintf("%s-%s.%s-rule-%d", parentName, uRoute.Namespace, uRoute.Name, 0),
+		BackendRefs: backends,
+	}
+}
+
 // httpFilterChain each one represents a GW Listener that has been merged into a single Gloo Listener (with distinct filter chains).
 // In the case where no GW Listener merging takes place, every listener will use a Gloo AggregatedListeener with 1 HTTP filter chain.
 type httpFilterChain struct {
 	parents []httpFilterChainParent
 }
 
+// attachedPolicies returns the policies attached to the gateway listeners sharing the filter chain.
+func (httpFilterChain *httpFilterChain) attachedPolicies() ir.AttachedPolicies {
+	policies := make([]ir.AttachedPolicies, 0, len(httpFilterChain.parents))
+	for _, parent := range httpFilterChain.parents {
+		policies = append(policies, parent.attachedPolicies)
+	}
+	return mergeAttachedPolicies(policies...)
+}
+
 func isHostContained(host string, maybeLhost *gwv1.Hostname) bool {
 	if maybeLhost == nil {
 		return true
@@ -665,7 +882,10 @@ func (httpFilterChain *httpFilterChain) translateHttpFilterChain(
 		// This is a problem for example if section name on HttpListener policy.
 		// it won't attach in that case..
 		// i'm pretty sure this is what we want, as we can't attach HCM policies to only some of the vhosts.
-		Vhosts: virtualHosts,
+		// Network policies apply to the connections of the filter chain, so the ones of all the listeners
+		// sharing it are applied.
+		AttachedNetworkPolicies: httpFilterChain.attachedPolicies(),
+		Vhosts:                  virtualHosts,
 	}
 }
 
@@ -720,6 +940,20 @@ func (httpsFilterChain *httpsFilterChain) translateHttpsFilterChain(
 		matcher.SniDomains = []string{string(*httpsFilterChain.sniDomain)}
 	}
 
+	// unsupported options are ignored, the listener is programmed with the supported ones
+	var unsupported []string
+	if httpsFilterChain.tls != nil {
+		unsupported = unsupportedTlsOptionKeys(httpsFilterChain.tls.Options)
+	}
+	if len(unsupported) > 0 {
+		listenerReporter.SetCondition(reports.ListenerCondition{
+			Type:    gwv1.ListenerConditionAccepted,
+			Status:  metav1.ConditionTrue,
+			Reason:  gwv1.ListenerReasonAccepted,
+			Message: "Ignored unsupported TLS options: " + strings.Join(unsupported, ", "),
+		})
+	}
+
 	sslConfig, err := translateSslConfig(
 		kctx,
 		ctx,
@@ -728,20 +962,25 @@ func (httpsFilterChain *httpsFilterChain) translateHttpsFilterChain(
 		queries,
 	)
 	if err != nil {
-		reason := gwv1.ListenerReasonRefNotPermitted
-		if !errors.Is(err, krtcollections.ErrMissingReferenceGrant) {
-			reason = gwv1.ListenerReasonInvalidCertificateRef
+		// invalid tls options don't affect the resolution of the refs of the listener
+		if !errors.Is(err, errInvalidTlsOption) {
+			reason := gwv1.ListenerReasonRefNotPermitted
+			if !errors.Is(err, krtcollections.ErrMissingReferenceGrant) {
+				reason = gwv1.ListenerReasonInvalidCertificateRef
+			}
+			listenerReporter.SetCondition(reports.ListenerCondition{
+				Type:    gwv1.ListenerConditionResolvedRefs,
+				Status:  metav1.ConditionFalse,
+				Reason:  reason,
+				Message: err.Error(),
+			})
 		}
-		listenerReporter.SetCondition(reports.ListenerCondition{
-			Type:   gwv1.ListenerConditionResolvedRefs,
-			Status: metav1.ConditionFalse,
-			Reason: reason,
-		})
 		// listener with no ssl is invalid. We return nil so set programmed to false
 		listenerReporter.SetCondition(reports.ListenerCondition{
-			Type:   gwv1.ListenerConditionProgrammed,
-			Status: metav1.ConditionFalse,
-			Reason: gwv1.ListenerReasonInvalid,
+			Type:    gwv1.ListenerConditionProgrammed,
+			Status:  metav1.ConditionFalse,
+			Reason:  gwv1.ListenerReasonInvalid,
+			Message: err.Error(),
 		})
 		return nil
 	}
@@ -754,8 +993,9 @@ func (httpsFilterChain *httpsFilterChain) translateHttpsFilterChain(
 			Matcher:         matcher,
 			TLS:             sslConfig,
 		},
-		AttachedPolicies: httpsFilterChain.attachedPolicies,
-		Vhosts:           virtualHosts,
+		AttachedPolicies:        httpsFilterChain.attachedPolicies,
+		AttachedNetworkPolicies: httpsFilterChain.attachedPolicies,
+		Vhosts:                  virtualHosts,
 	}
 }
 
@@ -808,14 +1048,10 @@ func translateSslConfig(
 		return nil, nil
 	}
 
+	bundle := &ir.TlsBundle{}
 	for _, certRef := range tls.CertificateRefs {
 		// validate via query
-		secret, err := queries.GetSecretForRef(kctx, ctx, schema.GroupKind{
-			Group: gwv1.GroupName,
-			Kind:  "Gateway",
-		},
-			parentNamespace,
-			certRef)
+		secret, err := queries.GetSecretForRef(kctx, ctx, gatewayGK, parentNamespace, certRef)
 		if err != nil {
 			return nil, err
 		}
@@ -825,19 +1061,29 @@ func translateSslConfig(
 			return nil, err
 		}
 
-		certChain := secret.Data[corev1.TLSCertKey]
-		privateKey := secret.Data[corev1.TLSPrivateKeyKey]
-		rootCa := secret.Data[corev1.ServiceAccountRootCAKey]
-
-		return &ir.TlsBundle{
-			PrivateKey: privateKey,
-			CertChain:  certChain,
-			CA:         rootCa,
-		}, nil
-		// TODO support multiple certs
+		bundle.Certificates = append(bundle.Certificates, ir.TlsCertificate{
+			PrivateKey:    secret.Data[corev1.TLSPrivateKeyKey],
+			CertChain:     secret.Data[corev1.TLSCertKey],
+			SdsSecretName: sdsserver.KubeSecretServerCertName(secret.Namespace, secret.Name),
+		})
+		// the CA of the first certificate is kept
+		if bundle.CA == nil {
+			bundle.CA = secret.Data[corev1.ServiceAccountRootCAKey]
+		}
+	}
+	if len(bundle.Certificates) == 0 {
+		return nil, nil
 	}
 
-	return nil, nil
+	var err error
+	if bundle.Params, err = translateTlsParameters(tls.Options); err != nil {
+		return nil, err
+	}
+	bundle.AlpnProtocols = splitOption(string(tls.Options[annotations.AlpnProtocols]))
+	if bundle.FrontendValidation, err = translateFrontendValidation(kctx, ctx, parentNamespace, tls, queries); err != nil {
+		return nil, err
+	}
+	return bundle, nil
 }
 
 // makeVhostName computes the name of a virtual host based on the parent name and domain.
@@ -0,0 +1,76 @@
+package listener
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/annotations"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+// supportedTlsOptions are the keys of the tls options of listeners. Other keys are ignored and listed
+// in the status of the listener, so that a typo doesn't silently weaken the TLS settings of a listener.
+var supportedTlsOptions = sets.New[gwv1.AnnotationKey](
+	annotations.ClientCertificateMode,
+	annotations.VerifySubjectAltNames,
+	annotations.VerifyCertificateHash,
+	annotations.MinTLSVersion,
+	annotations.MaxTLSVersion,
+	annotations.CipherSuites,
+	annotations.EcdhCurves,
+	annotations.AlpnProtocols,
+)
+
+var tlsVersions = sets.New("1.0", "1.1", "1.2", "1.3")
+
+// unsupportedTlsOptionKeys returns the sorted keys of the options that are not supported.
+func unsupportedTlsOptionKeys(options map[gwv1.AnnotationKey]gwv1.AnnotationValue) []string {
+	var unsupported []string
+	for k := range options {
+		if !supportedTlsOptions.Has(k) {
+			unsupported = append(unsupported, string(k))
+		}
+	}
+	slices.Sort(unsupported)
+	return unsupported
+}
+
+// translateTlsParameters parses the options setting the TLS protocol parameters of a listener.
+// It returns nil when none of them is set.
+func translateTlsParameters(options map[gwv1.AnnotationKey]gwv1.AnnotationValue) (*ir.TlsParameters, error) {
+	params := &ir.TlsParameters{
+		MinVersion:   strings.TrimSpace(string(options[annotations.MinTLSVersion])),
+		MaxVersion:   strings.TrimSpace(string(options[annotations.MaxTLSVersion])),
+		CipherSuites: splitOption(string(options[annotations.CipherSuites])),
+		EcdhCurves:   splitOption(string(options[annotations.EcdhCurves])),
+	}
+	for key, version := range map[string]string{annotations.MinTLSVersion: params.MinVersion, annotations.MaxTLSVersion: params.MaxVersion} {
+		if version != "" && !tlsVersions.Has(version) {
+			return nil, fmt.Errorf("%w %s: unknown TLS version %q", errInvalidTlsOption, key, version)
+		}
+	}
+	// the versions are ordered lexicographically
+	if params.MinVersion != "" && params.MaxVersion != "" && params.MinVersion > params.MaxVersion {
+		return nil, fmt.Errorf("%w: %s %s is greater than %s %s", errInvalidTlsOption,
+			annotations.MinTLSVersion, params.MinVersion, annotations.MaxTLSVersion, params.MaxVersion)
+	}
+
+	if params.MinVersion == "" && params.MaxVersion == "" && len(params.CipherSuites) == 0 && len(params.EcdhCurves) == 0 {
+		return nil, nil
+	}
+	return params, nil
+}
+
+func splitOption(value string) []string {
+	var out []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,83 @@
+package listener
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/annotations"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+func TestTranslateTlsParameters(t *testing.T) {
+	tests := []struct {
+		name    string
+		options map[gwv1.AnnotationKey]gwv1.AnnotationValue
+		want    *ir.TlsParameters
+		wantErr bool
+	}{
+		{
+			name: "no parameters",
+			options: map[gwv1.AnnotationKey]gwv1.AnnotationValue{
+				annotations.AlpnProtocols: "h2",
+			},
+		},
+		{
+			name: "all parameters",
+			options: map[gwv1.AnnotationKey]gwv1.AnnotationValue{
+				annotations.MinTLSVersion: "1.2",
+				annotations.MaxTLSVersion: "1.3",
+				annotations.CipherSuites:  "ECDHE-ECDSA-AES256-GCM-SHA384, ECDHE-RSA-AES256-GCM-SHA384",
+				annotations.EcdhCurves:    "X25519,P-256",
+			},
+			want: &ir.TlsParameters{
+				MinVersion:   "1.2",
+				MaxVersion:   "1.3",
+				CipherSuites: []string{"ECDHE-ECDSA-AES256-GCM-SHA384", "ECDHE-RSA-AES256-GCM-SHA384"},
+				EcdhCurves:   []string{"X25519", "P-256"},
+			},
+		},
+		{
+			name: "unknown version",
+			options: map[gwv1.AnnotationKey]gwv1.AnnotationValue{
+				annotations.MinTLSVersion: "TLSv1_2",
+			},
+			wantErr: true,
+		},
+		{
+			name: "min version greater than max version",
+			options: map[gwv1.AnnotationKey]gwv1.AnnotationValue{
+				annotations.MinTLSVersion: "1.3",
+				annotations.MaxTLSVersion: "1.2",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got, err := translateTlsParameters(tt.options)
+			if tt.wantErr {
+				g.Expect(err).To(MatchError(errInvalidTlsOption))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestUnsupportedTlsOptionKeys(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(unsupportedTlsOptionKeys(map[gwv1.AnnotationKey]gwv1.AnnotationValue{
+		annotations.MinTLSVersion:         "1.2",
+		annotations.ClientCertificateMode: annotations.ClientCertificateModeOptional,
+	})).To(BeEmpty())
+
+	g.Expect(unsupportedTlsOptionKeys(map[gwv1.AnnotationKey]gwv1.AnnotationValue{
+		annotations.MinTLSVersion:  "1.2",
+		"kgateway.dev/min-version": "1.2",
+		"example.com/option":       "value",
+	})).To(Equal([]string{"example.com/option", "kgateway.dev/min-version"}))
+}
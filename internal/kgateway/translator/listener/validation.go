@@ -24,6 +24,13 @@ type portProtocol struct {
 	listeners []ir.Listener
 }
 
+// portKey identifies the socket a listener binds to. UDP listeners bind a
+// separate socket, so they may share a port number with TCP based listeners.
+type portKey struct {
+	port gwv1.PortNumber
+	udp  bool
+}
+
 type protocol = string
 type groupName = string
 type routeKind = string
@@ -52,6 +59,11 @@ func getSupportedProtocolsRoutes() map[protocol]map[groupName][]routeKind {
 				wellknown.TLSRouteKind,
 			},
 		},
+		string(gwv1.UDPProtocolType): {
+			gwv1.GroupName: []string{
+				wellknown.UDPRouteKind,
+			},
+		},
 	}
 	return supportedProtocolToKinds
 }
@@ -133,15 +145,16 @@ func validateListeners(gw *ir.Gateway, reporter reports.Reporter) []ir.Listener
 
 	validListeners := validateSupportedRoutes(gw.Listeners, reporter)
 
-	portListeners := map[gwv1.PortNumber]*portProtocol{}
+	portListeners := map[portKey]*portProtocol{}
 	for _, listener := range validListeners {
 		protocol := listener.Protocol
 		if protocol == gwv1.HTTPSProtocolType || protocol == gwv1.TLSProtocolType {
 			protocol = NormalizedHTTPSTLSType
 		}
+		key := portKey{port: listener.Port, udp: protocol == gwv1.UDPProtocolType}
 
 		// TODO: Keep the first listener in case of a conflict
-		if existingListener, ok := portListeners[listener.Port]; ok {
+		if existingListener, ok := portListeners[key]; ok {
 			existingListener.protocol[protocol] = true
 			existingListener.listeners = append(existingListener.listeners, listener)
 			//TODO(Law): handle validation that hostname empty for udp/tcp
@@ -166,7 +179,7 @@ func validateListeners(gw *ir.Gateway, reporter reports.Reporter) []ir.Listener
 				},
 				listeners: []ir.Listener{listener},
 			}
-			portListeners[listener.Port] = &pp
+			portListeners[key] = &pp
 		}
 	}
 
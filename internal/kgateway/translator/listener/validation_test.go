@@ -248,15 +248,15 @@ func TestUnsupportedProtocol(t *testing.T) {
 	g.Expect(validListeners).To(BeEmpty())
 
 	expectedGwStatuses := map[string]gwv1.ListenerStatus{
-		"udp": {
-			Name:           "udp",
+		"sctp": {
+			Name:           "sctp",
 			SupportedKinds: []gwv1.RouteGroupKind{},
 			Conditions: []metav1.Condition{
 				{
 					Type:    string(gwv1.ListenerConditionAccepted),
 					Status:  metav1.ConditionFalse,
 					Reason:  string(gwv1.ListenerReasonUnsupportedProtocol),
-					Message: "Protocol UDP is unsupported.",
+					Message: "Protocol SCTP is unsupported.",
 				},
 			},
 		},
@@ -807,6 +807,61 @@ func TestValidTCPRouteListener(t *testing.T) {
 	assertExpectedListenerStatuses(t, g, report.Gateway(gateway), utils.ToListenerSlice(listenerSet.Spec.Listeners), expectedStatuses)
 }
 
+func TestValidUDPRouteListener(t *testing.T) {
+	gateway := simpleGwUDPRoute()
+	report := reports.NewReportMap()
+	reporter := reports.NewReporter(&report)
+
+	validListeners := validateGateway(gwToIr(gateway, nil, nil), reporter)
+	g := NewWithT(t)
+	g.Expect(validListeners).To(HaveLen(1))
+
+	expectedStatuses := map[string]gwv1.ListenerStatus{
+		"udp": {
+			Name: "udp",
+			SupportedKinds: []gwv1.RouteGroupKind{
+				{
+					Group: GroupNameHelper(),
+					Kind:  "UDPRoute",
+				},
+			},
+		},
+	}
+	assertExpectedListenerStatuses(t, g, report.Gateway(gateway), gateway.Spec.Listeners, expectedStatuses)
+}
+
+func TestUDPAndTCPListenersShareAPort(t *testing.T) {
+	gateway := udpAndTCPSamePortGw()
+	report := reports.NewReportMap()
+	reporter := reports.NewReporter(&report)
+
+	validListeners := validateGateway(gwToIr(gateway, nil, nil), reporter)
+	g := NewWithT(t)
+	g.Expect(validListeners).To(HaveLen(2))
+
+	expectedStatuses := map[string]gwv1.ListenerStatus{
+		"dns-udp": {
+			Name: "dns-udp",
+			SupportedKinds: []gwv1.RouteGroupKind{
+				{
+					Group: GroupNameHelper(),
+					Kind:  "UDPRoute",
+				},
+			},
+		},
+		"dns-tcp": {
+			Name: "dns-tcp",
+			SupportedKinds: []gwv1.RouteGroupKind{
+				{
+					Group: GroupNameHelper(),
+					Kind:  "TCPRoute",
+				},
+			},
+		},
+	}
+	assertExpectedListenerStatuses(t, g, report.Gateway(gateway), gateway.Spec.Listeners, expectedStatuses)
+}
+
 func TestInvalidRouteKindOnTCPListener(t *testing.T) {
 	gateway := simpleGwInvalidTCPRouteKind()
 	listenerSet := simpleLsInvalidTCPRouteKind()
@@ -1040,6 +1095,49 @@ func simpleLsInvalidTCPRouteKind() *gwxv1a1.XListenerSet {
 	}
 }
 
+func simpleGwUDPRoute() *gwv1.Gateway {
+	return &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "udp-gateway",
+		},
+		Spec: gwv1.GatewaySpec{
+			GatewayClassName: "solo",
+			Listeners: []gwv1.Listener{
+				{
+					Name:     "udp",
+					Port:     8053,
+					Protocol: gwv1.UDPProtocolType,
+				},
+			},
+		},
+	}
+}
+
+func udpAndTCPSamePortGw() *gwv1.Gateway {
+	return &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "dns-gateway",
+		},
+		Spec: gwv1.GatewaySpec{
+			GatewayClassName: "solo",
+			Listeners: []gwv1.Listener{
+				{
+					Name:     "dns-udp",
+					Port:     8053,
+					Protocol: gwv1.UDPProtocolType,
+				},
+				{
+					Name:     "dns-tcp",
+					Port:     8053,
+					Protocol: gwv1.TCPProtocolType,
+				},
+			},
+		},
+	}
+}
+
 func tcpProtocolConflictGw() *gwv1.Gateway {
 	return &gwv1.Gateway{
 		ObjectMeta: metav1.ObjectMeta{
@@ -2112,9 +2210,9 @@ func unsupportedProtocolGw() *gwv1.Gateway {
 			GatewayClassName: "solo",
 			Listeners: []gwv1.Listener{
 				{
-					Name:     "udp",
+					Name:     "sctp",
 					Port:     8080,
-					Protocol: gwv1.UDPProtocolType,
+					Protocol: gwv1.ProtocolType("SCTP"),
 				},
 			},
 		},
@@ -3,11 +3,14 @@ package utils
 import (
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_proxy_protocol_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/proxy_protocol/v3"
 	envoy_upstreams_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
 	proto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 )
 
 func MutateHttpOptions(c *envoy_config_cluster_v3.Cluster, m func(*envoy_upstreams_v3.HttpProtocolOptions)) error {
@@ -43,3 +46,91 @@ func SetHttp2options(c *envoy_config_cluster_v3.Cluster) error {
 		}
 	})
 }
+
+// SetTransportSocket sets the transport socket of the cluster. If the cluster already sends the
+// PROXY protocol, the transport socket is wrapped by the PROXY protocol transport socket instead,
+// so that plugins setting the two compose regardless of the order they run in.
+func SetTransportSocket(c *envoy_config_cluster_v3.Cluster, ts *envoy_config_core_v3.TransportSocket) error {
+	if c.GetTransportSocket().GetName() != wellknown.TransportSocketUpstreamProxyProtocol {
+		c.TransportSocket = ts
+		return nil
+	}
+	proxyProtocol := &envoy_proxy_protocol_v3.ProxyProtocolUpstreamTransport{}
+	if err := c.GetTransportSocket().GetTypedConfig().UnmarshalTo(proxyProtocol); err != nil {
+		return err
+	}
+	return SetProxyProtocolTransportSocket(c, proxyProtocol.GetConfig(), ts)
+}
+
+// WrapTransportSocketWithProxyProtocol makes the cluster send the PROXY protocol header before
+// any data of its current transport socket.
+func WrapTransportSocketWithProxyProtocol(c *envoy_config_cluster_v3.Cluster, config *envoy_config_core_v3.ProxyProtocolConfig) error {
+	inner := c.GetTransportSocket()
+	if inner.GetName() == wellknown.TransportSocketUpstreamProxyProtocol {
+		proxyProtocol := &envoy_proxy_protocol_v3.ProxyProtocolUpstreamTransport{}
+		if err := inner.GetTypedConfig().UnmarshalTo(proxyProtocol); err != nil {
+			return err
+		}
+		inner = proxyProtocol.GetTransportSocket()
+	}
+	return SetProxyProtocolTransportSocket(c, config, inner)
+}
+
+// SetProxyProtocolTransportSocket sets the transport socket of the cluster to a PROXY protocol
+// transport socket wrapping the given one. A nil inner transport socket means raw buffer.
+func SetProxyProtocolTransportSocket(
+	c *envoy_config_cluster_v3.Cluster,
+	config *envoy_config_core_v3.ProxyProtocolConfig,
+	inner *envoy_config_core_v3.TransportSocket,
+) error {
+	typedConfig, err := utils.MessageToAny(&envoy_proxy_protocol_v3.ProxyProtocolUpstreamTransport{
+		Config:          config,
+		TransportSocket: inner,
+	})
+	if err != nil {
+		return err
+	}
+	c.TransportSocket = &envoy_config_core_v3.TransportSocket{
+		Name: wellknown.TransportSocketUpstreamProxyProtocol,
+		ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{
+			TypedConfig: typedConfig,
+		},
+	}
+	return nil
+}
+
+// MergeCircuitBreakers merges the thresholds of cb into the circuit breakers of the cluster,
+// matching the thresholds by priority. The fields set in cb replace the existing ones if override
+// is true, otherwise they are only used for the fields the existing thresholds don't set.
+// The circuit breakers are never modified in place, as they may be shared with a policy IR.
+func MergeCircuitBreakers(c *envoy_config_cluster_v3.Cluster, cb *envoy_config_cluster_v3.CircuitBreakers, override bool) {
+	if cb == nil {
+		return
+	}
+	if c.GetCircuitBreakers() == nil {
+		c.CircuitBreakers = cb
+		return
+	}
+	merged := proto.Clone(c.GetCircuitBreakers()).(*envoy_config_cluster_v3.CircuitBreakers)
+	for _, in := range cb.GetThresholds() {
+		var existing *envoy_config_cluster_v3.CircuitBreakers_Thresholds
+		for _, t := range merged.GetThresholds() {
+			if t.GetPriority() == in.GetPriority() {
+				existing = t
+				break
+			}
+		}
+		if existing == nil {
+			merged.Thresholds = append(merged.GetThresholds(), proto.Clone(in).(*envoy_config_cluster_v3.CircuitBreakers_Thresholds))
+			continue
+		}
+		dst := existing.ProtoReflect()
+		in.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			if override || !dst.Has(fd) {
+				dst.Set(fd, v)
+			}
+			return true
+		})
+	}
+	c.CircuitBreakers = merged
+}
@@ -0,0 +1,67 @@
+package utils
+
+import (
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	preserve_case_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/header_formatters/preserve_case/v3"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+)
+
+const PreserveCasePlugin = "envoy.http.stateful_header_formatters.preserve_case"
+
+// ToEnvoyHttp1ProtocolOptions translates HTTP/1 options, used by both upstream clusters and downstream listeners.
+func ToEnvoyHttp1ProtocolOptions(http1ProtocolOptions *v1alpha1.Http1ProtocolOptions) (*corev3.Http1ProtocolOptions, error) {
+	out := &corev3.Http1ProtocolOptions{}
+	if http1ProtocolOptions.EnableTrailers != nil {
+		out.EnableTrailers = *http1ProtocolOptions.EnableTrailers
+	}
+
+	if http1ProtocolOptions.OverrideStreamErrorOnInvalidHttpMessage != nil {
+		out.OverrideStreamErrorOnInvalidHttpMessage = &wrapperspb.BoolValue{Value: *http1ProtocolOptions.OverrideStreamErrorOnInvalidHttpMessage}
+	}
+
+	if http1ProtocolOptions.HeaderFormat != nil {
+		switch *http1ProtocolOptions.HeaderFormat {
+		case v1alpha1.ProperCaseHeaderKeyFormat:
+			out.HeaderKeyFormat = &corev3.Http1ProtocolOptions_HeaderKeyFormat{
+				HeaderFormat: &corev3.Http1ProtocolOptions_HeaderKeyFormat_ProperCaseWords_{
+					ProperCaseWords: &corev3.Http1ProtocolOptions_HeaderKeyFormat_ProperCaseWords{},
+				},
+			}
+		case v1alpha1.PreserveCaseHeaderKeyFormat:
+			typedConfig, err := utils.MessageToAny(&preserve_case_v3.PreserveCaseFormatterConfig{})
+			if err != nil {
+				return nil, err
+			}
+			out.HeaderKeyFormat = &corev3.Http1ProtocolOptions_HeaderKeyFormat{
+				HeaderFormat: &corev3.Http1ProtocolOptions_HeaderKeyFormat_StatefulFormatter{
+					StatefulFormatter: &corev3.TypedExtensionConfig{
+						Name:        PreserveCasePlugin,
+						TypedConfig: typedConfig,
+					},
+				},
+			}
+		}
+	}
+	return out, nil
+}
+
+// ToEnvoyHttp2ProtocolOptions translates HTTP/2 options, used by both upstream clusters and downstream listeners.
+func ToEnvoyHttp2ProtocolOptions(http2ProtocolOptions *v1alpha1.Http2ProtocolOptions) *corev3.Http2ProtocolOptions {
+	out := &corev3.Http2ProtocolOptions{}
+	if http2ProtocolOptions.MaxConcurrentStreams != nil {
+		out.MaxConcurrentStreams = &wrapperspb.UInt32Value{Value: uint32(*http2ProtocolOptions.MaxConcurrentStreams)}
+	}
+	if http2ProtocolOptions.InitialStreamWindowSize != nil {
+		out.InitialStreamWindowSize = &wrapperspb.UInt32Value{Value: uint32(http2ProtocolOptions.InitialStreamWindowSize.Value())}
+	}
+	if http2ProtocolOptions.InitialConnectionWindowSize != nil {
+		out.InitialConnectionWindowSize = &wrapperspb.UInt32Value{Value: uint32(http2ProtocolOptions.InitialConnectionWindowSize.Value())}
+	}
+	if http2ProtocolOptions.OverrideStreamErrorOnInvalidHttpMessage != nil {
+		out.OverrideStreamErrorOnInvalidHttpMessage = &wrapperspb.BoolValue{Value: *http2ProtocolOptions.OverrideStreamErrorOnInvalidHttpMessage}
+	}
+	return out
+}
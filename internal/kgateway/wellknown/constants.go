@@ -1,5 +1,7 @@
 package wellknown
 
+import "strings"
+
 const (
 	// Note: These are coming from istio: https://github.com/istio/istio/blob/fa321ebd2a1186325788b0f461aa9f36a1a8d90e/pilot/pkg/model/service.go#L206
 	// IstioCertSecret is the secret that holds the server cert and key for Istio mTLS
@@ -36,6 +38,24 @@ const (
 	ExtprocFilterName                 = "envoy.filters.http.ext_proc"
 )
 
+const (
+	// CompressorFilterNamePrefix and DecompressorFilterNamePrefix name the compressor and
+	// decompressor filters, which are added once per compression algorithm.
+	CompressorFilterNamePrefix   = "envoy.filters.http.compressor"
+	DecompressorFilterNamePrefix = "envoy.filters.http.decompressor"
+)
+
+// CompressionFilterName returns the name of the compressor or decompressor filter for the algorithm.
+func CompressionFilterName(prefix, algorithm string) string {
+	return prefix + "/" + strings.ToLower(algorithm)
+}
+
+const (
+	// TransportSocketUpstreamProxyProtocol names the transport socket that sends the PROXY protocol
+	// header to the upstream before the data of the transport socket it wraps.
+	TransportSocketUpstreamProxyProtocol = "envoy.transport_sockets.upstream_proxy_protocol"
+)
+
 const (
 	EnvoyConfigNameMaxLen = 253
 )
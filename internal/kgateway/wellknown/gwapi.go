@@ -21,6 +21,7 @@ const (
 	HTTPRouteKind        = "HTTPRoute"
 	TCPRouteKind         = "TCPRoute"
 	TLSRouteKind         = "TLSRoute"
+	UDPRouteKind         = "UDPRoute"
 	GRPCRouteKind        = "GRPCRoute"
 	GatewayKind          = "Gateway"
 	GatewayClassKind     = "GatewayClass"
@@ -8,6 +8,9 @@ import (
 var (
 	ServiceEntryGVK = istionetworking.SchemeGroupVersion.WithKind("ServiceEntry")
 	HostnameGVK     = istionetworking.SchemeGroupVersion.WithKind("Hostname")
+	// SubsetGVK is the virtual kind used to reference a subset of a DestinationRule as a backend,
+	// by the name `<service name>.<subset name>` in the namespace of the service.
+	SubsetGVK = istionetworking.SchemeGroupVersion.WithKind("Subset")
 )
 
 var (
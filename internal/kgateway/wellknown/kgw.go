@@ -24,6 +24,7 @@ var (
 	TrafficPolicyGVK       = buildKgatewayGvk("TrafficPolicy")
 	HTTPListenerPolicyGVK  = buildKgatewayGvk("HTTPListenerPolicy")
 	BackendConfigPolicyGVK = buildKgatewayGvk("BackendConfigPolicy")
+	ListenerPolicyGVK      = buildKgatewayGvk("ListenerPolicy")
 	GatewayParametersGVR   = GatewayParametersGVK.GroupVersion().WithResource("gatewayparameters")
 	GatewayExtensionGVR    = GatewayExtensionGVK.GroupVersion().WithResource("gatewayextensions")
 	DirectResponseGVR      = DirectResponseGVK.GroupVersion().WithResource("directresponses")
@@ -31,4 +32,5 @@ var (
 	TrafficPolicyGVR       = TrafficPolicyGVK.GroupVersion().WithResource("trafficpolicies")
 	HTTPListenerPolicyGVR  = HTTPListenerPolicyGVK.GroupVersion().WithResource("httplistenerpolicies")
 	BackendConfigPolicyGVR = BackendConfigPolicyGVK.GroupVersion().WithResource("backendconfigpolicies")
+	ListenerPolicyGVR      = ListenerPolicyGVK.GroupVersion().WithResource("listenerpolicies")
 )
@@ -2,7 +2,9 @@ package wellknown
 
 import (
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 )
 
@@ -14,6 +16,11 @@ var (
 
 	// RBAC GVKs
 	ClusterRoleBindingGVK = rbacv1.SchemeGroupVersion.WithKind("ClusterRoleBinding")
+	RoleGVK               = rbacv1.SchemeGroupVersion.WithKind("Role")
+	RoleBindingGVK        = rbacv1.SchemeGroupVersion.WithKind("RoleBinding")
 
 	DeploymentGVK = appsv1.SchemeGroupVersion.WithKind("Deployment")
+
+	HorizontalPodAutoscalerGVK = autoscalingv2.SchemeGroupVersion.WithKind("HorizontalPodAutoscaler")
+	PodDisruptionBudgetGVK     = policyv1.SchemeGroupVersion.WithKind("PodDisruptionBudget")
 )
@@ -0,0 +1,391 @@
+package xds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	xdsserver "github.com/envoyproxy/go-control-plane/pkg/server/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+)
+
+const (
+	// TokenAudience is the audience of the ServiceAccount tokens that proxies present to the xDS server.
+	TokenAudience = "kgateway-xds"
+
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+
+	serviceAccountUsernamePrefix = "system:serviceaccount:"
+	podNameExtraKey              = "authentication.kubernetes.io/pod-name"
+
+	podIPIndex = "podIP"
+)
+
+// ProxyIdentity is the authenticated identity of a proxy connected to the xDS server.
+type ProxyIdentity struct {
+	Namespace      string
+	ServiceAccount string
+	// PodName is only known for tokens bound to a pod and for proxies authenticated by address.
+	PodName string
+	// Gateway is the Gateway that owns the ServiceAccount of the proxy.
+	Gateway types.NamespacedName
+}
+
+// Authenticator authenticates the proxy calling the xDS server and returns its identity.
+type Authenticator interface {
+	Authenticate(ctx context.Context) (*ProxyIdentity, error)
+}
+
+type tokenReviewAuthenticator struct {
+	client          kubernetes.Interface
+	serviceAccounts corev1listers.ServiceAccountLister
+}
+
+// NewTokenReviewAuthenticator returns an Authenticator that validates the ServiceAccount token in the
+// authorization header of the call with the Kubernetes TokenReview API. ServiceAccounts are read
+// from the informer factory, which must be started by the caller.
+func NewTokenReviewAuthenticator(client kubernetes.Interface, factory informers.SharedInformerFactory) Authenticator {
+	return &tokenReviewAuthenticator{
+		client:          client,
+		serviceAccounts: factory.Core().V1().ServiceAccounts().Lister(),
+	}
+}
+
+func (a *tokenReviewAuthenticator) Authenticate(ctx context.Context) (*ProxyIdentity, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(authorizationHeader)
+	if len(values) != 1 || !strings.HasPrefix(values[0], bearerPrefix) {
+		return nil, errors.New("missing bearer token")
+	}
+	review, err := a.client.AuthenticationV1().TokenReviews().Create(ctx, &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{
+			Token:     strings.TrimPrefix(values[0], bearerPrefix),
+			Audiences: []string{TokenAudience},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to review token: %w", err)
+	}
+	if !review.Status.Authenticated {
+		return nil, fmt.Errorf("token not authenticated: %s", review.Status.Error)
+	}
+	if !slices.Contains(review.Status.Audiences, TokenAudience) {
+		return nil, fmt.Errorf("token is not valid for audience %s", TokenAudience)
+	}
+
+	user := review.Status.User
+	ns, sa, ok := strings.Cut(strings.TrimPrefix(user.Username, serviceAccountUsernamePrefix), ":")
+	if !strings.HasPrefix(user.Username, serviceAccountUsernamePrefix) || !ok || ns == "" || sa == "" {
+		return nil, fmt.Errorf("%s is not a service account", user.Username)
+	}
+	id := &ProxyIdentity{
+		Namespace:      ns,
+		ServiceAccount: sa,
+	}
+	if podName := user.Extra[podNameExtraKey]; len(podName) == 1 {
+		id.PodName = podName[0]
+	}
+	if id.Gateway, err = gatewayForServiceAccount(a.serviceAccounts, ns, sa); err != nil {
+		return nil, err
+	}
+	return id, nil
+}
+
+type peerAddressAuthenticator struct {
+	pods            cache.Indexer
+	serviceAccounts corev1listers.ServiceAccountLister
+}
+
+// NewPeerAddressAuthenticator returns an Authenticator that identifies proxies by the pod running
+// with the address of the caller. It is only meant for servers that require client certificates,
+// which authenticate the caller but are shared by the proxies of a namespace. Pods and
+// ServiceAccounts are read from the informer factory, which must be started by the caller.
+func NewPeerAddressAuthenticator(factory informers.SharedInformerFactory) (Authenticator, error) {
+	podInformer := factory.Core().V1().Pods().Informer()
+	if err := podInformer.AddIndexers(cache.Indexers{podIPIndex: podIPs}); err != nil {
+		return nil, fmt.Errorf("failed to index pods by address: %w", err)
+	}
+	return &peerAddressAuthenticator{
+		pods:            podInformer.GetIndexer(),
+		serviceAccounts: factory.Core().V1().ServiceAccounts().Lister(),
+	}, nil
+}
+
+func podIPs(obj any) ([]string, error) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return nil, nil
+	}
+	ips := make([]string, 0, len(pod.Status.PodIPs))
+	for _, ip := range pod.Status.PodIPs {
+		ips = append(ips, ip.IP)
+	}
+	if len(ips) == 0 && pod.Status.PodIP != "" {
+		ips = append(ips, pod.Status.PodIP)
+	}
+	return ips, nil
+}
+
+func (a *peerAddressAuthenticator) Authenticate(ctx context.Context) (*ProxyIdentity, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("unknown peer address")
+	}
+	tcpAddr, ok := p.Addr.(*net.TCPAddr)
+	if !ok {
+		return nil, fmt.Errorf("unsupported peer address %s", p.Addr)
+	}
+	ip := tcpAddr.IP.String()
+	pods, err := a.pods.ByIndex(podIPIndex, ip)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods with address %s: %w", ip, err)
+	}
+	// host network pods share the address of the node
+	var matches []*corev1.Pod
+	for _, obj := range pods {
+		pod, ok := obj.(*corev1.Pod)
+		if ok && !pod.Spec.HostNetwork && pod.Status.Phase == corev1.PodRunning {
+			matches = append(matches, pod)
+		}
+	}
+	if len(matches) != 1 {
+		return nil, fmt.Errorf("found %d running pods with address %s", len(matches), ip)
+	}
+	pod := matches[0]
+	id := &ProxyIdentity{
+		Namespace:      pod.Namespace,
+		ServiceAccount: pod.Spec.ServiceAccountName,
+		PodName:        pod.Name,
+	}
+	if id.Gateway, err = gatewayForServiceAccount(a.serviceAccounts, pod.Namespace, pod.Spec.ServiceAccountName); err != nil {
+		return nil, err
+	}
+	return id, nil
+}
+
+// gatewayForServiceAccount returns the Gateway that owns a ServiceAccount. The deployer sets the
+// Gateway as the owner of the ServiceAccount of its proxies, whose name may be truncated.
+func gatewayForServiceAccount(serviceAccounts corev1listers.ServiceAccountLister, namespace, name string) (types.NamespacedName, error) {
+	sa, err := serviceAccounts.ServiceAccounts(namespace).Get(name)
+	if err != nil {
+		return types.NamespacedName{}, fmt.Errorf("failed to get service account %s/%s: %w", namespace, name, err)
+	}
+	for _, ref := range sa.GetOwnerReferences() {
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err == nil && gv.Group == gwv1.GroupName && ref.Kind == wellknown.GatewayKind {
+			return types.NamespacedName{Namespace: namespace, Name: ref.Name}, nil
+		}
+	}
+	return types.NamespacedName{}, fmt.Errorf("service account %s/%s is not owned by a Gateway", namespace, name)
+}
+
+type proxyIdentityKey struct{}
+
+// ProxyIdentityFromContext returns the identity authenticated for the gRPC call, if any.
+func ProxyIdentityFromContext(ctx context.Context) *ProxyIdentity {
+	id, _ := ctx.Value(proxyIdentityKey{}).(*ProxyIdentity)
+	return id
+}
+
+func authenticate(ctx context.Context, authn Authenticator) (context.Context, error) {
+	id, err := authn.Authenticate(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return context.WithValue(ctx, proxyIdentityKey{}, id), nil
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// StreamAuthInterceptor rejects xDS streams that don't carry a valid bearer token, and makes the
+// authenticated identity available to the xDS callbacks through the stream context.
+func StreamAuthInterceptor(authn Authenticator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), authn)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// UnaryAuthInterceptor is the equivalent of StreamAuthInterceptor for xDS fetch requests.
+func UnaryAuthInterceptor(authn Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticate(ctx, authn)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// authStreamKey identifies an xDS stream. State-of-the-world and delta streams are numbered
+// independently by the xDS server, so the ids alone are not unique.
+type authStreamKey struct {
+	id    int64
+	delta bool
+}
+
+type authStream struct {
+	identity   *ProxyIdentity
+	authorized bool
+}
+
+// authCallbacks only lets a proxy request the config of the Gateway its identity belongs to.
+type authCallbacks struct {
+	xdsserver.Callbacks
+
+	lock    sync.Mutex
+	streams map[authStreamKey]*authStream
+}
+
+// NewAuthCallbacks wraps the xDS server callbacks to authorize the requests of proxies
+// authenticated by StreamAuthInterceptor or UnaryAuthInterceptor. It must wrap every other
+// callback, as those may rewrite the role in the node metadata.
+func NewAuthCallbacks(callbacks xdsserver.Callbacks) xdsserver.Callbacks {
+	if callbacks == nil {
+		callbacks = xdsserver.CallbackFuncs{}
+	}
+	return &authCallbacks{
+		Callbacks: callbacks,
+		streams:   make(map[authStreamKey]*authStream),
+	}
+}
+
+func (a *authCallbacks) OnStreamOpen(ctx context.Context, sid int64, typeURL string) error {
+	if err := a.openStream(ctx, authStreamKey{id: sid}); err != nil {
+		return err
+	}
+	return a.Callbacks.OnStreamOpen(ctx, sid, typeURL)
+}
+
+func (a *authCallbacks) OnDeltaStreamOpen(ctx context.Context, sid int64, typeURL string) error {
+	if err := a.openStream(ctx, authStreamKey{id: sid, delta: true}); err != nil {
+		return err
+	}
+	return a.Callbacks.OnDeltaStreamOpen(ctx, sid, typeURL)
+}
+
+func (a *authCallbacks) OnStreamClosed(sid int64, node *envoy_config_core_v3.Node) {
+	a.closeStream(authStreamKey{id: sid})
+	a.Callbacks.OnStreamClosed(sid, node)
+}
+
+func (a *authCallbacks) OnDeltaStreamClosed(sid int64, node *envoy_config_core_v3.Node) {
+	a.closeStream(authStreamKey{id: sid, delta: true})
+	a.Callbacks.OnDeltaStreamClosed(sid, node)
+}
+
+func (a *authCallbacks) OnStreamRequest(sid int64, req *envoy_service_discovery_v3.DiscoveryRequest) error {
+	if err := a.authorizeStream(authStreamKey{id: sid}, req.GetNode()); err != nil {
+		return err
+	}
+	return a.Callbacks.OnStreamRequest(sid, req)
+}
+
+func (a *authCallbacks) OnStreamDeltaRequest(sid int64, req *envoy_service_discovery_v3.DeltaDiscoveryRequest) error {
+	if err := a.authorizeStream(authStreamKey{id: sid, delta: true}, req.GetNode()); err != nil {
+		return err
+	}
+	return a.Callbacks.OnStreamDeltaRequest(sid, req)
+}
+
+func (a *authCallbacks) OnFetchRequest(ctx context.Context, req *envoy_service_discovery_v3.DiscoveryRequest) error {
+	id := ProxyIdentityFromContext(ctx)
+	if id == nil {
+		return status.Error(codes.Unauthenticated, "unauthenticated xDS client")
+	}
+	if err := authorizeNode(id, req.GetNode()); err != nil {
+		return err
+	}
+	return a.Callbacks.OnFetchRequest(ctx, req)
+}
+
+func (a *authCallbacks) openStream(ctx context.Context, key authStreamKey) error {
+	id := ProxyIdentityFromContext(ctx)
+	if id == nil {
+		return status.Error(codes.Unauthenticated, "unauthenticated xDS client")
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.streams[key] = &authStream{identity: id}
+	return nil
+}
+
+func (a *authCallbacks) closeStream(key authStreamKey) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	delete(a.streams, key)
+}
+
+func (a *authCallbacks) authorizeStream(key authStreamKey, node *envoy_config_core_v3.Node) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	stream, ok := a.streams[key]
+	if !ok {
+		return status.Error(codes.Unauthenticated, "unauthenticated xDS client")
+	}
+	// delta requests only carry the node on the first request of the stream
+	if node == nil {
+		if !stream.authorized {
+			return status.Error(codes.PermissionDenied, "missing node in first xDS request")
+		}
+		return nil
+	}
+	if err := authorizeNode(stream.identity, node); err != nil {
+		return err
+	}
+	stream.authorized = true
+	return nil
+}
+
+// authorizeNode checks that the role in the node metadata refers to the Gateway that owns the
+// ServiceAccount of the authenticated proxy.
+func authorizeNode(id *ProxyIdentity, node *envoy_config_core_v3.Node) error {
+	role := node.GetMetadata().GetFields()[RoleKey].GetStringValue()
+	// the role may have been extended with the unique client segments already
+	segments := strings.Split(role, KeyDelimiter)
+	if len(segments) < 3 || segments[0] != wellknown.GatewayApiProxyValue {
+		return status.Errorf(codes.PermissionDenied, "invalid role %q", role)
+	}
+	if segments[1] != id.Gateway.Namespace || segments[2] != id.Gateway.Name {
+		return status.Errorf(codes.PermissionDenied, "proxy of Gateway %s may not request config for role %q",
+			id.Gateway, role)
+	}
+	if id.PodName != "" && node.GetId() != id.PodName+"."+id.Namespace {
+		return status.Errorf(codes.PermissionDenied, "node id %q does not match pod %s/%s",
+			node.GetId(), id.Namespace, id.PodName)
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+package xds_test
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	xdsserver "github.com/envoyproxy/go-control-plane/pkg/server/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	. "github.com/kgateway-dev/kgateway/v2/internal/kgateway/xds"
+)
+
+// longGatewayName is longer than the 63 characters the deployer truncates the ServiceAccount name to.
+const longGatewayName = "a-gateway-with-a-name-that-is-longer-than-sixty-three-characters-long"
+
+// gatewayServiceAccount returns the ServiceAccount the deployer creates for the proxies of a Gateway.
+func gatewayServiceAccount(namespace, gateway string) *corev1.ServiceAccount {
+	name := gateway
+	if len(name) > 63 {
+		name = strings.TrimSuffix(name[:63], "-")
+	}
+	return &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: gwv1.GroupVersion.String(),
+				Kind:       "Gateway",
+				Name:       gateway,
+				Controller: ptr.To(true),
+			}},
+		},
+	}
+}
+
+func fakeTokenReviews(t *testing.T, tokens map[string]authenticationv1.UserInfo, objs ...runtime.Object) *fake.Clientset {
+	client := fake.NewClientset(objs...)
+	client.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		assert.Equal(t, []string{TokenAudience}, review.Spec.Audiences)
+		user, ok := tokens[review.Spec.Token]
+		review.Status = authenticationv1.TokenReviewStatus{
+			Authenticated: ok,
+			User:          user,
+			Audiences:     review.Spec.Audiences,
+		}
+		return true, review, nil
+	})
+	return client
+}
+
+// startInformers starts the informers of the factory once the authenticators registered theirs.
+func startInformers(t *testing.T, factory informers.SharedInformerFactory) {
+	stop := make(chan struct{})
+	t.Cleanup(func() { close(stop) })
+	factory.Start(stop)
+	factory.WaitForCacheSync(stop)
+}
+
+func withToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestTokenReviewAuthenticator(t *testing.T) {
+	longSA := gatewayServiceAccount("default", longGatewayName)
+	client := fakeTokenReviews(t, map[string]authenticationv1.UserInfo{
+		"gw-token": {
+			Username: "system:serviceaccount:default:gw",
+			Extra: map[string]authenticationv1.ExtraValue{
+				"authentication.kubernetes.io/pod-name": {"gw-abc"},
+			},
+		},
+		"long-gw-token": {Username: "system:serviceaccount:default:" + longSA.Name},
+		"orphan-token":  {Username: "system:serviceaccount:default:orphan"},
+		"user-token":    {Username: "alice"},
+	},
+		gatewayServiceAccount("default", "gw"),
+		longSA,
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "orphan"}},
+	)
+	factory := informers.NewSharedInformerFactory(client, 0)
+	authn := NewTokenReviewAuthenticator(client, factory)
+	startInformers(t, factory)
+
+	id, err := authn.Authenticate(withToken("gw-token"))
+	require.NoError(t, err)
+	assert.Equal(t, &ProxyIdentity{
+		Namespace:      "default",
+		ServiceAccount: "gw",
+		PodName:        "gw-abc",
+		Gateway:        types.NamespacedName{Namespace: "default", Name: "gw"},
+	}, id)
+
+	id, err = authn.Authenticate(withToken("long-gw-token"))
+	require.NoError(t, err)
+	assert.Equal(t, types.NamespacedName{Namespace: "default", Name: longGatewayName}, id.Gateway)
+
+	_, err = authn.Authenticate(withToken("orphan-token"))
+	assert.ErrorContains(t, err, "not owned by a Gateway")
+
+	_, err = authn.Authenticate(withToken("user-token"))
+	assert.ErrorContains(t, err, "not a service account")
+
+	_, err = authn.Authenticate(withToken("unknown"))
+	assert.ErrorContains(t, err, "not authenticated")
+
+	_, err = authn.Authenticate(context.Background())
+	assert.ErrorContains(t, err, "missing bearer token")
+}
+
+func TestPeerAddressAuthenticator(t *testing.T) {
+	pod := func(name, ip string, hostNetwork bool) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec: corev1.PodSpec{
+				ServiceAccountName: "gw",
+				HostNetwork:        hostNetwork,
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning, PodIP: ip},
+		}
+	}
+	client := fake.NewClientset(
+		gatewayServiceAccount("default", "gw"),
+		pod("gw-abc", "10.0.0.1", false),
+		pod("node-agent", "10.0.1.1", true),
+	)
+	factory := informers.NewSharedInformerFactory(client, 0)
+	authn, err := NewPeerAddressAuthenticator(factory)
+	require.NoError(t, err)
+	startInformers(t, factory)
+	fromAddr := func(ip string) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 5000}})
+	}
+
+	id, err := authn.Authenticate(fromAddr("10.0.0.1"))
+	require.NoError(t, err)
+	assert.Equal(t, &ProxyIdentity{
+		Namespace:      "default",
+		ServiceAccount: "gw",
+		PodName:        "gw-abc",
+		Gateway:        types.NamespacedName{Namespace: "default", Name: "gw"},
+	}, id)
+
+	_, err = authn.Authenticate(fromAddr("10.0.1.1"))
+	assert.ErrorContains(t, err, "found 0 running pods")
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func roleNode(id, role string) *envoy_config_core_v3.Node {
+	return &envoy_config_core_v3.Node{
+		Id: id,
+		Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{
+			RoleKey: structpb.NewStringValue(role),
+		}},
+	}
+}
+
+func TestAuthCallbacks(t *testing.T) {
+	client := fakeTokenReviews(t, map[string]authenticationv1.UserInfo{
+		"gw-token": {
+			Username: "system:serviceaccount:default:gw",
+			Extra: map[string]authenticationv1.ExtraValue{
+				"authentication.kubernetes.io/pod-name": {"gw-abc"},
+			},
+		},
+		"long-gw-token": {
+			Username: "system:serviceaccount:default:" + gatewayServiceAccount("default", longGatewayName).Name,
+		},
+	}, gatewayServiceAccount("default", "gw"), gatewayServiceAccount("default", longGatewayName))
+	factory := informers.NewSharedInformerFactory(client, 0)
+	authn := NewTokenReviewAuthenticator(client, factory)
+	startInformers(t, factory)
+	interceptor := StreamAuthInterceptor(authn)
+	callbacks := NewAuthCallbacks(xdsserver.CallbackFuncs{})
+
+	// openStream runs the interceptor and the stream open callback like the xDS server does
+	openStream := func(token string, sid int64, delta bool) error {
+		ctx := context.Background()
+		if token != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+		}
+		return interceptor(nil, &fakeServerStream{ctx: ctx}, &grpc.StreamServerInfo{}, func(_ interface{}, ss grpc.ServerStream) error {
+			if delta {
+				return callbacks.OnDeltaStreamOpen(ss.Context(), sid, "")
+			}
+			return callbacks.OnStreamOpen(ss.Context(), sid, "")
+		})
+	}
+
+	t.Run("rejects streams without a valid token", func(t *testing.T) {
+		assert.Equal(t, codes.Unauthenticated, status.Code(openStream("", 1, false)))
+		assert.Equal(t, codes.Unauthenticated, status.Code(openStream("bad-token", 1, false)))
+	})
+
+	t.Run("serves the gateway of the service account", func(t *testing.T) {
+		require.NoError(t, openStream("gw-token", 2, false))
+		req := &envoy_service_discovery_v3.DiscoveryRequest{Node: roleNode("gw-abc.default", "kgateway-kube-gateway-api~default~gw")}
+		assert.NoError(t, callbacks.OnStreamRequest(2, req))
+
+		// the unique client callbacks extend the role of the node stored by the stream
+		req.Node = roleNode("gw-abc.default", "kgateway-kube-gateway-api~default~gw~1234~default")
+		assert.NoError(t, callbacks.OnStreamRequest(2, req))
+		callbacks.OnStreamClosed(2, req.GetNode())
+	})
+
+	t.Run("serves gateways whose name is longer than the service account name", func(t *testing.T) {
+		require.NoError(t, openStream("long-gw-token", 5, false))
+		assert.NoError(t, callbacks.OnStreamRequest(5, &envoy_service_discovery_v3.DiscoveryRequest{
+			Node: roleNode("long-gw-abc.default", "kgateway-kube-gateway-api~default~"+longGatewayName),
+		}))
+		callbacks.OnStreamClosed(5, nil)
+	})
+
+	t.Run("denies other gateways", func(t *testing.T) {
+		require.NoError(t, openStream("gw-token", 3, false))
+		err := callbacks.OnStreamRequest(3, &envoy_service_discovery_v3.DiscoveryRequest{
+			Node: roleNode("gw-abc.default", "kgateway-kube-gateway-api~default~other-gw"),
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		err = callbacks.OnStreamRequest(3, &envoy_service_discovery_v3.DiscoveryRequest{
+			Node: roleNode("gw-abc.default", "misconfigured-node"),
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("denies nodes of other pods", func(t *testing.T) {
+		require.NoError(t, openStream("gw-token", 4, false))
+		err := callbacks.OnStreamRequest(4, &envoy_service_discovery_v3.DiscoveryRequest{
+			Node: roleNode("gw-def.default", "kgateway-kube-gateway-api~default~gw"),
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("delta streams only carry the node on the first request", func(t *testing.T) {
+		// delta stream ids overlap with state-of-the-world ones
+		require.NoError(t, openStream("gw-token", 4, true))
+		assert.Equal(t, codes.PermissionDenied, status.Code(callbacks.OnStreamDeltaRequest(4, &envoy_service_discovery_v3.DeltaDiscoveryRequest{})))
+		assert.NoError(t, callbacks.OnStreamDeltaRequest(4, &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			Node: roleNode("gw-abc.default", "kgateway-kube-gateway-api~default~gw"),
+		}))
+		assert.NoError(t, callbacks.OnStreamDeltaRequest(4, &envoy_service_discovery_v3.DeltaDiscoveryRequest{}))
+		callbacks.OnDeltaStreamClosed(4, nil)
+	})
+}
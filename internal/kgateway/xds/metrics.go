@@ -0,0 +1,77 @@
+package xds
+
+import (
+	"context"
+
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	xdsserver "github.com/envoyproxy/go-control-plane/pkg/server/v3"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/metrics"
+)
+
+const (
+	xdsSubsystem = "xds"
+	modeLabel    = "mode"
+	typeURLLabel = "type_url"
+
+	modeStateOfTheWorld = "sotw"
+	modeDelta           = "delta"
+)
+
+var responseBytes = metrics.NewHistogram(
+	metrics.HistogramOpts{
+		Subsystem: xdsSubsystem,
+		Name:      "response_bytes",
+		Help:      "Size of the xDS responses pushed to proxies, by xDS mode",
+		Buckets:   prometheus.ExponentialBuckets(256, 4, 10),
+	},
+	[]string{modeLabel, typeURLLabel},
+)
+
+// metricsCallbacks records the size of every response sent on state-of-the-world
+// and delta xDS streams before calling the wrapped callbacks.
+type metricsCallbacks struct {
+	xdsserver.Callbacks
+}
+
+// NewMetricsCallbacks wraps the xDS server callbacks to record response size metrics.
+func NewMetricsCallbacks(callbacks xdsserver.Callbacks) xdsserver.Callbacks {
+	if callbacks == nil {
+		callbacks = xdsserver.CallbackFuncs{}
+	}
+	return &metricsCallbacks{Callbacks: callbacks}
+}
+
+func (m *metricsCallbacks) OnStreamResponse(
+	ctx context.Context,
+	sid int64,
+	req *envoy_service_discovery_v3.DiscoveryRequest,
+	resp *envoy_service_discovery_v3.DiscoveryResponse,
+) {
+	observeResponseBytes(modeStateOfTheWorld, resp.GetTypeUrl(), proto.Size(resp))
+	m.Callbacks.OnStreamResponse(ctx, sid, req, resp)
+}
+
+func (m *metricsCallbacks) OnStreamDeltaResponse(
+	sid int64,
+	req *envoy_service_discovery_v3.DeltaDiscoveryRequest,
+	resp *envoy_service_discovery_v3.DeltaDiscoveryResponse,
+) {
+	observeResponseBytes(modeDelta, resp.GetTypeUrl(), proto.Size(resp))
+	m.Callbacks.OnStreamDeltaResponse(sid, req, resp)
+}
+
+func observeResponseBytes(mode, typeURL string, size int) {
+	responseBytes.Observe(float64(size),
+		metrics.Label{Name: modeLabel, Value: mode},
+		metrics.Label{Name: typeURLLabel, Value: typeURL},
+	)
+}
+
+// ResetMetrics resets the metrics from this package.
+// This is provided for testing purposes only.
+func ResetMetrics() {
+	responseBytes.Reset()
+}
@@ -0,0 +1,43 @@
+package xds_test
+
+import (
+	"context"
+	"testing"
+
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	xdsserver "github.com/envoyproxy/go-control-plane/pkg/server/v3"
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/kgateway-dev/kgateway/v2/internal/kgateway/xds"
+	"github.com/kgateway-dev/kgateway/v2/pkg/metrics"
+	"github.com/kgateway-dev/kgateway/v2/pkg/metrics/metricstest"
+)
+
+func TestMetricsCallbacks(t *testing.T) {
+	ResetMetrics()
+
+	var sotwCalled, deltaCalled bool
+	cb := NewMetricsCallbacks(xdsserver.CallbackFuncs{
+		StreamResponseFunc: func(context.Context, int64, *envoy_service_discovery_v3.DiscoveryRequest, *envoy_service_discovery_v3.DiscoveryResponse) {
+			sotwCalled = true
+		},
+		StreamDeltaResponseFunc: func(int64, *envoy_service_discovery_v3.DeltaDiscoveryRequest, *envoy_service_discovery_v3.DeltaDiscoveryResponse) {
+			deltaCalled = true
+		},
+	})
+
+	typeURL := "type.googleapis.com/envoy.config.endpoint.v3.ClusterLoadAssignment"
+	sotwResp := &envoy_service_discovery_v3.DiscoveryResponse{TypeUrl: typeURL, VersionInfo: "1"}
+	deltaResp := &envoy_service_discovery_v3.DeltaDiscoveryResponse{TypeUrl: typeURL, SystemVersionInfo: "1"}
+	cb.OnStreamResponse(context.Background(), 1, &envoy_service_discovery_v3.DiscoveryRequest{}, sotwResp)
+	cb.OnStreamDeltaResponse(1, &envoy_service_discovery_v3.DeltaDiscoveryRequest{}, deltaResp)
+
+	assert.True(t, sotwCalled)
+	assert.True(t, deltaCalled)
+
+	gathered := metricstest.MustGatherMetrics(t)
+	gathered.AssertMetricsLabels("kgateway_xds_response_bytes", [][]metrics.Label{
+		{{Name: "mode", Value: "delta"}, {Name: "type_url", Value: typeURL}},
+		{{Name: "mode", Value: "sotw"}, {Name: "type_url", Value: typeURL}},
+	})
+}
@@ -0,0 +1,68 @@
+package xds
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	tlsCertFile = "tls.crt"
+	tlsKeyFile  = "tls.key"
+	tlsCAFile   = "ca.crt"
+)
+
+// ReadTLSCA returns the PEM-encoded CA in the xDS TLS certificate directory.
+func ReadTLSCA(certDir string) (string, error) {
+	ca, err := os.ReadFile(filepath.Join(certDir, tlsCAFile))
+	if err != nil {
+		return "", fmt.Errorf("failed to read xDS TLS CA: %w", err)
+	}
+	return string(ca), nil
+}
+
+// NewServerTLSConfig returns the TLS config of the xDS server, serving the tls.crt and tls.key
+// in certDir. If requireClientCert is set, clients must present a certificate signed by the
+// ca.crt in certDir. The files are read on every handshake so that rotated certificates are
+// picked up without a restart.
+func NewServerTLSConfig(certDir string, requireClientCert bool) (*tls.Config, error) {
+	// fail early on a bad directory rather than on the first handshake
+	if _, err := loadServerTLSConfig(certDir, requireClientCert); err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return loadServerTLSConfig(certDir, requireClientCert)
+		},
+	}, nil
+}
+
+func loadServerTLSConfig(certDir string, requireClientCert bool) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(filepath.Join(certDir, tlsCertFile), filepath.Join(certDir, tlsKeyFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load xDS TLS certificate: %w", err)
+	}
+	cfg := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+	}
+	if !requireClientCert {
+		return cfg, nil
+	}
+
+	ca, err := ReadTLSCA(certDir)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM([]byte(ca)) {
+		return nil, errors.New("no certificates found in xDS TLS CA")
+	}
+	cfg.ClientCAs = pool
+	cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	return cfg, nil
+}
@@ -2,21 +2,48 @@ package run
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"os/signal"
+	"slices"
 	"syscall"
 	"time"
 
 	"github.com/fsnotify/fsnotify"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
 
 	"github.com/kgateway-dev/kgateway/v2/internal/sds/pkg/server"
 )
 
 func Run(ctx context.Context, secrets []server.Secret, sdsClient, sdsServerAddress string) error {
+	return RunWithKubeSecrets(ctx, secrets, nil, "", sdsClient, sdsServerAddress)
+}
+
+// RunWithKubeSecrets runs the SDS server like Run, and also watches the Kubernetes Secrets of the secrets
+// that have a KubeSecretName in the given namespace.
+func RunWithKubeSecrets(
+	ctx context.Context,
+	secrets []server.Secret,
+	kubeClient kubernetes.Interface,
+	namespace, sdsClient, sdsServerAddress string,
+) error {
 	ctx, cancel := context.WithCancel(ctx)
 
 	// Set up the gRPC server
-	sdsServer := server.SetupEnvoySDS(secrets, sdsClient, sdsServerAddress)
+	sdsServer := server.SetupEnvoySDS(secrets, sdsClient, sdsServerAddress, logger)
+
+	// Watch the kubernetes secrets before the SDS config is initialized, so that it is built from synced caches
+	if err := watchKubeSecrets(ctx, sdsServer, secrets, kubeClient, namespace); err != nil {
+		cancel()
+		return err
+	}
+
 	// Run the gRPC Server
 	serverStopped, err := sdsServer.Run(ctx) // runs the grpc server in internal goroutines
 	if err != nil {
@@ -73,6 +100,9 @@ func Run(ctx context.Context, secrets []server.Secret, sdsClient, sdsServerAddre
 
 func watchFiles(watcher *fsnotify.Watcher, secrets []server.Secret) {
 	for _, s := range secrets {
+		if s.KubeSecretName != "" {
+			continue
+		}
 		logger.Info("watcher started", "key_file", s.SslKeyFile, "cert_file", s.SslCertFile, "ca_file", s.SslCaFile)
 		if err := watcher.Add(s.SslKeyFile); err != nil {
 			logger.Warn("failed to add watch for key file", "error", err, "file", s.SslKeyFile)
@@ -85,3 +115,87 @@ func watchFiles(watcher *fsnotify.Watcher, secrets []server.Secret) {
 		}
 	}
 }
+
+// watchKubeSecrets starts an informer per watched Secret, scoped to its name in the namespace,
+// that updates the SDS config when the Secret changes.
+func watchKubeSecrets(
+	ctx context.Context,
+	sdsServer *server.Server,
+	secrets []server.Secret,
+	kubeClient kubernetes.Interface,
+	namespace string,
+) error {
+	var names []string
+	for _, s := range secrets {
+		if s.KubeSecretName != "" && !slices.Contains(names, s.KubeSecretName) {
+			names = append(names, s.KubeSecretName)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	slices.Sort(names)
+	if kubeClient == nil {
+		return fmt.Errorf("a kubernetes client is required to watch secrets %v", names)
+	}
+
+	onChange := func(obj any) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		secret, ok := obj.(*corev1.Secret)
+		if !ok {
+			return
+		}
+		logger.Info("received secret event", "secret", secret.GetName())
+		if err := sdsServer.UpdateSDSConfig(ctx); err != nil {
+			logger.Warn("failed to update SDS config", "error", err, "secret", secret.GetName())
+		}
+	}
+
+	// the proxy is only allowed to read the secrets it serves, so each informer lists and watches a single name
+	listers := map[string]corelisters.SecretNamespaceLister{}
+	var factories []informers.SharedInformerFactory
+	for _, name := range names {
+		factory := informers.NewSharedInformerFactoryWithOptions(kubeClient, 0,
+			informers.WithNamespace(namespace),
+			informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+				opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", name).String()
+			}),
+		)
+		informer := factory.Core().V1().Secrets()
+		listers[name] = informer.Lister().Secrets(namespace)
+		if _, err := informer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    onChange,
+			UpdateFunc: func(_, newObj any) { onChange(newObj) },
+			DeleteFunc: onChange,
+		}); err != nil {
+			return err
+		}
+		factories = append(factories, factory)
+	}
+	sdsServer.SetKubeSecretGetter(func(name string) *corev1.Secret {
+		lister, ok := listers[name]
+		if !ok {
+			return nil
+		}
+		secret, err := lister.Get(name)
+		if err != nil {
+			return nil
+		}
+		return secret
+	})
+
+	for _, factory := range factories {
+		factory.Start(ctx.Done())
+	}
+	for i, factory := range factories {
+		for typ, synced := range factory.WaitForCacheSync(ctx.Done()) {
+			if !synced {
+				return fmt.Errorf("failed to sync informer cache for %v %s", typ, names[i])
+			}
+		}
+	}
+	logger.Info("watching kubernetes secrets", "namespace", namespace, "secrets", names)
+	return nil
+}
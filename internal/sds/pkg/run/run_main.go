@@ -10,6 +10,8 @@ import (
 	"github.com/avast/retry-go"
 	"github.com/kelseyhightower/envconfig"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 
 	"github.com/solo-io/go-utils/stats"
 
@@ -40,6 +42,11 @@ type Config struct {
 	IstioCertDir           string `split_words:"true" default:"/etc/istio-certs/"`
 	IstioServerCert        string `split_words:"true" default:"istio_server_cert"`
 	IstioValidationContext string `split_words:"true" default:"istio_validation_context"`
+
+	// KubeSecretNames are the names of the Kubernetes TLS Secrets in the pod namespace to serve.
+	// Each Secret is served under the names returned by server.KubeSecretServerCertName and
+	// server.KubeSecretValidationContextName.
+	KubeSecretNames []string `split_words:"true"`
 }
 
 func RunMain() {
@@ -54,6 +61,7 @@ func RunMain() {
 		"config loaded",
 		slog.Bool("glooMtlsSdsEnabled", c.GlooMtlsSdsEnabled),
 		slog.Bool("istioMtlsSdsEnabled", c.IstioMtlsSdsEnabled),
+		slog.Any("kubeSecretNames", c.KubeSecretNames),
 	)
 
 	secrets := []server.Secret{}
@@ -90,7 +98,26 @@ func RunMain() {
 
 	logger.Info("secrets confirmed present, proceeding to start SDS server")
 
-	if err := Run(context.Background(), secrets, c.SdsClient, c.SdsServerAddress); err != nil {
+	var kubeClient kubernetes.Interface
+	for _, name := range c.KubeSecretNames {
+		secrets = append(secrets, server.Secret{
+			ServerCert:        server.KubeSecretServerCertName(c.PodNamespace, name),
+			ValidationContext: server.KubeSecretValidationContextName(c.PodNamespace, name),
+			KubeSecretName:    name,
+		})
+	}
+	if len(c.KubeSecretNames) > 0 {
+		restConfig, err := rest.InClusterConfig()
+		if err != nil {
+			log.Fatalf("failed to get kubernetes config: %v", err)
+		}
+		kubeClient, err = kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			log.Fatalf("failed to create kubernetes client: %v", err)
+		}
+	}
+
+	if err := RunWithKubeSecrets(context.Background(), secrets, kubeClient, c.PodNamespace, c.SdsClient, c.SdsServerAddress); err != nil {
 		log.Fatalf("failed to run SDS server: %v", err)
 	}
 }
@@ -108,10 +135,14 @@ func setup() Config {
 	}
 
 	// At least one must be enabled, otherwise we have nothing to do.
-	if !c.GlooMtlsSdsEnabled && !c.IstioMtlsSdsEnabled {
-		err := fmt.Errorf("at least one of Istio Cert rotation or Gloo Cert rotation must be enabled, using env vars GLOO_MTLS_SDS_ENABLED or ISTIO_MTLS_SDS_ENABLED")
+	if !c.GlooMtlsSdsEnabled && !c.IstioMtlsSdsEnabled && len(c.KubeSecretNames) == 0 {
+		err := fmt.Errorf("at least one of Istio Cert rotation, Gloo Cert rotation or Kubernetes Secrets must be enabled, using env vars GLOO_MTLS_SDS_ENABLED, ISTIO_MTLS_SDS_ENABLED or KUBE_SECRET_NAMES")
 		log.Fatalf("invalid config: %v", err)
 	}
+	// The secrets informer is scoped to the namespace of the proxy.
+	if len(c.KubeSecretNames) > 0 && c.PodNamespace == "" {
+		log.Fatalf("invalid config: POD_NAMESPACE must be set to watch Kubernetes Secrets")
+	}
 	return c
 }
 
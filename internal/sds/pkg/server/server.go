@@ -10,6 +10,7 @@ import (
 	"math"
 	"net"
 	"os"
+	"sync"
 
 	"github.com/avast/retry-go"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
@@ -20,8 +21,12 @@ import (
 	server "github.com/envoyproxy/go-control-plane/pkg/server/v3"
 	"github.com/solo-io/go-utils/hashutils"
 	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
 )
 
+// OcspStapleKey is the key of the optional OCSP staple in a Kubernetes TLS Secret.
+const OcspStapleKey = "tls.ocsp-staple"
+
 var (
 	grpcOptions = []grpc.ServerOption{
 		grpc.MaxConcurrentStreams(10000),
@@ -37,6 +42,24 @@ type Secret struct {
 	SslOcspFile       string
 	ServerCert        string // name of a tls_certificate_sds_secret_config
 	ValidationContext string // name of the validation_context_sds_secret_config
+	KubeSecretName    string // name of a kubernetes secret to read the certs from, instead of the files
+}
+
+// KubeSecretGetter returns the Kubernetes Secret with the given name from the namespace of the proxy,
+// or nil if it does not exist.
+type KubeSecretGetter func(name string) *corev1.Secret
+
+// KubeSecretServerCertName returns the stable name of the tls_certificate_sds_secret_config
+// serving the certificate of the given Kubernetes Secret. The control plane references
+// Gateway listener certificates under the same name.
+func KubeSecretServerCertName(namespace, secretName string) string {
+	return "kube_secret~" + namespace + "~" + secretName
+}
+
+// KubeSecretValidationContextName returns the stable name of the validation_context_sds_secret_config
+// serving the CA of the given Kubernetes Secret.
+func KubeSecretValidationContextName(namespace, secretName string) string {
+	return KubeSecretServerCertName(namespace, secretName) + "~validation_context"
 }
 
 // Server is the SDS server. Holds config & secrets.
@@ -46,6 +69,11 @@ type Server struct {
 	grpcServer    *grpc.Server
 	address       string
 	snapshotCache cache.SnapshotCache
+	kubeSecrets   KubeSecretGetter
+	logger        *slog.Logger
+
+	// updateLock serializes the snapshot updates triggered by the file and secret watchers
+	updateLock sync.Mutex
 }
 
 // ID needed for snapshotCache
@@ -53,14 +81,15 @@ func (s *Server) ID(_ *envoy_config_core_v3.Node) string {
 	return s.sdsClient
 }
 
-// SetupEnvoySDS creates a new SDSServer. The returned server can be started with Run()
-func SetupEnvoySDS(secrets []Secret, sdsClient, serverAddress string) *Server {
+// SetupEnvoySDS creates a new SDSServer logging to the given logger. The returned server can be started with Run()
+func SetupEnvoySDS(secrets []Secret, sdsClient, serverAddress string, logger *slog.Logger) *Server {
 	grpcServer := grpc.NewServer(grpcOptions...)
 	sdsServer := &Server{
 		secrets:    secrets,
 		grpcServer: grpcServer,
 		sdsClient:  sdsClient,
 		address:    serverAddress,
+		logger:     logger,
 	}
 	snapshotCache := cache.NewSnapshotCache(false, sdsServer, nil)
 	sdsServer.snapshotCache = snapshotCache
@@ -72,13 +101,18 @@ func SetupEnvoySDS(secrets []Secret, sdsClient, serverAddress string) *Server {
 	return sdsServer
 }
 
+// SetKubeSecretGetter sets the getter used to read the secrets that have a KubeSecretName.
+func (s *Server) SetKubeSecretGetter(getter KubeSecretGetter) {
+	s.kubeSecrets = getter
+}
+
 // Run starts the server
 func (s *Server) Run(ctx context.Context) (<-chan struct{}, error) {
 	lis, err := net.Listen("tcp", s.address)
 	if err != nil {
 		return nil, err
 	}
-	slog.Info("sds server listening", "address", s.address)
+	s.logger.Info("sds server listening", "address", s.address)
 	go func() {
 		if err = s.grpcServer.Serve(lis); err != nil {
 			log.Fatalf("fatal error in gRPC server: address=%s error=%v", s.address, err)
@@ -87,7 +121,7 @@ func (s *Server) Run(ctx context.Context) (<-chan struct{}, error) {
 	serverStopped := make(chan struct{})
 	go func() {
 		<-ctx.Done()
-		slog.Info("stopping sds server", "address", s.address)
+		s.logger.Info("stopping sds server", "address", s.address)
 		s.grpcServer.GracefulStop()
 		serverStopped <- struct{}{}
 	}()
@@ -96,9 +130,18 @@ func (s *Server) Run(ctx context.Context) (<-chan struct{}, error) {
 
 // UpdateSDSConfig updates with the current certs
 func (s *Server) UpdateSDSConfig(ctx context.Context) error {
+	s.updateLock.Lock()
+	defer s.updateLock.Unlock()
+
 	var certs [][]byte
 	var items []cache_types.Resource
 	for _, sec := range s.secrets {
+		if sec.KubeSecretName != "" {
+			secretCerts, secretItems := s.kubeSecretResources(sec)
+			certs = append(certs, secretCerts...)
+			items = append(items, secretItems...)
+			continue
+		}
 		key, err := readAndVerifyCert(ctx, sec.SslKeyFile)
 		if err != nil {
 			return err
@@ -128,16 +171,54 @@ func (s *Server) UpdateSDSConfig(ctx context.Context) error {
 
 	snapshotVersion, err := GetSnapshotVersion(certs)
 	if err != nil {
-		slog.Error("error getting snapshot version", "error", err)
+		s.logger.Error("error getting snapshot version", "error", err)
 		return err
 	}
-	slog.Info("updating SDS config", "client", s.sdsClient, "snapshot_version", snapshotVersion)
+	s.logger.Info("updating SDS config", "client", s.sdsClient, "snapshot_version", snapshotVersion)
 
 	secretSnapshot := &cache.Snapshot{}
 	secretSnapshot.Resources[cache_types.Secret] = cache.NewResources(snapshotVersion, items)
 	return s.snapshotCache.SetSnapshot(ctx, s.sdsClient, secretSnapshot)
 }
 
+// kubeSecretResources returns the certs and the SDS resources of a secret read from Kubernetes.
+// A Secret that is missing or invalid is skipped, so that envoy keeps waiting for its resources
+// while the other secrets are still served.
+func (s *Server) kubeSecretResources(sec Secret) ([][]byte, []cache_types.Resource) {
+	var kubeSecret *corev1.Secret
+	if s.kubeSecrets != nil {
+		kubeSecret = s.kubeSecrets(sec.KubeSecretName)
+	}
+	if kubeSecret == nil {
+		s.logger.Warn("kubernetes secret not found", "secret", sec.KubeSecretName)
+		return nil, nil
+	}
+
+	key := kubeSecret.Data[corev1.TLSPrivateKeyKey]
+	certChain := kubeSecret.Data[corev1.TLSCertKey]
+	if !checkCert(key) || !checkCert(certChain) {
+		s.logger.Warn("kubernetes secret does not contain a valid certificate and private key", "secret", sec.KubeSecretName)
+		return nil, nil
+	}
+	certs := [][]byte{key, certChain}
+	ocspStaple := kubeSecret.Data[OcspStapleKey] // ocsp stapling is optional
+	if ocspStaple != nil {
+		certs = append(certs, ocspStaple)
+	}
+	items := []cache_types.Resource{serverCertSecret(key, certChain, ocspStaple, sec.ServerCert)}
+
+	// the validation context is only served when the secret has a CA
+	if ca := kubeSecret.Data[corev1.ServiceAccountRootCAKey]; len(ca) > 0 {
+		if !checkCert(ca) {
+			s.logger.Warn("kubernetes secret contains an invalid CA", "secret", sec.KubeSecretName)
+			return nil, nil
+		}
+		certs = append(certs, ca)
+		items = append(items, validationContextSecret(ca, sec.ValidationContext))
+	}
+	return certs, items
+}
+
 // GetSnapshotVersion generates a version string by hashing the certs
 func GetSnapshotVersion(certs ...interface{}) (string, error) {
 	hash, err := hashutils.HashAllSafe(fnv.New64(), certs...)
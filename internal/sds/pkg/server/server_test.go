@@ -2,16 +2,20 @@ package server_test
 
 import (
 	"context"
+	"log/slog"
 	"os"
 	"strings"
 	"time"
 
+	envoy_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	envoy_service_secret_v3 "github.com/envoyproxy/go-control-plane/envoy/service/secret/v3"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/afero"
 	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/internal/sds/pkg/server"
 	"github.com/kgateway-dev/kgateway/v2/internal/sds/pkg/testutils"
@@ -66,7 +70,7 @@ var _ = Describe("SDS Server", func() {
 				ValidationContext: "test-validation",
 			},
 		}
-		srv = server.SetupEnvoySDS(secrets, sdsClient, serverAddr)
+		srv = server.SetupEnvoySDS(secrets, sdsClient, serverAddr, slog.Default())
 	})
 
 	AfterEach(func() {
@@ -161,4 +165,89 @@ var _ = Describe("SDS Server", func() {
 			}
 		})
 	})
+
+	Context("Kubernetes Secrets", func() {
+		var (
+			ctx         context.Context
+			cancel      context.CancelFunc
+			kubeSrv     *server.Server
+			stopped     <-chan struct{}
+			kubeSecrets map[string]*corev1.Secret
+			kubeAddr    = "127.0.0.1:8889"
+			pemBlock    = []byte("-----BEGIN CERTIFICATE-----\ndGVzdA==\n-----END CERTIFICATE-----\n")
+		)
+
+		fetchSecrets := func() map[string]string {
+			conn, err := grpc.Dial(kubeAddr, grpc.WithInsecure())
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			client := envoy_service_secret_v3.NewSecretDiscoveryServiceClient(conn)
+			resp, err := client.FetchSecrets(ctx, &envoy_service_discovery_v3.DiscoveryRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Validate()).To(Succeed())
+			resources := map[string]string{}
+			for _, resource := range resp.GetResources() {
+				var secret envoy_tls_v3.Secret
+				Expect(resource.UnmarshalTo(&secret)).To(Succeed())
+				resources[secret.GetName()] = secret.String()
+			}
+			return resources
+		}
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithCancel(context.Background())
+			kubeSecrets = map[string]*corev1.Secret{
+				"gateway-tls": {
+					ObjectMeta: metav1.ObjectMeta{Name: "gateway-tls"},
+					Data: map[string][]byte{
+						corev1.TLSCertKey:              pemBlock,
+						corev1.TLSPrivateKeyKey:        pemBlock,
+						corev1.ServiceAccountRootCAKey: pemBlock,
+						server.OcspStapleKey:           []byte("staple"),
+					},
+				},
+			}
+			secrets := []server.Secret{}
+			for _, name := range []string{"gateway-tls", "missing"} {
+				secrets = append(secrets, server.Secret{
+					ServerCert:        server.KubeSecretServerCertName("default", name),
+					ValidationContext: server.KubeSecretValidationContextName("default", name),
+					KubeSecretName:    name,
+				})
+			}
+			kubeSrv = server.SetupEnvoySDS(secrets, sdsClient, kubeAddr, slog.Default())
+			kubeSrv.SetKubeSecretGetter(func(name string) *corev1.Secret {
+				return kubeSecrets[name]
+			})
+			stopped, err = kubeSrv.Run(ctx)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			cancel()
+			// wait for the port to be released before the next test
+			Eventually(stopped).Should(Receive())
+		})
+
+		It("serves the secrets under stable names", func() {
+			Expect(kubeSrv.UpdateSDSConfig(ctx)).To(Succeed())
+			Eventually(fetchSecrets).Should(HaveLen(2))
+
+			resources := fetchSecrets()
+			Expect(resources).To(HaveKeyWithValue("kube_secret~default~gateway-tls", And(
+				ContainSubstring("certificate_chain"),
+				ContainSubstring("private_key"),
+				ContainSubstring("ocsp_staple"),
+			)))
+			Expect(resources).To(HaveKeyWithValue("kube_secret~default~gateway-tls~validation_context", ContainSubstring("trusted_ca")))
+		})
+
+		It("only serves the validation context when the secret has a CA", func() {
+			delete(kubeSecrets["gateway-tls"].Data, corev1.ServiceAccountRootCAKey)
+			Expect(kubeSrv.UpdateSDSConfig(ctx)).To(Succeed())
+			Eventually(fetchSecrets).Should(HaveLen(1))
+			Expect(fetchSecrets()).To(HaveKey("kube_secret~default~gateway-tls"))
+		})
+	})
 })
@@ -14,4 +14,6 @@ type GatewayParametersExpansion interface{}
 
 type HTTPListenerPolicyExpansion interface{}
 
+type ListenerPolicyExpansion interface{}
+
 type TrafficPolicyExpansion interface{}
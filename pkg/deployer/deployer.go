@@ -12,6 +12,7 @@ import (
 	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/storage"
 	"helm.sh/helm/v3/pkg/storage/driver"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -27,6 +28,15 @@ import (
 type ControlPlaneInfo struct {
 	XdsHost string
 	XdsPort uint32
+	// XdsTlsCaCert is the PEM-encoded CA that proxies use to verify the xDS server.
+	// If empty, proxies connect to the xDS server in plaintext.
+	XdsTlsCaCert string
+	// XdsTlsClientCertSecretName is the name of the Secret, in the namespace of the Gateway,
+	// holding the client certificate that proxies present to the xDS server.
+	XdsTlsClientCertSecretName string
+	// XdsTokenAudience is the audience of the ServiceAccount token that proxies present to the
+	// xDS server. If empty, proxies don't present a token.
+	XdsTokenAudience string
 }
 
 // InferenceExtInfo defines the runtime state of Gateway API inference extensions.
@@ -195,6 +205,38 @@ func (d *Deployer) DeployObjs(ctx context.Context, objs []client.Object) error {
 	return nil
 }
 
+// PruneObjs deletes the objects of the given kinds that were deployed for the owner but are no
+// longer rendered, such as the HorizontalPodAutoscaler of a Gateway that no longer autoscales.
+// Only objects named after the release and controlled by the owner are deleted.
+func (d *Deployer) PruneObjs(ctx context.Context, owner client.Object, objs []client.Object, gvks []schema.GroupVersionKind) error {
+	logger := log.FromContext(ctx)
+	rname, rns := d.helmReleaseNameAndNamespaceGenerator(owner)
+	for _, gvk := range gvks {
+		if slices.ContainsFunc(objs, func(obj client.Object) bool {
+			return obj.GetObjectKind().GroupVersionKind() == gvk
+		}) {
+			continue
+		}
+
+		existing := &unstructured.Unstructured{}
+		existing.SetGroupVersionKind(gvk)
+		if err := d.cli.Get(ctx, client.ObjectKey{Namespace: rns, Name: rname}, existing); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed to get object %s %s: %w", gvk.String(), rname, err)
+		}
+		if !metav1.IsControlledBy(existing, owner) {
+			continue
+		}
+		logger.V(1).Info("pruning object", "kind", gvk.Kind, "namespace", rns, "name", rname)
+		if err := d.cli.Delete(ctx, existing); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete object %s %s: %w", gvk.String(), rname, err)
+		}
+	}
+	return nil
+}
+
 func (d *Deployer) GetGvksToWatch(ctx context.Context, vals map[string]any) ([]schema.GroupVersionKind, error) {
 	// The deployer watches all resources (Deployment, Service, ServiceAccount, and ConfigMap)
 	// that it creates via the deployer helm chart.
@@ -202,8 +244,7 @@ func (d *Deployer) GetGvksToWatch(ctx context.Context, vals map[string]any) ([]s
 	// In order to get the GVKs for the resources to watch, we need:
 	// - a placeholder Gateway (only the name and namespace are used, but the actual values don't matter,
 	//   as we only care about the GVKs of the rendered resources)
-	// - the minimal values that render all the proxy resources (HPA is not included because it's not
-	//   fully integrated/working at the moment)
+	// - the minimal values that render all the proxy resources
 	//
 	// Note: another option is to hardcode the GVKs here, but rendering the helm chart is a
 	// _slightly_ more dynamic way of getting the GVKs. It isn't a perfect solution since if
@@ -8,7 +8,10 @@ import (
 	"time"
 
 	envoy_config_bootstrap "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_grpc_credential_v3 "github.com/envoyproxy/go-control-plane/envoy/config/grpc_credential/v3"
+	envoy_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	_ "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
 	"github.com/ghodss/yaml"
 	. "github.com/onsi/ginkgo/v2"
@@ -19,7 +22,9 @@ import (
 	"istio.io/istio/pkg/kube/krt/krttest"
 	"istio.io/istio/pkg/test"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -404,6 +409,81 @@ var _ = Describe("Deployer", func() {
 			Expect(objs.findConfigMap(defaultNamespace, gw.Name)).ToNot(BeNil())
 			Expect(objs.findServiceAccount(defaultNamespace, gw.Name)).ToNot(BeNil())
 		})
+
+		It("should secure the connection to the xds server", func() {
+			gwc := defaultGatewayClass()
+			gw := defaultGateway()
+
+			gwp := internaldeployer.NewGatewayParameters(newFakeClientWithObjs(gwc), &deployer.Inputs{
+				CommonCollections: newCommonCols(GinkgoT(), gwc, gw),
+				ControlPlane: deployer.ControlPlaneInfo{
+					XdsHost:                    "something.cluster.local",
+					XdsPort:                    1234,
+					XdsTlsCaCert:               "fake-ca",
+					XdsTlsClientCertSecretName: "xds-client-tls",
+					XdsTokenAudience:           "kgateway-xds",
+				},
+				ImageInfo: &deployer.ImageInfo{
+					Registry: "foo",
+					Tag:      "bar",
+				},
+				GatewayClassName:         wellknown.DefaultGatewayClassName,
+				WaypointGatewayClassName: wellknown.DefaultWaypointClassName,
+				AgentGatewayClassName:    wellknown.DefaultAgentGatewayClassName,
+			})
+			chart, err := internaldeployer.LoadGatewayChart()
+			Expect(err).NotTo(HaveOccurred())
+			d := deployer.NewDeployer(wellknown.DefaultGatewayControllerName, newFakeClientWithObjs(gwc), chart,
+				gwp,
+				internaldeployer.GatewayReleaseNameAndNamespace)
+
+			var objs clientObjects
+			objs, err = d.GetObjsToDeploy(context.Background(), gw)
+			Expect(err).NotTo(HaveOccurred())
+			objs = d.SetNamespaceAndOwner(gw, objs)
+
+			By("verifying the xds cluster validates the server and presents the client certificate")
+			bootstrapCfg := objs.getEnvoyConfig(defaultNamespace, gw.Name)
+			var xdsCluster *envoy_config_cluster_v3.Cluster
+			for _, c := range bootstrapCfg.GetStaticResources().GetClusters() {
+				if c.GetName() == "xds_cluster" {
+					xdsCluster = c
+				}
+			}
+			Expect(xdsCluster).NotTo(BeNil())
+			tlsContext := &envoy_tls_v3.UpstreamTlsContext{}
+			Expect(xdsCluster.GetTransportSocket().GetTypedConfig().UnmarshalTo(tlsContext)).To(Succeed())
+			Expect(tlsContext.GetSni()).To(Equal("something.cluster.local"))
+			Expect(tlsContext.GetCommonTlsContext().GetValidationContext().GetTrustedCa().GetInlineString()).To(Equal("fake-ca"))
+			Expect(tlsContext.GetCommonTlsContext().GetTlsCertificates()).To(HaveLen(1))
+			Expect(tlsContext.GetCommonTlsContext().GetTlsCertificates()[0].GetCertificateChain().GetFilename()).To(Equal("/etc/xds-tls/tls.crt"))
+
+			By("verifying the client certificate and the service account token are mounted")
+			dep := objs.findDeployment(defaultNamespace, gw.Name)
+			Expect(dep).NotTo(BeNil())
+			volumes := map[string]corev1.Volume{}
+			for _, v := range dep.Spec.Template.Spec.Volumes {
+				volumes[v.Name] = v
+			}
+			Expect(volumes["xds-tls"].Secret.SecretName).To(Equal("xds-client-tls"))
+			token := volumes["xds-token"].Projected.Sources[0].ServiceAccountToken
+			Expect(token.Audience).To(Equal("kgateway-xds"))
+			Expect(token.ExpirationSeconds).To(Equal(ptr.To[int64](43200)))
+
+			By("verifying envoy reads the token from the file for every xds call")
+			grpcServices := bootstrapCfg.GetDynamicResources().GetAdsConfig().GetGrpcServices()
+			Expect(grpcServices).To(HaveLen(1))
+			googleGrpc := grpcServices[0].GetGoogleGrpc()
+			Expect(googleGrpc.GetTargetUri()).To(Equal("something.cluster.local:1234"))
+			Expect(googleGrpc.GetChannelCredentials().GetSslCredentials().GetRootCerts().GetInlineString()).To(Equal("fake-ca"))
+			Expect(googleGrpc.GetChannelCredentials().GetSslCredentials().GetCertChain().GetFilename()).To(Equal("/etc/xds-tls/tls.crt"))
+			Expect(googleGrpc.GetCallCredentials()).To(HaveLen(1))
+			metadataConfig := &envoy_grpc_credential_v3.FileBasedMetadataConfig{}
+			Expect(googleGrpc.GetCallCredentials()[0].GetFromPlugin().GetTypedConfig().UnmarshalTo(metadataConfig)).To(Succeed())
+			Expect(metadataConfig.GetSecretData().GetFilename()).To(Equal("/var/run/secrets/xds-tokens/xds-token"))
+			Expect(metadataConfig.GetHeaderPrefix()).To(Equal("Bearer "))
+			Expect(grpcServices[0].GetInitialMetadata()).To(BeEmpty())
+		})
 	})
 
 	Context("self managed gateway", func() {
@@ -613,7 +693,7 @@ var _ = Describe("Deployer", func() {
 				},
 			}
 
-			gwParams1 := internaldeployer.NewGatewayParameters(newFakeClientWithObjs(gwc, defaultGatewayParams()), &deployer.Inputs{
+			gwParams1 := internaldeployer.NewGatewayParameters(newFakeClientWithObjs(gwc), &deployer.Inputs{
 				CommonCollections: newCommonCols(GinkgoT(), gwc, gw1, gw2),
 				Dev:               false,
 				ControlPlane: deployer.ControlPlaneInfo{
@@ -631,11 +711,11 @@ var _ = Describe("Deployer", func() {
 			chart, err := internaldeployer.LoadGatewayChart()
 			Expect(err).NotTo(HaveOccurred())
 			d1 := deployer.NewDeployer(wellknown.DefaultGatewayControllerName,
-				newFakeClientWithObjs(gwc, defaultGatewayParams()), chart,
+				newFakeClientWithObjs(gwc), chart,
 				gwParams1,
 				internaldeployer.GatewayReleaseNameAndNamespace)
 
-			gwParams2 := internaldeployer.NewGatewayParameters(newFakeClientWithObjs(gwc, defaultGatewayParams()), &deployer.Inputs{
+			gwParams2 := internaldeployer.NewGatewayParameters(newFakeClientWithObjs(gwc), &deployer.Inputs{
 				CommonCollections: newCommonCols(GinkgoT(), gwc, gw1, gw2),
 				Dev:               false,
 				ControlPlane: deployer.ControlPlaneInfo{
@@ -650,7 +730,7 @@ var _ = Describe("Deployer", func() {
 				WaypointGatewayClassName: wellknown.DefaultWaypointClassName,
 				AgentGatewayClassName:    wellknown.DefaultAgentGatewayClassName,
 			})
-			d2 := deployer.NewDeployer(wellknown.DefaultGatewayControllerName, newFakeClientWithObjs(gwc, defaultGatewayParams()), chart,
+			d2 := deployer.NewDeployer(wellknown.DefaultGatewayControllerName, newFakeClientWithObjs(gwc), chart,
 				gwParams2,
 				internaldeployer.GatewayReleaseNameAndNamespace)
 
@@ -955,6 +1035,7 @@ var _ = Describe("Deployer", func() {
 							EnvoyContainer: &gw2_v1alpha1.EnvoyContainer{
 								Bootstrap: &gw2_v1alpha1.EnvoyBootstrap{
 									LogLevel: ptr.To("debug"),
+									XdsMode:  ptr.To(gw2_v1alpha1.XdsModeDelta),
 								},
 							},
 						},
@@ -1048,6 +1129,9 @@ var _ = Describe("Deployer", func() {
 				port := prometheusListener.Address.GetSocketAddress().PortSpecifier.(*envoy_config_core_v3.SocketAddress_PortValue)
 				Expect(port.PortValue).To(Equal(uint32(9091)))
 
+				By("verifying the proxy uses delta xds")
+				Expect(bootstrapCfg.DynamicResources.GetAdsConfig().GetApiType()).To(Equal(envoy_config_core_v3.ApiConfigSource_DELTA_GRPC))
+
 				By("verifying image registry and tag were inherited")
 				Expect(envoyContainer.Image).To(Equal(fmt.Sprintf("%s/%s:%s", registry, deployer.EnvoyWrapperImage, tag)))
 			})
@@ -1300,6 +1384,18 @@ var _ = Describe("Deployer", func() {
 					},
 				}
 			}
+			gatewayParamsOverrideWithAutoscaling = func() *gw2_v1alpha1.GatewayParameters {
+				params := defaultGatewayParamsOverride()
+				params.Spec.Kube.Deployment.Autoscaling = &gw2_v1alpha1.Autoscaling{
+					MinReplicas:                       ptr.To(uint32(2)),
+					MaxReplicas:                       ptr.To(uint32(5)),
+					TargetMemoryUtilizationPercentage: ptr.To(uint32(70)),
+				}
+				params.Spec.Kube.Deployment.PodDisruptionBudget = &gw2_v1alpha1.PodDisruptionBudget{
+					MinAvailable: ptr.To(intstr.FromInt32(1)),
+				}
+				return params
+			}
 			fullyDefinedGatewayParams = func() *gw2_v1alpha1.GatewayParameters {
 				return fullyDefinedGatewayParameters(wellknown.DefaultGatewayParametersName, defaultNamespace)
 			}
@@ -2143,6 +2239,113 @@ var _ = Describe("Deployer", func() {
 					return nil
 				},
 			}),
+			Entry("autoscaling and pod disruption budget", &input{
+				dInputs:     defaultDeployerInputs(),
+				gw:          defaultGatewayWithGatewayParams(gwpOverrideName),
+				defaultGwp:  defaultGatewayParams(),
+				overrideGwp: gatewayParamsOverrideWithAutoscaling(),
+			}, &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					dep := objs.findDeployment(defaultNamespace, defaultDeploymentName)
+					Expect(dep).NotTo(BeNil())
+					// the replicas are managed by the HorizontalPodAutoscaler
+					Expect(dep.Spec.Replicas).To(BeNil())
+
+					var hpa *autoscalingv2.HorizontalPodAutoscaler
+					var pdb *policyv1.PodDisruptionBudget
+					for _, obj := range objs {
+						switch obj := obj.(type) {
+						case *autoscalingv2.HorizontalPodAutoscaler:
+							hpa = obj
+						case *policyv1.PodDisruptionBudget:
+							pdb = obj
+						}
+					}
+					Expect(hpa).NotTo(BeNil())
+					Expect(hpa.Spec.ScaleTargetRef.Name).To(Equal(defaultDeploymentName))
+					Expect(hpa.Spec.MinReplicas).To(Equal(ptr.To(int32(2))))
+					Expect(hpa.Spec.MaxReplicas).To(Equal(int32(5)))
+					Expect(hpa.Spec.Metrics).To(HaveLen(1))
+					Expect(hpa.Spec.Metrics[0].Resource.Name).To(Equal(corev1.ResourceMemory))
+					Expect(hpa.Spec.Metrics[0].Resource.Target.AverageUtilization).To(Equal(ptr.To(int32(70))))
+
+					Expect(pdb).NotTo(BeNil())
+					Expect(pdb.Spec.MinAvailable).To(Equal(ptr.To(intstr.FromInt32(1))))
+					Expect(pdb.Spec.MaxUnavailable).To(BeNil())
+					Expect(pdb.Spec.Selector.MatchLabels).To(Equal(dep.Spec.Selector.MatchLabels))
+					return nil
+				},
+			}),
+			Entry("sds serving kubernetes secrets", &input{
+				dInputs:    istioEnabledDeployerInputs(),
+				gw:         defaultGatewayWithGatewayParams(gwpOverrideName),
+				defaultGwp: defaultGatewayParams(),
+				overrideGwp: func() *gw2_v1alpha1.GatewayParameters {
+					gwp := gatewayParamsOverrideWithSds()
+					gwp.Spec.Kube.SdsContainer.Bootstrap = &gw2_v1alpha1.SdsBootstrap{
+						SecretNames: []string{"client-cert", "server-cert"},
+					}
+					return gwp
+				}(),
+			}, &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					dep := objs.findDeployment(defaultNamespace, defaultDeploymentName)
+					Expect(dep).NotTo(BeNil())
+					sdsContainer := dep.Spec.Template.Spec.Containers[1]
+					Expect(sdsContainer.Name).To(Equal("sds"))
+					Expect(sdsContainer.Env).To(ContainElement(corev1.EnvVar{
+						Name:  "KUBE_SECRET_NAMES",
+						Value: "client-cert,server-cert",
+					}))
+					// the service account token is not automounted, so it is projected for the sds container only
+					Expect(sdsContainer.VolumeMounts).To(ContainElement(corev1.VolumeMount{
+						Name:      "sds-kube-api-access",
+						MountPath: "/var/run/secrets/kubernetes.io/serviceaccount",
+						ReadOnly:  true,
+					}))
+					Expect(dep.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("Name", "sds-kube-api-access")))
+
+					var role *rbacv1.Role
+					var roleBinding *rbacv1.RoleBinding
+					for _, obj := range objs {
+						switch obj := obj.(type) {
+						case *rbacv1.Role:
+							role = obj
+						case *rbacv1.RoleBinding:
+							roleBinding = obj
+						}
+					}
+					Expect(role).NotTo(BeNil())
+					Expect(role.Rules).To(ConsistOf(rbacv1.PolicyRule{
+						APIGroups:     []string{""},
+						Resources:     []string{"secrets"},
+						ResourceNames: []string{"client-cert", "server-cert"},
+						Verbs:         []string{"get", "list", "watch"},
+					}))
+					Expect(roleBinding).NotTo(BeNil())
+					Expect(roleBinding.RoleRef.Name).To(Equal(role.Name))
+					Expect(roleBinding.Subjects).To(ConsistOf(rbacv1.Subject{
+						Kind:      rbacv1.ServiceAccountKind,
+						Name:      dep.Spec.Template.Spec.ServiceAccountName,
+						Namespace: defaultNamespace,
+					}))
+					return nil
+				},
+			}),
+			Entry("correct deployment with sds and no kubernetes secrets", &input{
+				dInputs:     istioEnabledDeployerInputs(),
+				gw:          defaultGatewayWithGatewayParams(gwpOverrideName),
+				defaultGwp:  defaultGatewayParams(),
+				overrideGwp: gatewayParamsOverrideWithSds(),
+			}, &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					for _, obj := range objs {
+						Expect(obj).NotTo(BeAssignableToTypeOf(&rbacv1.Role{}))
+						Expect(obj).NotTo(BeAssignableToTypeOf(&rbacv1.RoleBinding{}))
+					}
+					return nil
+				},
+			}),
 			Entry("failed to get GatewayParameters", &input{
 				dInputs:    defaultDeployerInputs(),
 				gw:         defaultGatewayWithGatewayParams("bad-gwp"),
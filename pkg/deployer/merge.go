@@ -497,6 +497,10 @@ func deepMergeSdsBootstrap(dst, src *v1alpha1.SdsBootstrap) *v1alpha1.SdsBootstr
 		dst.LogLevel = src.GetLogLevel()
 	}
 
+	if src.GetSecretNames() != nil {
+		dst.SecretNames = src.GetSecretNames()
+	}
+
 	return dst
 }
 
@@ -626,6 +630,10 @@ func deepMergeEnvoyBootstrap(dst, src *v1alpha1.EnvoyBootstrap) *v1alpha1.EnvoyB
 
 	dst.ComponentLogLevels = DeepMergeMaps(dst.GetComponentLogLevels(), src.GetComponentLogLevels())
 
+	if src.GetXdsMode() != nil {
+		dst.XdsMode = src.GetXdsMode()
+	}
+
 	return dst
 }
 
@@ -697,6 +705,28 @@ func deepMergeDeployment(dst, src *v1alpha1.ProxyDeployment) *v1alpha1.ProxyDepl
 	}
 
 	dst.Replicas = MergePointers(dst.GetReplicas(), src.GetReplicas())
+	dst.Autoscaling = deepMergeAutoscaling(dst.GetAutoscaling(), src.GetAutoscaling())
+	// minAvailable and maxUnavailable are mutually exclusive, so the budget is not merged field by field
+	dst.PodDisruptionBudget = MergePointers(dst.GetPodDisruptionBudget(), src.GetPodDisruptionBudget())
+
+	return dst
+}
+
+func deepMergeAutoscaling(dst, src *v1alpha1.Autoscaling) *v1alpha1.Autoscaling {
+	// nil src override means just use dst
+	if src == nil {
+		return dst
+	}
+
+	if dst == nil {
+		return src
+	}
+
+	dst.MinReplicas = MergePointers(dst.GetMinReplicas(), src.GetMinReplicas())
+	dst.MaxReplicas = MergePointers(dst.GetMaxReplicas(), src.GetMaxReplicas())
+	dst.TargetCPUUtilizationPercentage = MergePointers(dst.GetTargetCPUUtilizationPercentage(), src.GetTargetCPUUtilizationPercentage())
+	dst.TargetMemoryUtilizationPercentage = MergePointers(dst.GetTargetMemoryUtilizationPercentage(), src.GetTargetMemoryUtilizationPercentage())
+	dst.Behavior = MergePointers(dst.GetBehavior(), src.GetBehavior())
 
 	return dst
 }
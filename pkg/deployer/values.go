@@ -1,7 +1,9 @@
 package deployer
 
 import (
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
@@ -22,11 +24,12 @@ type HelmGateway struct {
 	FullnameOverride *string `json:"fullnameOverride,omitempty"`
 
 	// deployment/service values
-	ReplicaCount   *uint32          `json:"replicaCount,omitempty"`
-	Autoscaling    *HelmAutoscaling `json:"autoscaling,omitempty"`
-	Ports          []HelmPort       `json:"ports,omitempty"`
-	Service        *HelmService     `json:"service,omitempty"`
-	FloatingUserId *bool            `json:"floatingUserId,omitempty"`
+	ReplicaCount        *uint32                  `json:"replicaCount,omitempty"`
+	Autoscaling         *HelmAutoscaling         `json:"autoscaling,omitempty"`
+	PodDisruptionBudget *HelmPodDisruptionBudget `json:"podDisruptionBudget,omitempty"`
+	Ports               []HelmPort               `json:"ports,omitempty"`
+	Service             *HelmService             `json:"service,omitempty"`
+	FloatingUserId      *bool                    `json:"floatingUserId,omitempty"`
 
 	// serviceaccount values
 	ServiceAccount *HelmServiceAccount `json:"serviceAccount,omitempty"`
@@ -106,16 +109,33 @@ type HelmServiceAccount struct {
 // helmXds represents the xds host and port to which envoy will connect
 // to receive xds config updates
 type HelmXds struct {
-	Host *string `json:"host,omitempty"`
-	Port *uint32 `json:"port,omitempty"`
+	Host    *string `json:"host,omitempty"`
+	Port    *uint32 `json:"port,omitempty"`
+	ApiType *string `json:"apiType,omitempty"`
+	// Tls is set when the xds server only accepts TLS connections
+	Tls *HelmXdsTls `json:"tls,omitempty"`
+	// TokenAudience is set when the proxy must authenticate with its service account token
+	TokenAudience *string `json:"tokenAudience,omitempty"`
+}
+
+type HelmXdsTls struct {
+	CaCert               *string `json:"caCert,omitempty"`
+	ClientCertSecretName *string `json:"clientCertSecretName,omitempty"`
 }
 
 type HelmAutoscaling struct {
-	Enabled                           *bool   `json:"enabled,omitempty"`
-	MinReplicas                       *uint32 `json:"minReplicas,omitempty"`
-	MaxReplicas                       *uint32 `json:"maxReplicas,omitempty"`
-	TargetCPUUtilizationPercentage    *uint32 `json:"targetCPUUtilizationPercentage,omitempty"`
-	TargetMemoryUtilizationPercentage *uint32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+	Enabled                           *bool                                          `json:"enabled,omitempty"`
+	MinReplicas                       *uint32                                        `json:"minReplicas,omitempty"`
+	MaxReplicas                       *uint32                                        `json:"maxReplicas,omitempty"`
+	TargetCPUUtilizationPercentage    *uint32                                        `json:"targetCPUUtilizationPercentage,omitempty"`
+	TargetMemoryUtilizationPercentage *uint32                                        `json:"targetMemoryUtilizationPercentage,omitempty"`
+	Behavior                          *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+}
+
+type HelmPodDisruptionBudget struct {
+	Enabled        *bool               `json:"enabled,omitempty"`
+	MinAvailable   *intstr.IntOrString `json:"minAvailable,omitempty"`
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
 }
 
 type HelmIstio struct {
@@ -130,7 +150,8 @@ type HelmSdsContainer struct {
 }
 
 type SdsBootstrap struct {
-	LogLevel *string `json:"logLevel,omitempty"`
+	LogLevel    *string  `json:"logLevel,omitempty"`
+	SecretNames []string `json:"secretNames,omitempty"`
 }
 
 type HelmIstioContainer struct {
@@ -77,26 +77,43 @@ func AppendPortValue(gwPorts []HelmPort, port uint16, name string, gwp *v1alpha1
 	})
 }
 
-// TODO: Removing until autoscaling is re-added.
-// See: https://github.com/solo-io/solo-projects/issues/5948
 // Convert autoscaling values from GatewayParameters into helm values to be used by the deployer.
-// func getAutoscalingValues(autoscaling *v1.Autoscaling) *helmAutoscaling {
-// 	hpaConfig := autoscaling.HorizontalPodAutoscaler
-// 	if hpaConfig == nil {
-// 		return nil
-// 	}
-
-// 	trueVal := true
-// 	autoscalingVals := &helmAutoscaling{
-// 		Enabled: &trueVal,
-// 	}
-// 	autoscalingVals.MinReplicas = hpaConfig.MinReplicas
-// 	autoscalingVals.MaxReplicas = hpaConfig.MaxReplicas
-// 	autoscalingVals.TargetCPUUtilizationPercentage = hpaConfig.TargetCpuUtilizationPercentage
-// 	autoscalingVals.TargetMemoryUtilizationPercentage = hpaConfig.TargetMemoryUtilizationPercentage
-
-// 	return autoscalingVals
-// }
+func GetAutoscalingValues(autoscaling *v1alpha1.Autoscaling) *HelmAutoscaling {
+	if autoscaling == nil {
+		return nil
+	}
+
+	autoscalingVals := &HelmAutoscaling{
+		Enabled:                           ptr.To(true),
+		MinReplicas:                       autoscaling.GetMinReplicas(),
+		MaxReplicas:                       autoscaling.GetMaxReplicas(),
+		TargetCPUUtilizationPercentage:    autoscaling.GetTargetCPUUtilizationPercentage(),
+		TargetMemoryUtilizationPercentage: autoscaling.GetTargetMemoryUtilizationPercentage(),
+		Behavior:                          autoscaling.GetBehavior(),
+	}
+	if autoscalingVals.MinReplicas == nil {
+		autoscalingVals.MinReplicas = ptr.To[uint32](1)
+	}
+	// the HorizontalPodAutoscaler needs a metric to scale on
+	if autoscalingVals.TargetCPUUtilizationPercentage == nil && autoscalingVals.TargetMemoryUtilizationPercentage == nil {
+		autoscalingVals.TargetCPUUtilizationPercentage = ptr.To[uint32](80)
+	}
+
+	return autoscalingVals
+}
+
+// Convert pod disruption budget values from GatewayParameters into helm values to be used by the deployer.
+func GetPodDisruptionBudgetValues(pdb *v1alpha1.PodDisruptionBudget) *HelmPodDisruptionBudget {
+	if pdb == nil {
+		return nil
+	}
+
+	return &HelmPodDisruptionBudget{
+		Enabled:        ptr.To(true),
+		MinAvailable:   pdb.GetMinAvailable(),
+		MaxUnavailable: pdb.GetMaxUnavailable(),
+	}
+}
 
 // Convert service values from GatewayParameters into helm values to be used by the deployer.
 func GetServiceValues(svcConfig *v1alpha1.Service) *HelmService {
@@ -137,7 +154,8 @@ func GetSdsContainerValues(sdsContainerConfig *v1alpha1.SdsContainer) *HelmSdsCo
 
 	if bootstrap := sdsContainerConfig.GetBootstrap(); bootstrap != nil {
 		vals.SdsBootstrap = &SdsBootstrap{
-			LogLevel: bootstrap.GetLogLevel(),
+			LogLevel:    bootstrap.GetLogLevel(),
+			SecretNames: bootstrap.GetSecretNames(),
 		}
 	}
 
@@ -243,6 +261,30 @@ func ComponentLogLevelsToString(vals map[string]string) (string, error) {
 	return strings.Join(parts, ","), nil
 }
 
+// GetXdsApiType returns the ADS api type of the Envoy bootstrap for the xDS mode.
+// nil is returned for the default state-of-the-world mode.
+func GetXdsApiType(mode *v1alpha1.XdsMode) *string {
+	if mode == nil || *mode != v1alpha1.XdsModeDelta {
+		return nil
+	}
+	return ptr.To("DELTA_GRPC")
+}
+
+// GetXdsTlsValues returns the TLS values of the xds connection, or nil if the xds server
+// doesn't use TLS.
+func GetXdsTlsValues(cp ControlPlaneInfo) *HelmXdsTls {
+	if cp.XdsTlsCaCert == "" {
+		return nil
+	}
+	tls := &HelmXdsTls{
+		CaCert: ptr.To(cp.XdsTlsCaCert),
+	}
+	if cp.XdsTlsClientCertSecretName != "" {
+		tls.ClientCertSecretName = ptr.To(cp.XdsTlsClientCertSecretName)
+	}
+	return tls
+}
+
 func GetAIExtensionValues(config *v1alpha1.AiExtension) (*HelmAIExtension, error) {
 	if config == nil {
 		return nil, nil
@@ -10,6 +10,7 @@ import (
 	"istio.io/istio/pkg/kube/krt"
 	"istio.io/istio/pkg/kube/kubetypes"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -39,6 +40,7 @@ type CommonCollections struct {
 	GatewayIndex      *krtcollections.GatewayIndex
 	GatewayExtensions krt.Collection[ir.GatewayExtension]
 	Services          krt.Collection[*corev1.Service]
+	EndpointSlices    krt.Collection[*discoveryv1.EndpointSlice]
 	ServiceEntries    krt.Collection[*networkingclient.ServiceEntry]
 
 	Pods       krt.Collection[krtcollections.LocalityPod]
@@ -63,6 +65,7 @@ func (c *CommonCollections) HasSynced() bool {
 		c.ConfigMaps != nil && c.ConfigMaps.HasSynced() &&
 		c.GatewayExtensions != nil && c.GatewayExtensions.HasSynced() &&
 		c.Services != nil && c.Services.HasSynced() &&
+		c.EndpointSlices != nil && c.EndpointSlices.HasSynced() &&
 		c.ServiceEntries != nil && c.ServiceEntries.HasSynced() &&
 		c.GatewayIndex != nil && c.GatewayIndex.Gateways.HasSynced()
 }
@@ -125,6 +128,12 @@ func NewCommonCollections(
 	)
 	services := krt.WrapClient(serviceClient, krtOptions.ToOptions("Services")...)
 
+	epSliceClient := kclient.NewFiltered[*discoveryv1.EndpointSlice](
+		client,
+		kclient.Filter{ObjectFilter: client.ObjectFilter()},
+	)
+	endpointSlices := krt.WrapClient(epSliceClient, krtOptions.ToOptions("EndpointSlices")...)
+
 	seInformer := kclient.NewDelayedInformer[*networkingclient.ServiceEntry](
 		client, gvr.ServiceEntry,
 		kubetypes.StandardInformer, kclient.Filter{ObjectFilter: client.ObjectFilter()},
@@ -150,6 +159,7 @@ func NewCommonCollections(
 		Settings:          settings,
 		Namespaces:        namespaces,
 		Services:          services,
+		EndpointSlices:    endpointSlices,
 		ServiceEntries:    serviceEntries,
 		ConfigMaps:        cfgmaps,
 		GatewayExtensions: gwExts,
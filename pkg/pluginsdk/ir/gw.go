@@ -88,6 +88,17 @@ func (c PolicyAtt) FormatErrors() string {
 	return strings.Join(errs, "; ")
 }
 
+// AcceptedMessage returns the message of the Accepted condition of the policy, extended by the
+// status message of the policy IR for the routes it is applied to if it has one.
+func (c PolicyAtt) AcceptedMessage(msg string, routes ...*HttpRouteIR) string {
+	if sm, ok := c.PolicyIr.(PolicyIRStatusMessage); ok {
+		if details := sm.StatusMessage(routes); details != "" {
+			return msg + "; " + details
+		}
+	}
+	return msg
+}
+
 type PolicyAttachmentOpts func(*PolicyAtt)
 
 func WithDelegationInheritedPolicyPriority(priority apiannotations.DelegationInheritedPolicyPriorityValue) PolicyAttachmentOpts {
@@ -55,10 +55,17 @@ type ListenerIR struct {
 
 	HttpFilterChain []HttpFilterChainIR
 	TcpFilterChain  []TcpIR
+	// Udp is set on UDP listeners, which have no filter chains and proxy datagrams
+	// with the udp_proxy listener filter instead.
+	Udp *UdpIR
 
 	PolicyAncestorRef gwv1.ParentReference
 
 	AttachedPolicies AttachedPolicies
+	// PartiallyAttachedPolicies are the IDs of the attached policies that only target some of
+	// the Gateway listeners sharing the port of the listener. Their settings of the whole
+	// listener would also apply to the other Gateway listeners.
+	PartiallyAttachedPolicies map[string]bool
 }
 
 type VirtualHost struct {
@@ -77,10 +84,55 @@ type FilterChainMatch struct {
 }
 
 type TlsBundle struct {
-	CA            []byte
-	PrivateKey    []byte
-	CertChain     []byte
+	CA []byte
+	// Certificates are the certificates of the listener, one per certificate ref. Envoy selects the
+	// certificate of a connection based on the SNI and on the signature algorithms of the client.
+	Certificates  []TlsCertificate
 	AlpnProtocols []string
+	// Params are the TLS protocol parameters of the listener, nil to use the Envoy defaults.
+	Params *TlsParameters
+	// FrontendValidation configures the validation of client certificates. It is nil when the
+	// listener does not request client certificates.
+	FrontendValidation *FrontendTlsValidation
+}
+
+// TlsCertificate is a certificate of a listener with its private key.
+type TlsCertificate struct {
+	PrivateKey []byte
+	CertChain  []byte
+	// SdsSecretName is the stable name the certificate is served under when it is served over SDS.
+	SdsSecretName string
+}
+
+// TlsParameters are the TLS protocol parameters of a listener. Empty fields use the Envoy defaults.
+type TlsParameters struct {
+	// MinVersion and MaxVersion are TLS versions such as "1.2".
+	MinVersion   string
+	MaxVersion   string
+	CipherSuites []string
+	EcdhCurves   []string
+}
+
+// FrontendTlsValidation configures the validation of the certificates presented by the clients of a listener.
+type FrontendTlsValidation struct {
+	// CACertificates is the PEM bundle of the CAs trusted to issue client certificates.
+	CACertificates []byte
+	// Crl is the PEM bundle of the certificate revocation lists of the CAs, if any.
+	Crl []byte
+	// Optional accepts the connections of clients presenting no certificate.
+	Optional bool
+	// SubjectAltNames, when set, requires the client certificate to have one of these names.
+	SubjectAltNames []SubjectAltName
+	// CertificateHashes, when set, requires the client certificate to match one of these
+	// hex-encoded SHA-256 hashes.
+	CertificateHashes []string
+}
+
+// SubjectAltName is a Subject Alternative Name of a certificate.
+type SubjectAltName struct {
+	// Type is one of dns, uri, email or ip.
+	Type  string
+	Value string
 }
 
 type FilterChainCommon struct {
@@ -111,6 +163,13 @@ type HttpFilterChainIR struct {
 type TcpIR struct {
 	FilterChainCommon
 	BackendRefs []BackendRefIR
+	// AttachedNetworkPolicies are the policies attached to the gateway listener of the filter chain.
+	AttachedNetworkPolicies AttachedPolicies
+}
+
+type UdpIR struct {
+	Name        string
+	BackendRefs []BackendRefIR
 }
 
 // this is 1:1 with envoy deployments
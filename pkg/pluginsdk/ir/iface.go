@@ -26,6 +26,20 @@ var logger = logging.New("pluginsdk/ir")
 type ListenerContext struct {
 	Policy            PolicyIR
 	PolicyAncestorRef gwv1.ParentReference
+	// PolicyRef is the policy the Policy IR comes from, to report status on it.
+	// nil if the Policy IR does not come from a policy resource.
+	PolicyRef *AttachedPolicyRef
+	// Generation of the policy resource.
+	Generation int64
+	// PartiallyAttached is set when the policy only targets some of the Gateway listeners
+	// sharing the port of the listener.
+	PartiallyAttached bool
+}
+
+type FilterChainContext struct {
+	Policy            PolicyIR
+	FilterChainName   string
+	PolicyAncestorRef gwv1.ParentReference
 }
 
 type RouteConfigContext struct {
@@ -97,7 +111,15 @@ type RouteContext struct {
 }
 
 type HcmContext struct {
-	Policy PolicyIR
+	Policy          PolicyIR
+	FilterChainName string
+	// PolicyRef is the policy the Policy IR comes from, to report status on it.
+	// nil if the Policy IR does not come from a policy resource.
+	PolicyRef *AttachedPolicyRef
+	// Generation of the policy resource.
+	Generation int64
+	// PolicyAncestorRef is the ancestor of the policy the status is reported for.
+	PolicyAncestorRef gwv1.ParentReference
 }
 
 // ProxyTranslationPass represents a single translation pass for a gateway. It can hold state
@@ -115,6 +137,13 @@ type ProxyTranslationPass interface {
 	ApplyHCM(ctx context.Context,
 		pCtx *HcmContext,
 		out *envoy_hcm.HttpConnectionManager) error
+	// called 1 time per filter chain, after its network filters are computed, for the policies
+	// attached to the gateway and to the gateway listeners the filter chain comes from.
+	ApplyFilterChainPlugin(
+		ctx context.Context,
+		pCtx *FilterChainContext,
+		out *envoy_config_listener_v3.FilterChain,
+	)
 
 	// called 1 time for all the routes in a filter chain. Use this to set default PerFilterConfig
 	// No policy is provided here.
@@ -179,6 +208,9 @@ func (s UnimplementedProxyTranslationPass) ApplyHCM(ctx context.Context, pCtx *H
 	return nil
 }
 
+func (s UnimplementedProxyTranslationPass) ApplyFilterChainPlugin(ctx context.Context, pCtx *FilterChainContext, out *envoy_config_listener_v3.FilterChain) {
+}
+
 func (s UnimplementedProxyTranslationPass) ApplyForBackend(ctx context.Context, pCtx *RouteBackendContext, in HttpBackend, out *envoy_config_route_v3.Route) error {
 	return nil
 }
@@ -221,6 +253,14 @@ type PolicyIR interface {
 	Equals(in any) bool
 }
 
+// PolicyIRStatusMessage can optionally be implemented by a PolicyIR to add details on how the
+// policy is applied to the HTTPRoutes it affects, e.g. the fields overridden by the routes, to
+// the message of its Accepted condition.
+type PolicyIRStatusMessage interface {
+	// StatusMessage returns the details for the given routes, or an empty string if there are none.
+	StatusMessage(routes []*HttpRouteIR) string
+}
+
 type PolicyWrapper struct {
 	// A reference to the original policy object
 	ObjectSource `json:",inline"`
@@ -77,17 +77,7 @@ func (r *PolicyReport) ancestorRef(parentRef gwv1.ParentReference) *AncestorRefR
 func (r *PolicyReport) ancestorRefs() []gwv1.ParentReference {
 	var refs []gwv1.ParentReference
 	for key := range r.Ancestors {
-		var ns *gwv1.Namespace
-		if key.Namespace != "" {
-			ns = ptr.To(gwv1.Namespace(key.Namespace))
-		}
-		parentRef := gwv1.ParentReference{
-			Group:     ptr.To(gwv1.Group(key.Group)),
-			Kind:      ptr.To(gwv1.Kind(key.Kind)),
-			Name:      gwv1.ObjectName(key.Name),
-			Namespace: ns,
-		}
-		refs = append(refs, parentRef)
+		refs = append(refs, key.parentRef())
 	}
 	return refs
 }
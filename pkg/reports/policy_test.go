@@ -362,6 +362,63 @@ func TestPolicyStatusReport(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with ancestors that are sections of the same gateway merged",
+			fakeTranslation: func(a *assert.Assertions, reporter Reporter) {
+				policyReport := reporter.Policy(PolicyKey{
+					Group:     "example.com",
+					Kind:      "Policy",
+					Namespace: "default",
+					Name:      "example",
+				}, 1)
+				policyReport.AncestorRef(gwv1.ParentReference{
+					Group:       ptr.To(gwv1.Group("gateway.networking.k8s.io")),
+					Kind:        ptr.To(gwv1.Kind("Gateway")),
+					Namespace:   ptr.To(gwv1.Namespace("default")),
+					Name:        gwv1.ObjectName("gw-1"),
+					SectionName: ptr.To(gwv1.SectionName("http")),
+				})
+				policyReport.AncestorRef(gwv1.ParentReference{
+					Group:       ptr.To(gwv1.Group("gateway.networking.k8s.io")),
+					Kind:        ptr.To(gwv1.Kind("Gateway")),
+					Namespace:   ptr.To(gwv1.Namespace("default")),
+					Name:        gwv1.ObjectName("gw-1"),
+					SectionName: ptr.To(gwv1.SectionName("https")),
+				}).SetCondition(pluginsdkreporter.PolicyCondition{
+					Type:   gwv1alpha2.PolicyConditionAccepted,
+					Status: metav1.ConditionFalse,
+					Reason: gwv1alpha2.PolicyReasonInvalid,
+				})
+			},
+			key: PolicyKey{
+				Group:     "example.com",
+				Kind:      "Policy",
+				Namespace: "default",
+				Name:      "example",
+			},
+			controller: "example-controller",
+			wantStatus: &gwv1alpha2.PolicyStatus{
+				Ancestors: []gwv1alpha2.PolicyAncestorStatus{
+					{
+						AncestorRef: gwv1.ParentReference{
+							Group:     ptr.To(gwv1.Group("gateway.networking.k8s.io")),
+							Kind:      ptr.To(gwv1.Kind("Gateway")),
+							Namespace: ptr.To(gwv1.Namespace("default")),
+							Name:      gwv1.ObjectName("gw-1"),
+						},
+						ControllerName: "example-controller",
+						Conditions: []metav1.Condition{
+							{
+								ObservedGeneration: 1,
+								Type:               string(gwv1alpha2.PolicyConditionAccepted),
+								Status:             metav1.ConditionFalse,
+								Reason:             string(gwv1alpha2.PolicyReasonInvalid),
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
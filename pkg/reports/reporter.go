@@ -3,6 +3,7 @@ package reports
 import (
 	"fmt"
 	"log/slog"
+	"slices"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -12,6 +13,7 @@ import (
 	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	gwxv1alpha1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
 
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 	pluginsdkreporter "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
 )
 
@@ -24,6 +26,7 @@ type ReportMap struct {
 	GRPCRoutes   map[types.NamespacedName]*RouteReport
 	TCPRoutes    map[types.NamespacedName]*RouteReport
 	TLSRoutes    map[types.NamespacedName]*RouteReport
+	UDPRoutes    map[types.NamespacedName]*RouteReport
 	Policies     map[PolicyKey]*PolicyReport
 }
 
@@ -53,10 +56,30 @@ type ParentRefReport struct {
 	Conditions []metav1.Condition
 }
 
+// ParentRefKey identifies a parentRef of a route or an ancestorRef of a policy. The port is only
+// part of the key for Service parents, so that routes attached to different ports of a Service
+// through a waypoint get their own status for each port.
 type ParentRefKey struct {
 	Group string
 	Kind  string
 	types.NamespacedName
+	Port int32
+}
+
+// parentRef returns the ParentReference identified by the key.
+func (k ParentRefKey) parentRef() gwv1.ParentReference {
+	parentRef := gwv1.ParentReference{
+		Group: ptr.To(gwv1.Group(k.Group)),
+		Kind:  ptr.To(gwv1.Kind(k.Kind)),
+		Name:  gwv1.ObjectName(k.Name),
+	}
+	if k.Namespace != "" {
+		parentRef.Namespace = ptr.To(gwv1.Namespace(k.Namespace))
+	}
+	if k.Port != 0 {
+		parentRef.Port = ptr.To(gwv1.PortNumber(k.Port))
+	}
+	return parentRef
 }
 
 func NewReportMap() ReportMap {
@@ -67,6 +90,7 @@ func NewReportMap() ReportMap {
 		GRPCRoutes:   make(map[types.NamespacedName]*RouteReport),
 		TCPRoutes:    make(map[types.NamespacedName]*RouteReport),
 		TLSRoutes:    make(map[types.NamespacedName]*RouteReport),
+		UDPRoutes:    make(map[types.NamespacedName]*RouteReport),
 		Policies:     make(map[PolicyKey]*PolicyReport),
 	}
 }
@@ -118,6 +142,7 @@ func (r *ReportMap) newListenerSetReport(listenerSet *gwxv1alpha1.XListenerSet)
 // * HTTPRoute
 // * TCPRoute
 // * TLSRoute
+// * UDPRoute
 // * GRPCRoute
 func (r *ReportMap) route(obj metav1.Object) *RouteReport {
 	key := key(obj)
@@ -129,6 +154,8 @@ func (r *ReportMap) route(obj metav1.Object) *RouteReport {
 		return r.TCPRoutes[key]
 	case *gwv1alpha2.TLSRoute:
 		return r.TLSRoutes[key]
+	case *gwv1alpha2.UDPRoute:
+		return r.UDPRoutes[key]
 	case *gwv1.GRPCRoute:
 		return r.GRPCRoutes[key]
 	default:
@@ -151,6 +178,8 @@ func (r *ReportMap) newRouteReport(obj metav1.Object) *RouteReport {
 		r.TCPRoutes[key] = rr
 	case *gwv1alpha2.TLSRoute:
 		r.TLSRoutes[key] = rr
+	case *gwv1alpha2.UDPRoute:
+		r.UDPRoutes[key] = rr
 	case *gwv1.GRPCRoute:
 		r.GRPCRoutes[key] = rr
 	default:
@@ -192,6 +221,14 @@ func (g *GatewayReport) GetConditions() []metav1.Condition {
 	return g.conditions
 }
 
+// Clone returns a copy of the report whose Gateway conditions can be set without modifying the
+// original report. The listener reports are shared with the original report.
+func (g *GatewayReport) Clone() *GatewayReport {
+	out := *g
+	out.conditions = slices.Clone(g.conditions)
+	return &out
+}
+
 func (g *GatewayReport) SetCondition(gc pluginsdkreporter.GatewayCondition) {
 	condition := metav1.Condition{
 		Type:    string(gc.Type),
@@ -312,6 +349,10 @@ func getParentRefKey(parentRef *gwv1.ParentReference) ParentRefKey {
 	if parentRef.Namespace != nil {
 		ns = string(*parentRef.Namespace)
 	}
+	var port int32
+	if parentRef.Port != nil && group == "" && kind == wellknown.ServiceKind {
+		port = int32(*parentRef.Port)
+	}
 	return ParentRefKey{
 		Group: group,
 		Kind:  kind,
@@ -319,6 +360,7 @@ func getParentRefKey(parentRef *gwv1.ParentReference) ParentRefKey {
 			Namespace: ns,
 			Name:      string(parentRef.Name),
 		},
+		Port: port,
 	}
 }
 
@@ -353,17 +395,7 @@ func (r *RouteReport) parentRef(parentRef *gwv1.ParentReference) *ParentRefRepor
 func (r *RouteReport) parentRefs() []gwv1.ParentReference {
 	var refs []gwv1.ParentReference
 	for key := range r.Parents {
-		var ns *gwv1.Namespace
-		if key.Namespace != "" {
-			ns = ptr.To(gwv1.Namespace(key.Namespace))
-		}
-		parentRef := gwv1.ParentReference{
-			Group:     ptr.To(gwv1.Group(key.Group)),
-			Kind:      ptr.To(gwv1.Kind(key.Kind)),
-			Name:      gwv1.ObjectName(key.Name),
-			Namespace: ns,
-		}
-		refs = append(refs, parentRef)
+		refs = append(refs, key.parentRef())
 	}
 	return refs
 }
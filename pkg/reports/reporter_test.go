@@ -3,6 +3,8 @@ package reports_test
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -155,6 +157,7 @@ var _ = Describe("Reporting Infrastructure", func() {
 			Entry("regular httproute", httpRoute()),
 			Entry("regular tcproute", tcpRoute()),
 			Entry("regular tlsroute", tlsRoute()),
+			Entry("regular udproute", udpRoute()),
 			Entry("regular grpcroute", grpcRoute()),
 			Entry("delegatee route", delegateeRoute()),
 		)
@@ -303,10 +306,70 @@ var _ = Describe("Reporting Infrastructure", func() {
 			Entry("regular httproute", httpRoute(), parentRef()),
 			Entry("regular tcproute", tcpRoute(), parentRef()),
 			Entry("regular tlsroute", tlsRoute(), parentRef()),
+			Entry("regular udproute", udpRoute(), parentRef()),
 			Entry("regular grpcroute", grpcRoute(), parentRef()),
 			Entry("delegatee route", delegateeRoute(), parentRouteRef()),
 		)
 
+		DescribeTable("should merge the reports of parentRefs to a Gateway that differ only by section name or port",
+			func(obj client.Object) {
+				rm := reports.NewReportMap()
+				reporter := reports.NewReporter(&rm)
+				fakeTranslate(reporter, obj)
+				reporter.Route(obj).ParentRef(sectionParentRef()).SetCondition(pluginsdkreporter.RouteCondition{
+					Type:   gwv1.RouteConditionAccepted,
+					Status: metav1.ConditionFalse,
+					Reason: gwv1.RouteReasonNoMatchingParent,
+				})
+
+				status := rm.BuildRouteStatus(context.Background(), obj, wellknown.DefaultGatewayControllerName)
+
+				Expect(status).NotTo(BeNil())
+				Expect(status.Parents).To(HaveLen(2))
+				Expect(status.Parents[0].ParentRef).To(Equal(*portParentRef()))
+				Expect(status.Parents[1].ParentRef).To(Equal(*sectionParentRef()))
+				for _, parent := range status.Parents {
+					accepted := meta.FindStatusCondition(parent.Conditions, string(gwv1.RouteConditionAccepted))
+					Expect(accepted).NotTo(BeNil())
+					Expect(accepted.Status).To(Equal(metav1.ConditionFalse))
+				}
+			},
+			Entry("httproute", withParentRefs(&gwv1.HTTPRoute{}, *sectionParentRef(), *portParentRef())),
+			Entry("tcproute", withParentRefs(&gwv1a2.TCPRoute{}, *sectionParentRef(), *portParentRef())),
+		)
+
+		DescribeTable("should report the parentRefs of a Service with different ports separately",
+			func(obj client.Object) {
+				rm := reports.NewReportMap()
+				reporter := reports.NewReporter(&rm)
+				fakeTranslate(reporter, obj)
+				reporter.Route(obj).ParentRef(serviceParentRef(8080)).SetCondition(pluginsdkreporter.RouteCondition{
+					Type:   gwv1.RouteConditionAccepted,
+					Status: metav1.ConditionFalse,
+					Reason: gwv1.RouteReasonNoMatchingParent,
+				})
+
+				status := rm.BuildRouteStatus(context.Background(), obj, wellknown.DefaultGatewayControllerName)
+
+				Expect(status).NotTo(BeNil())
+				Expect(status.Parents).To(HaveLen(2))
+				for _, parent := range status.Parents {
+					accepted := meta.FindStatusCondition(parent.Conditions, string(gwv1.RouteConditionAccepted))
+					Expect(accepted).NotTo(BeNil())
+					if reflect.DeepEqual(parent.ParentRef, *serviceParentRef(8080)) {
+						Expect(accepted.Status).To(Equal(metav1.ConditionFalse))
+					} else {
+						Expect(parent.ParentRef).To(Equal(*serviceParentRef(9090)))
+						Expect(accepted.Status).To(Equal(metav1.ConditionTrue))
+						// the condition of the existing status is reconciled
+						Expect(accepted.LastTransitionTime).To(Equal(metav1.Time{Time: time.Unix(1, 0)}))
+					}
+				}
+			},
+			Entry("httproute", withParentRefs(&gwv1.HTTPRoute{}, *serviceParentRef(8080), *serviceParentRef(9090))),
+			Entry("tcproute", withParentRefs(&gwv1a2.TCPRoute{}, *serviceParentRef(8080), *serviceParentRef(9090))),
+		)
+
 		DescribeTable("should filter out multiple negative route conditions of the same type from report",
 			func(obj client.Object, parentRef *gwv1.ParentReference) {
 				rm := reports.NewReportMap()
@@ -334,6 +397,7 @@ var _ = Describe("Reporting Infrastructure", func() {
 			Entry("regular httproute", httpRoute(), parentRef()),
 			Entry("regular tcproute", tcpRoute(), parentRef()),
 			Entry("regular tlsroute", tlsRoute(), parentRef()),
+			Entry("regular udproute", udpRoute(), parentRef()),
 			Entry("regular grpcroute", grpcRoute(), parentRef()),
 			Entry("delegatee route", delegateeRoute(), parentRouteRef()),
 		)
@@ -361,6 +425,8 @@ var _ = Describe("Reporting Infrastructure", func() {
 					route.Status.RouteStatus = *status
 				case *gwv1a2.TLSRoute:
 					route.Status.RouteStatus = *status
+				case *gwv1a2.UDPRoute:
+					route.Status.RouteStatus = *status
 				case *gwv1.GRPCRoute:
 					route.Status.RouteStatus = *status
 				default:
@@ -380,6 +446,7 @@ var _ = Describe("Reporting Infrastructure", func() {
 			Entry("regular httproute", httpRoute()),
 			Entry("regular tcproute", tcpRoute()),
 			Entry("regular tlsroute", tlsRoute()),
+			Entry("regular udproute", udpRoute()),
 			Entry("regular grpcroute", grpcRoute()),
 			Entry("delegatee route", delegateeRoute()),
 		)
@@ -400,6 +467,10 @@ var _ = Describe("Reporting Infrastructure", func() {
 					route.Spec.ParentRefs = append(route.Spec.ParentRefs, gwv1.ParentReference{
 						Name: "additional-gateway",
 					})
+				case *gwv1a2.UDPRoute:
+					route.Spec.ParentRefs = append(route.Spec.ParentRefs, gwv1.ParentReference{
+						Name: "additional-gateway",
+					})
 				case *gwv1.GRPCRoute:
 					route.Spec.ParentRefs = append(route.Spec.ParentRefs, gwv1.ParentReference{
 						Name: "additional-gateway",
@@ -426,6 +497,7 @@ var _ = Describe("Reporting Infrastructure", func() {
 			Entry("regular HTTPRoute", httpRoute()),
 			Entry("regular TCPRoute", tcpRoute()),
 			Entry("regular tlsroute", tlsRoute()),
+			Entry("regular udproute", udpRoute()),
 			Entry("regular grpcroute", grpcRoute()),
 		)
 
@@ -680,6 +752,11 @@ func fakeTranslate(reporter reports.Reporter, obj client.Object) {
 		for _, pr := range route.Spec.ParentRefs {
 			routeReporter.ParentRef(&pr)
 		}
+	case *gwv1a2.UDPRoute:
+		routeReporter := reporter.Route(route)
+		for _, pr := range route.Spec.ParentRefs {
+			routeReporter.ParentRef(&pr)
+		}
 	case *gwv1.GRPCRoute:
 		routeReporter := reporter.Route(route)
 		for _, pr := range route.Spec.ParentRefs {
@@ -742,6 +819,24 @@ func tlsRoute(conditions ...metav1.Condition) client.Object {
 	return route
 }
 
+func udpRoute(conditions ...metav1.Condition) client.Object {
+	route := &gwv1a2.UDPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "route",
+			Namespace: "default",
+		},
+	}
+	route.Spec.CommonRouteSpec.ParentRefs = append(route.Spec.CommonRouteSpec.ParentRefs, *parentRef())
+	if len(conditions) > 0 {
+		route.Status.Parents = append(route.Status.Parents, gwv1.RouteParentStatus{
+			ParentRef:      *parentRef(),
+			Conditions:     conditions,
+			ControllerName: wellknown.DefaultGatewayControllerName,
+		})
+	}
+	return route
+}
+
 func grpcRoute(conditions ...metav1.Condition) client.Object {
 	route := &gwv1.GRPCRoute{
 		ObjectMeta: metav1.ObjectMeta{
@@ -766,6 +861,54 @@ func parentRef() *gwv1.ParentReference {
 	}
 }
 
+func sectionParentRef() *gwv1.ParentReference {
+	return &gwv1.ParentReference{
+		Name:        "kgateway-gtw",
+		SectionName: ptr.To(gwv1.SectionName("listener")),
+	}
+}
+
+func portParentRef() *gwv1.ParentReference {
+	return &gwv1.ParentReference{
+		Name: "kgateway-gtw",
+		Port: ptr.To(gwv1.PortNumber(8080)),
+	}
+}
+
+func serviceParentRef(port gwv1.PortNumber) *gwv1.ParentReference {
+	return &gwv1.ParentReference{
+		Group: ptr.To(gwv1.Group("")),
+		Kind:  ptr.To(gwv1.Kind("Service")),
+		Name:  "svc",
+		Port:  ptr.To(port),
+	}
+}
+
+// withParentRefs sets the parentRefs of the route, and an existing accepted status for the last one.
+func withParentRefs(route client.Object, parentRefs ...gwv1.ParentReference) client.Object {
+	route.SetName("route")
+	route.SetNamespace("default")
+	parents := []gwv1.RouteParentStatus{{
+		ParentRef:      parentRefs[len(parentRefs)-1],
+		ControllerName: wellknown.DefaultGatewayControllerName,
+		Conditions: []metav1.Condition{{
+			Type:               string(gwv1.RouteConditionAccepted),
+			Status:             metav1.ConditionTrue,
+			Reason:             string(gwv1.RouteReasonAccepted),
+			LastTransitionTime: metav1.Time{Time: time.Unix(1, 0)},
+		}},
+	}}
+	switch route := route.(type) {
+	case *gwv1.HTTPRoute:
+		route.Spec.ParentRefs = parentRefs
+		route.Status.Parents = parents
+	case *gwv1a2.TCPRoute:
+		route.Spec.ParentRefs = parentRefs
+		route.Status.Parents = parents
+	}
+	return route
+}
+
 func otherParentRef() *gwv1.ParentReference {
 	return &gwv1.ParentReference{
 		Name: "other-gtw",
@@ -85,6 +85,10 @@ type Settings struct {
 	// Controls the listener bind address. Can be either V4 or V6
 	ListenerBindIpv6 bool `split_words:"true" default:"true"`
 
+	// EnableIstioIntegration enables the support of Istio resources, e.g. DestinationRules.
+	// The consistentHash load balancer of a DestinationRule is only applied when it has no hash
+	// key (httpHeaderName, httpCookie, useSourceIp or httpQueryParameterName), as the hash key
+	// would have to be set on the routes of the Gateways.
 	EnableIstioIntegration bool `split_words:"true"`
 	EnableIstioAutoMtls    bool `split_words:"true"`
 
@@ -92,6 +96,15 @@ type Settings struct {
 	// Defaults to "istio-system".
 	IstioNamespace string `split_words:"true" default:"istio-system"`
 
+	// IstioTrustDomain is the trust domain of the mesh, used by waypoints to match the principals
+	// of AuthorizationPolicies. If not set, the trust domain of Istio's MeshConfig is used,
+	// falling back to "cluster.local".
+	IstioTrustDomain string `split_words:"true"`
+
+	// IstioTrustDomainAliases is a comma-separated list of trust domains that are treated as
+	// equivalent to IstioTrustDomain. If not set, the aliases of Istio's MeshConfig are used.
+	IstioTrustDomainAliases []string `split_words:"true"`
+
 	// XdsServiceHost is the host that serves xDS config.
 	// It overrides xdsServiceName if set.
 	XdsServiceHost string `split_words:"true"`
@@ -105,6 +118,26 @@ type Settings struct {
 	// This corresponds to the value of the `grpc-xds` port in the service.
 	XdsServicePort uint32 `split_words:"true" default:"9977"`
 
+	// XdsTlsCertDir is the directory holding the tls.crt and tls.key served by the xDS server,
+	// and the ca.crt that proxies use to verify it. If set, the xDS server only accepts TLS connections.
+	XdsTlsCertDir string `split_words:"true"`
+
+	// XdsTlsClientCertSecretName is the name of the Secret, in the namespace of each Gateway,
+	// holding the client certificate (tls.crt and tls.key) that proxies present to the xDS server.
+	// If set, the xDS server requires client certificates signed by the ca.crt in XdsTlsCertDir,
+	// and identifies the proxies by the address of their pod. Requires XdsTlsCertDir to be set.
+	XdsTlsClientCertSecretName string `split_words:"true"`
+
+	// XdsAuth requires proxies to authenticate to the xDS server with a token of the ServiceAccount
+	// of their Gateway's deployment. Tokens are validated with the TokenReview API, and a proxy is
+	// only served the config of the Gateway it belongs to.
+	XdsAuth bool `split_words:"true" default:"false"`
+
+	// DownstreamTlsSds serves the certificates of Gateway listeners through SDS on the xDS server,
+	// instead of inlining them in the listeners. Certificate rotations then only update the secrets,
+	// without updating and draining the listeners, and private keys are not sent in the listener config.
+	DownstreamTlsSds bool `split_words:"true" default:"false"`
+
 	UseRustFormations bool `split_words:"true" default:"false"`
 
 	// EnableInferExt defines whether to enable/disable support for Gateway API inference extension.
@@ -58,30 +58,36 @@ func TestSettings(t *testing.T) {
 		{
 			name: "all values set",
 			envVars: map[string]string{
-				"KGW_DNS_LOOKUP_FAMILY":              string(settings.DnsLookupFamilyV4Only),
-				"KGW_ENABLE_ISTIO_INTEGRATION":       "true",
-				"KGW_ENABLE_ISTIO_AUTO_MTLS":         "true",
-				"KGW_LISTENER_BIND_IPV6":             "false",
-				"KGW_STS_CLUSTER_NAME":               "my-cluster",
-				"KGW_STS_URI":                        "my.sts.uri",
-				"KGW_XDS_SERVICE_HOST":               "my-xds-host",
-				"KGW_XDS_SERVICE_NAME":               "custom-svc",
-				"KGW_XDS_SERVICE_PORT":               "1234",
-				"KGW_USE_RUST_FORMATIONS":            "true",
-				"KGW_ENABLE_INFER_EXT":               "true",
-				"KGW_INFER_EXT_AUTO_PROVISION":       "true",
-				"KGW_DEFAULT_IMAGE_REGISTRY":         "my-registry",
-				"KGW_DEFAULT_IMAGE_TAG":              "my-tag",
-				"KGW_DEFAULT_IMAGE_PULL_POLICY":      "Always",
-				"KGW_WAYPOINT_LOCAL_BINDING":         "true",
-				"KGW_INGRESS_USE_WAYPOINTS":          "true",
-				"KGW_LOG_LEVEL":                      "debug",
-				"KGW_DISCOVERY_NAMESPACE_SELECTORS":  `[{"matchExpressions":[{"key":"kubernetes.io/metadata.name","operator":"In","values":["infra"]}]},{"matchLabels":{"app":"a"}}]`,
-				"KGW_ENABLE_AGENT_GATEWAY":           "true",
-				"KGW_WEIGHTED_ROUTE_PRECEDENCE":      "true",
-				"KGW_ROUTE_REPLACEMENT_MODE":         string(settings.RouteReplacementStrict),
-				"KGW_ENABLE_BUILTIN_DEFAULT_METRICS": "true",
-				"KGW_GLOBAL_POLICY_NAMESPACE":        "foo",
+				"KGW_DNS_LOOKUP_FAMILY":               string(settings.DnsLookupFamilyV4Only),
+				"KGW_ENABLE_ISTIO_INTEGRATION":        "true",
+				"KGW_ENABLE_ISTIO_AUTO_MTLS":          "true",
+				"KGW_LISTENER_BIND_IPV6":              "false",
+				"KGW_ISTIO_TRUST_DOMAIN":              "example.org",
+				"KGW_ISTIO_TRUST_DOMAIN_ALIASES":      "old.example.org,cluster.local",
+				"KGW_STS_CLUSTER_NAME":                "my-cluster",
+				"KGW_STS_URI":                         "my.sts.uri",
+				"KGW_XDS_SERVICE_HOST":                "my-xds-host",
+				"KGW_XDS_SERVICE_NAME":                "custom-svc",
+				"KGW_XDS_SERVICE_PORT":                "1234",
+				"KGW_XDS_TLS_CERT_DIR":                "/etc/xds-tls",
+				"KGW_XDS_TLS_CLIENT_CERT_SECRET_NAME": "xds-client-tls",
+				"KGW_XDS_AUTH":                        "true",
+				"KGW_DOWNSTREAM_TLS_SDS":              "true",
+				"KGW_USE_RUST_FORMATIONS":             "true",
+				"KGW_ENABLE_INFER_EXT":                "true",
+				"KGW_INFER_EXT_AUTO_PROVISION":        "true",
+				"KGW_DEFAULT_IMAGE_REGISTRY":          "my-registry",
+				"KGW_DEFAULT_IMAGE_TAG":               "my-tag",
+				"KGW_DEFAULT_IMAGE_PULL_POLICY":       "Always",
+				"KGW_WAYPOINT_LOCAL_BINDING":          "true",
+				"KGW_INGRESS_USE_WAYPOINTS":           "true",
+				"KGW_LOG_LEVEL":                       "debug",
+				"KGW_DISCOVERY_NAMESPACE_SELECTORS":   `[{"matchExpressions":[{"key":"kubernetes.io/metadata.name","operator":"In","values":["infra"]}]},{"matchLabels":{"app":"a"}}]`,
+				"KGW_ENABLE_AGENT_GATEWAY":            "true",
+				"KGW_WEIGHTED_ROUTE_PRECEDENCE":       "true",
+				"KGW_ROUTE_REPLACEMENT_MODE":          string(settings.RouteReplacementStrict),
+				"KGW_ENABLE_BUILTIN_DEFAULT_METRICS":  "true",
+				"KGW_GLOBAL_POLICY_NAMESPACE":         "foo",
 			},
 			expectedSettings: &settings.Settings{
 				DnsLookupFamily:             settings.DnsLookupFamilyV4Only,
@@ -89,9 +95,15 @@ func TestSettings(t *testing.T) {
 				EnableIstioIntegration:      true,
 				EnableIstioAutoMtls:         true,
 				IstioNamespace:              "istio-system",
+				IstioTrustDomain:            "example.org",
+				IstioTrustDomainAliases:     []string{"old.example.org", "cluster.local"},
 				XdsServiceHost:              "my-xds-host",
 				XdsServiceName:              "custom-svc",
 				XdsServicePort:              1234,
+				XdsTlsCertDir:               "/etc/xds-tls",
+				XdsTlsClientCertSecretName:  "xds-client-tls",
+				XdsAuth:                     true,
+				DownstreamTlsSds:            true,
 				UseRustFormations:           true,
 				EnableInferExt:              true,
 				InferExtAutoProvision:       true,
@@ -135,6 +135,7 @@ func recordKubeState(ctx context.Context, kubectlCli *kubectl.Cli, f *os.File) {
 		"gatewayextensions.gateway.kgateway.dev",
 		"gatewayparameters.gateway.kgateway.dev",
 		"httplistenerpolicies.gateway.kgateway.dev",
+		"listenerpolicies.gateway.kgateway.dev",
 		"trafficpolicies.gateway.kgateway.dev",
 	}
 
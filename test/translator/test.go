@@ -14,6 +14,7 @@ import (
 
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -62,6 +63,7 @@ type translationResult struct {
 	Listeners     []*envoy_config_listener_v3.Listener
 	ExtraClusters []*clusterv3.Cluster
 	Clusters      []*clusterv3.Cluster
+	Secrets       []*envoytlsv3.Secret
 }
 
 func (tr *translationResult) MarshalJSON() ([]byte, error) {
@@ -105,6 +107,14 @@ func (tr *translationResult) MarshalJSON() ([]byte, error) {
 		result["Clusters"] = clusters
 	}
 
+	if len(tr.Secrets) > 0 {
+		secrets, err := marshalProtoMessages(tr.Secrets, m)
+		if err != nil {
+			return nil, err
+		}
+		result["Secrets"] = secrets
+	}
+
 	// Marshal the result map to JSON
 	return json.Marshal(result)
 }
@@ -181,6 +191,21 @@ func (tr *translationResult) UnmarshalJSON(data []byte) error {
 		}
 	}
 
+	if secretsData, ok := result["Secrets"]; ok {
+		var secrets []json.RawMessage
+		if err := json.Unmarshal(secretsData, &secrets); err != nil {
+			return err
+		}
+		tr.Secrets = make([]*envoytlsv3.Secret, len(secrets))
+		for i, secretData := range secrets {
+			secret := &envoytlsv3.Secret{}
+			if err := m.Unmarshal(secretData, secret); err != nil {
+				return err
+			}
+			tr.Secrets[i] = secret
+		}
+	}
+
 	return nil
 }
 
@@ -259,6 +284,7 @@ func TestTranslationWithExtraPlugins(
 		Listeners:     result.Proxy.Listeners,
 		ExtraClusters: result.Proxy.ExtraClusters,
 		Clusters:      result.Clusters,
+		Secrets:       result.Proxy.Secrets,
 	}
 	outputYaml, err := MarshalAnyYaml(output)
 	fmt.Fprintf(ginkgo.GinkgoWriter, "actual result:\n %s \nerror: %v", outputYaml, err)
@@ -404,6 +430,19 @@ func AreReportsSuccess(gwNN types.NamespacedName, reportsMap reports.ReportMap)
 		}
 	}
 
+	for nns, routeReport := range reportsMap.UDPRoutes {
+		for ref, parentRefReport := range routeReport.Parents {
+			for _, c := range parentRefReport.Conditions {
+				// most route conditions true is good, except RouteConditionPartiallyInvalid
+				if c.Type == string(gwv1.RouteConditionPartiallyInvalid) && c.Status != metav1.ConditionFalse {
+					return fmt.Errorf("condition error for udproute: %v ref: %v condition: %v", nns, ref, c)
+				} else if c.Status != metav1.ConditionTrue {
+					return fmt.Errorf("condition error for udproute: %v ref: %v condition: %v", nns, ref, c)
+				}
+			}
+		}
+	}
+
 	for nns, routeReport := range reportsMap.GRPCRoutes {
 		for ref, parentRefReport := range routeReport.Parents {
 			for _, c := range parentRefReport.Conditions {
@@ -487,6 +526,7 @@ func (tc TestCase) Run(
 		gvr.Pod,
 		gvr.TCPRoute,
 		gvr.TLSRoute,
+		gvr.UDPRoute,
 		gvr.ServiceEntry,
 		gvr.WorkloadEntry,
 		gvr.AuthorizationPolicy,